@@ -0,0 +1,298 @@
+// Package abi decodes the raw topics and data of log events with a contract's JSON ABI, without calling the API.
+package abi
+
+import (
+	"bytes"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/covalenthq/covalent-api-sdk-go/genericmodels"
+	"github.com/covalenthq/covalent-api-sdk-go/utils"
+)
+
+var (
+	// ErrUnknownEvent is returned when no event of the ABI matches the first topic of a log.
+	ErrUnknownEvent = errors.New("abi: no event matches the log topic")
+	// ErrNoTopics is returned when a log has no topics to identify its event by.
+	ErrNoTopics = errors.New("abi: log has no topics")
+)
+
+// ABI holds the events of a contract ABI.
+type ABI struct {
+	Events []Event
+}
+
+// Argument is an input of an event, or a component of a tuple.
+type Argument struct {
+	Name    string
+	Type    Type
+	Indexed bool
+}
+
+type argumentJSON struct {
+	Name       string         `json:"name"`
+	Type       string         `json:"type"`
+	Indexed    bool           `json:"indexed"`
+	Components []argumentJSON `json:"components"`
+}
+
+func (a argumentJSON) toArgument() (Argument, error) {
+	components := make([]Argument, len(a.Components))
+	for i, component := range a.Components {
+		argument, err := component.toArgument()
+		if err != nil {
+			return Argument{}, err
+		}
+		components[i] = argument
+	}
+	t, err := ParseType(a.Type, components...)
+	if err != nil {
+		return Argument{}, fmt.Errorf("argument %q: %w", a.Name, err)
+	}
+	return Argument{Name: a.Name, Type: t, Indexed: a.Indexed}, nil
+}
+
+// Event is an event of a contract ABI.
+type Event struct {
+	Name      string
+	Inputs    []Argument
+	Anonymous bool
+}
+
+// Parse reads a standard JSON ABI, either as an array of entries or as an object with an `abi` array such as
+// a compiler artifact. Entries other than events are ignored.
+func Parse(data []byte) (*ABI, error) {
+	data = bytes.TrimSpace(data)
+	if len(data) > 0 && data[0] == '{' {
+		var artifact struct {
+			ABI json.RawMessage `json:"abi"`
+		}
+		if err := json.Unmarshal(data, &artifact); err != nil {
+			return nil, err
+		}
+		if artifact.ABI == nil {
+			return nil, fmt.Errorf("abi: object has no abi field")
+		}
+		data = artifact.ABI
+	}
+
+	var entries []struct {
+		Type      string         `json:"type"`
+		Name      string         `json:"name"`
+		Inputs    []argumentJSON `json:"inputs"`
+		Anonymous bool           `json:"anonymous"`
+	}
+	if err := json.Unmarshal(data, &entries); err != nil {
+		return nil, err
+	}
+
+	parsed := &ABI{}
+	for _, entry := range entries {
+		if entry.Type != "event" {
+			continue
+		}
+		event := Event{Name: entry.Name, Anonymous: entry.Anonymous}
+		for _, input := range entry.Inputs {
+			argument, err := input.toArgument()
+			if err != nil {
+				return nil, fmt.Errorf("abi: event %s: %w", entry.Name, err)
+			}
+			event.Inputs = append(event.Inputs, argument)
+		}
+		parsed.Events = append(parsed.Events, event)
+	}
+	return parsed, nil
+}
+
+// MustParse is like Parse but panics on an invalid ABI. For ABIs embedded in code.
+func MustParse(data string) *ABI {
+	parsed, err := Parse([]byte(data))
+	if err != nil {
+		panic(err)
+	}
+	return parsed
+}
+
+// Signature returns the canonical signature of the event, eg: `Transfer(address,address,uint256)`.
+func (e Event) Signature() string {
+	types := make([]string, len(e.Inputs))
+	for i, input := range e.Inputs {
+		types[i] = input.Type.String()
+	}
+	return e.Name + "(" + strings.Join(types, ",") + ")"
+}
+
+// Topic returns the hash of the signature that non-anonymous events log as their first topic, as `0x`-prefixed hex.
+func (e Event) Topic() string {
+	return "0x" + hex.EncodeToString(utils.Keccak256([]byte(e.Signature())))
+}
+
+// EventByTopic returns the non-anonymous event whose topic is topic, compared case-insensitively.
+func (a *ABI) EventByTopic(topic string) (*Event, bool) {
+	for i := range a.Events {
+		if !a.Events[i].Anonymous && strings.EqualFold(a.Events[i].Topic(), topic) {
+			return &a.Events[i], true
+		}
+	}
+	return nil, false
+}
+
+// EventByName returns the first event named name.
+func (a *ABI) EventByName(name string) (*Event, bool) {
+	for i := range a.Events {
+		if a.Events[i].Name == name {
+			return &a.Events[i], true
+		}
+	}
+	return nil, false
+}
+
+// DecodeLog decodes the raw topics and data of log with the event its first topic identifies.
+func (a *ABI) DecodeLog(log genericmodels.LogEvent) (*genericmodels.DecodedItem, error) {
+	topics, data := rawLog(log)
+	if len(topics) == 0 {
+		return nil, ErrNoTopics
+	}
+	event, ok := a.EventByTopic(topics[0])
+	if !ok {
+		return nil, ErrUnknownEvent
+	}
+	return event.Decode(topics, data)
+}
+
+// FillDecoded sets log.Decoded from its raw topics and data when the API did not decode it.
+func (a *ABI) FillDecoded(log *genericmodels.LogEvent) error {
+	if log.Decoded != nil {
+		return nil
+	}
+	decoded, err := a.DecodeLog(*log)
+	if err != nil {
+		return err
+	}
+	log.Decoded = decoded
+	return nil
+}
+
+// DecodeLog decodes the raw topics and data of log with e, whether or not the first topic matches.
+func (e Event) DecodeLog(log genericmodels.LogEvent) (*genericmodels.DecodedItem, error) {
+	topics, data := rawLog(log)
+	return e.Decode(topics, data)
+}
+
+// Bind decodes log with e and sets the fields of the struct v points to from its params, see
+// genericmodels.DecodedItem.Bind. Logs without raw topics are read from Decoded instead, with its params matched to
+// the inputs of e by position since contracts name them differently, eg: `src`, `dst` and `wad` in place of `from`,
+// `to` and `value`.
+func (e Event) Bind(log genericmodels.LogEvent, v interface{}) error {
+	if log.RawLogTopics != nil && len(*log.RawLogTopics) > 0 {
+		decoded, err := e.DecodeLog(log)
+		if err != nil {
+			return err
+		}
+		return decoded.Bind(v)
+	}
+	if log.Decoded == nil || log.Decoded.Params == nil {
+		return ErrNoTopics
+	}
+	if len(*log.Decoded.Params) != len(e.Inputs) {
+		return fmt.Errorf("abi: event %s expects %d params, got %d", e.Name, len(e.Inputs), len(*log.Decoded.Params))
+	}
+	params := make([]genericmodels.Param, len(e.Inputs))
+	for i, param := range *log.Decoded.Params {
+		name := e.Inputs[i].Name
+		param.Name = &name
+		params[i] = param
+	}
+	return (&genericmodels.DecodedItem{Params: &params}).Bind(v)
+}
+
+// Decode decodes `0x`-prefixed hex topics and data with e. Indexed arguments are read from the topics: those of a
+// dynamic, array or tuple type only store a hash there, so their value is the topic and Decoded is false.
+func (e Event) Decode(topics []string, data string) (*genericmodels.DecodedItem, error) {
+	if !e.Anonymous {
+		if len(topics) == 0 {
+			return nil, ErrNoTopics
+		}
+		topics = topics[1:]
+	}
+
+	var indexed int
+	var nonIndexed []Type
+	for _, input := range e.Inputs {
+		if input.Indexed {
+			indexed++
+		} else {
+			nonIndexed = append(nonIndexed, input.Type)
+		}
+	}
+	if len(topics) != indexed {
+		return nil, fmt.Errorf("abi: event %s expects %d indexed topics, got %d", e.Name, indexed, len(topics))
+	}
+
+	rawData, err := decodeHex(data)
+	if err != nil {
+		return nil, fmt.Errorf("abi: event %s data: %w", e.Name, err)
+	}
+	values, err := decodeTuple(nonIndexed, rawData)
+	if err != nil {
+		return nil, fmt.Errorf("abi: event %s data: %w", e.Name, err)
+	}
+
+	params := make([]genericmodels.Param, len(e.Inputs))
+	for i, input := range e.Inputs {
+		var value interface{}
+		decoded := true
+		if input.Indexed {
+			topic := topics[0]
+			topics = topics[1:]
+			if input.Type.isElementary() {
+				word, err := decodeHex(topic)
+				if err != nil || len(word) != wordSize {
+					return nil, fmt.Errorf("abi: event %s topic for %s is not a 32-byte word: %q", e.Name, input.Name, topic)
+				}
+				value, err = decodeAt(input.Type, word)
+				if err != nil {
+					return nil, fmt.Errorf("abi: event %s topic for %s: %w", e.Name, input.Name, err)
+				}
+			} else {
+				value = strings.ToLower(topic)
+				decoded = false
+			}
+		} else {
+			value = values[0]
+			values = values[1:]
+		}
+		params[i] = newParam(input, value, decoded)
+	}
+
+	name := e.Name
+	signature := e.Signature()
+	return &genericmodels.DecodedItem{Name: &name, Signature: &signature, Params: &params}, nil
+}
+
+func newParam(argument Argument, value interface{}, decoded bool) genericmodels.Param {
+	name := argument.Name
+	typeName := argument.Type.String()
+	indexed := argument.Indexed
+	return genericmodels.Param{Name: &name, Type: &typeName, Indexed: &indexed, Decoded: &decoded, Value: &value}
+}
+
+func rawLog(log genericmodels.LogEvent) ([]string, string) {
+	var topics []string
+	if log.RawLogTopics != nil {
+		topics = *log.RawLogTopics
+	}
+	var data string
+	if log.RawLogData != nil {
+		data = *log.RawLogData
+	}
+	return topics, data
+}
+
+func decodeHex(s string) ([]byte, error) {
+	s = strings.TrimPrefix(strings.TrimPrefix(s, "0x"), "0X")
+	return hex.DecodeString(s)
+}
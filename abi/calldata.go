@@ -0,0 +1,231 @@
+package abi
+
+import (
+	"fmt"
+	"math/big"
+	"reflect"
+	"strings"
+
+	"github.com/covalenthq/covalent-api-sdk-go/genericmodels"
+	"github.com/covalenthq/covalent-api-sdk-go/utils"
+)
+
+// encodeTuple returns the ABI encoding of values as a tuple of types, as used for the arguments of Function.EncodeCall.
+func encodeTuple(types []Type, values []interface{}) ([]byte, error) {
+	headSize := 0
+	for _, t := range types {
+		headSize += t.headSize()
+	}
+	head := make([]byte, 0, headSize)
+	var tail []byte
+	for i, t := range types {
+		encoded, err := encodeValue(t, values[i])
+		if err != nil {
+			return nil, err
+		}
+		if t.IsDynamic() {
+			head = append(head, word(big.NewInt(int64(headSize+len(tail))))...)
+			tail = append(tail, encoded...)
+		} else {
+			head = append(head, encoded...)
+		}
+	}
+	return append(head, tail...), nil
+}
+
+func encodeValue(t Type, value interface{}) ([]byte, error) {
+	switch t.Kind {
+	case UintKind, IntKind:
+		n, err := toBigInt(value)
+		if err != nil {
+			return nil, err
+		}
+		limit := new(big.Int).Lsh(big.NewInt(1), uint(t.Size))
+		if t.Kind == IntKind {
+			limit.Rsh(limit, 1)
+			if n.Cmp(limit) >= 0 || n.Cmp(new(big.Int).Neg(limit)) < 0 {
+				return nil, fmt.Errorf("%s does not fit in %s", n, t)
+			}
+		} else if n.Sign() < 0 || n.Cmp(limit) >= 0 {
+			return nil, fmt.Errorf("%s does not fit in %s", n, t)
+		}
+		return word(n), nil
+	case AddressKind:
+		address, err := toAddress(value)
+		if err != nil {
+			return nil, err
+		}
+		return leftPad(address[:]), nil
+	case BoolKind:
+		b, ok := value.(bool)
+		if !ok {
+			return nil, fmt.Errorf("expected a bool for %s, got %T", t, value)
+		}
+		if b {
+			return word(big.NewInt(1)), nil
+		}
+		return word(big.NewInt(0)), nil
+	case FixedBytesKind:
+		b, err := toBytes(value)
+		if err != nil {
+			return nil, err
+		}
+		if len(b) != t.Size {
+			return nil, fmt.Errorf("expected %d bytes for %s, got %d", t.Size, t, len(b))
+		}
+		return rightPad(b), nil
+	case BytesKind, StringKind:
+		var b []byte
+		if s, ok := value.(string); ok && t.Kind == StringKind {
+			b = []byte(s)
+		} else {
+			var err error
+			if b, err = toBytes(value); err != nil {
+				return nil, err
+			}
+		}
+		return append(word(big.NewInt(int64(len(b)))), rightPad(b)...), nil
+	case SliceKind, ArrayKind:
+		items, err := toSlice(value)
+		if err != nil {
+			return nil, err
+		}
+		if t.Kind == ArrayKind && len(items) != t.Length {
+			return nil, fmt.Errorf("expected %d items for %s, got %d", t.Length, t, len(items))
+		}
+		encoded, err := encodeTuple(repeatType(*t.Elem, len(items)), items)
+		if err != nil {
+			return nil, err
+		}
+		if t.Kind == SliceKind {
+			return append(word(big.NewInt(int64(len(items)))), encoded...), nil
+		}
+		return encoded, nil
+	case TupleKind:
+		items, err := toSlice(value)
+		if err != nil {
+			return nil, err
+		}
+		if len(items) != len(t.Components) {
+			return nil, fmt.Errorf("expected %d components for %s, got %d", len(t.Components), t, len(items))
+		}
+		types := make([]Type, len(t.Components))
+		for i, component := range t.Components {
+			types[i] = component.Type
+		}
+		return encodeTuple(types, items)
+	}
+	return nil, fmt.Errorf("unsupported ABI type %s", t)
+}
+
+func toBigInt(value interface{}) (*big.Int, error) {
+	switch v := value.(type) {
+	case *big.Int:
+		return v, nil
+	case big.Int:
+		return &v, nil
+	case utils.BigInt:
+		if v.Int != nil {
+			return v.Int, nil
+		}
+	case *utils.BigInt:
+		if v != nil && v.Int != nil {
+			return v.Int, nil
+		}
+	case string:
+		base := 10
+		if strings.HasPrefix(v, "0x") || strings.HasPrefix(v, "0X") {
+			v, base = v[2:], 16
+		}
+		n, ok := new(big.Int).SetString(v, base)
+		if ok {
+			return n, nil
+		}
+	default:
+		rv := reflect.ValueOf(value)
+		switch rv.Kind() {
+		case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+			return big.NewInt(rv.Int()), nil
+		case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+			return new(big.Int).SetUint64(rv.Uint()), nil
+		}
+	}
+	return nil, fmt.Errorf("cannot encode %v (%T) as an integer", value, value)
+}
+
+func toAddress(value interface{}) (utils.Address, error) {
+	switch v := value.(type) {
+	case utils.Address:
+		return v, nil
+	case *utils.Address:
+		if v != nil {
+			return *v, nil
+		}
+	case [20]byte:
+		return utils.Address(v), nil
+	case string:
+		return utils.ParseAddress(v)
+	}
+	return utils.Address{}, fmt.Errorf("cannot encode %v (%T) as an address", value, value)
+}
+
+func toBytes(value interface{}) ([]byte, error) {
+	switch v := value.(type) {
+	case []byte:
+		return v, nil
+	case string:
+		if strings.HasPrefix(v, "0x") || strings.HasPrefix(v, "0X") {
+			return decodeHex(v)
+		}
+	default:
+		rv := reflect.ValueOf(value)
+		if rv.Kind() == reflect.Array && rv.Type().Elem().Kind() == reflect.Uint8 {
+			b := make([]byte, rv.Len())
+			reflect.Copy(reflect.ValueOf(b), rv)
+			return b, nil
+		}
+	}
+	return nil, fmt.Errorf("cannot encode %v (%T) as bytes", value, value)
+}
+
+func toSlice(value interface{}) ([]interface{}, error) {
+	if params, ok := value.([]genericmodels.Param); ok {
+		items := make([]interface{}, len(params))
+		for i, param := range params {
+			if param.Value != nil {
+				items[i] = *param.Value
+			}
+		}
+		return items, nil
+	}
+	rv := reflect.ValueOf(value)
+	if rv.Kind() != reflect.Slice && rv.Kind() != reflect.Array {
+		return nil, fmt.Errorf("cannot encode %v (%T) as a list", value, value)
+	}
+	items := make([]interface{}, rv.Len())
+	for i := range items {
+		items[i] = rv.Index(i).Interface()
+	}
+	return items, nil
+}
+
+// word returns the 32-byte two's complement encoding of n.
+func word(n *big.Int) []byte {
+	if n.Sign() < 0 {
+		n = new(big.Int).Add(n, new(big.Int).Lsh(big.NewInt(1), 256))
+	}
+	return n.FillBytes(make([]byte, wordSize))
+}
+
+func leftPad(b []byte) []byte {
+	padded := make([]byte, wordSize)
+	copy(padded[wordSize-len(b):], b)
+	return padded
+}
+
+func rightPad(b []byte) []byte {
+	size := (len(b) + wordSize - 1) / wordSize * wordSize
+	padded := make([]byte, size)
+	copy(padded, b)
+	return padded
+}
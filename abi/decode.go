@@ -0,0 +1,132 @@
+package abi
+
+import (
+	"encoding/hex"
+	"fmt"
+	"math/big"
+
+	"github.com/covalenthq/covalent-api-sdk-go/genericmodels"
+)
+
+const wordSize = 32
+
+// Decoded values take the same shape as the values of params decoded by the API: integers are decimal strings,
+// addresses lowercase hex strings, bytes `0x`-prefixed hex strings, arrays []interface{} and tuples []genericmodels.Param.
+
+// decodeTuple decodes values of types laid out as a tuple at the start of data: a head with static values and
+// offsets to dynamic values, relative to the start of data.
+func decodeTuple(types []Type, data []byte) ([]interface{}, error) {
+	values := make([]interface{}, len(types))
+	position := 0
+	for i, t := range types {
+		if position+t.headSize() > len(data) {
+			return nil, fmt.Errorf("data too short for %s at offset %d", t, position)
+		}
+		var err error
+		if t.IsDynamic() {
+			offset, err := readOffset(data[position:])
+			if err != nil {
+				return nil, err
+			}
+			if offset > len(data) {
+				return nil, fmt.Errorf("offset %d of %s is out of range", offset, t)
+			}
+			values[i], err = decodeAt(t, data[offset:])
+			if err != nil {
+				return nil, err
+			}
+		} else {
+			values[i], err = decodeAt(t, data[position:])
+			if err != nil {
+				return nil, err
+			}
+		}
+		position += t.headSize()
+	}
+	return values, nil
+}
+
+// decodeAt decodes a value of type t whose encoding starts at the start of data.
+func decodeAt(t Type, data []byte) (interface{}, error) {
+	switch t.Kind {
+	case StringKind, BytesKind:
+		length, err := readOffset(data)
+		if err != nil {
+			return nil, err
+		}
+		if wordSize+length > len(data) {
+			return nil, fmt.Errorf("data too short for %s of length %d", t, length)
+		}
+		content := data[wordSize : wordSize+length]
+		if t.Kind == StringKind {
+			return string(content), nil
+		}
+		return "0x" + hex.EncodeToString(content), nil
+	case SliceKind:
+		length, err := readOffset(data)
+		if err != nil {
+			return nil, err
+		}
+		if length > len(data) {
+			return nil, fmt.Errorf("length %d of %s is out of range", length, t)
+		}
+		return decodeTuple(repeatType(*t.Elem, length), data[wordSize:])
+	case ArrayKind:
+		return decodeTuple(repeatType(*t.Elem, t.Length), data)
+	case TupleKind:
+		types := make([]Type, len(t.Components))
+		for i, component := range t.Components {
+			types[i] = component.Type
+		}
+		values, err := decodeTuple(types, data)
+		if err != nil {
+			return nil, err
+		}
+		params := make([]genericmodels.Param, len(values))
+		for i, component := range t.Components {
+			params[i] = newParam(component, values[i], true)
+		}
+		return params, nil
+	}
+
+	if len(data) < wordSize {
+		return nil, fmt.Errorf("data too short for %s", t)
+	}
+	word := data[:wordSize]
+	switch t.Kind {
+	case UintKind:
+		return new(big.Int).SetBytes(word).String(), nil
+	case IntKind:
+		value := new(big.Int).SetBytes(word)
+		if word[0]&0x80 != 0 {
+			value.Sub(value, new(big.Int).Lsh(big.NewInt(1), 256))
+		}
+		return value.String(), nil
+	case AddressKind:
+		return "0x" + hex.EncodeToString(word[12:]), nil
+	case BoolKind:
+		return word[wordSize-1] == 1, nil
+	case FixedBytesKind:
+		return "0x" + hex.EncodeToString(word[:t.Size]), nil
+	}
+	return nil, fmt.Errorf("unsupported ABI type %s", t)
+}
+
+func readOffset(data []byte) (int, error) {
+	if len(data) < wordSize {
+		return 0, fmt.Errorf("data too short for an offset")
+	}
+	value := new(big.Int).SetBytes(data[:wordSize])
+	if !value.IsInt64() || value.Int64() > int64(^uint32(0)) {
+		return 0, fmt.Errorf("offset %s is out of range", value)
+	}
+	return int(value.Int64()), nil
+}
+
+func repeatType(t Type, n int) []Type {
+	types := make([]Type, n)
+	for i := range types {
+		types[i] = t
+	}
+	return types
+}
@@ -0,0 +1,89 @@
+package abi
+
+import (
+	"encoding/hex"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/covalenthq/covalent-api-sdk-go/utils"
+)
+
+// dataLocation matches the data locations of Solidity arguments, which are not part of their types.
+var dataLocation = regexp.MustCompile(`\b(memory|calldata|storage)\b`)
+
+// Function is a contract function, used to encode the call data of transactions offline.
+type Function struct {
+	Name   string
+	Inputs []Argument
+}
+
+// ParseFunction reads a human-readable function signature, either canonical such as `approve(address,uint256)` or as
+// declared in Solidity such as `function approve(address spender, uint amount)`. Modifiers and return types after the
+// argument list and data locations such as `memory` are ignored.
+func ParseFunction(signature string) (Function, error) {
+	s := strings.TrimSpace(signature)
+	s = strings.TrimSpace(strings.TrimPrefix(s, "function "))
+	open := strings.Index(s, "(")
+	if open <= 0 || !isIdentifier(strings.TrimSpace(s[:open])) {
+		return Function{}, fmt.Errorf("abi: invalid function signature %q", signature)
+	}
+	end := matchingParen(s[open:])
+	if end < 0 {
+		return Function{}, fmt.Errorf("abi: invalid function signature %q", signature)
+	}
+	inputs, err := parseArguments(dataLocation.ReplaceAllString(s[open+1:open+end], ""))
+	if err != nil {
+		return Function{}, fmt.Errorf("abi: invalid function signature %q: %w", signature, err)
+	}
+	return Function{Name: strings.TrimSpace(s[:open]), Inputs: inputs}, nil
+}
+
+// MustParseFunction is like ParseFunction but panics on an invalid signature. For signatures embedded in code.
+func MustParseFunction(signature string) Function {
+	function, err := ParseFunction(signature)
+	if err != nil {
+		panic(err)
+	}
+	return function
+}
+
+// Signature returns the canonical signature of the function, eg: `approve(address,uint256)`.
+func (f Function) Signature() string {
+	types := make([]string, len(f.Inputs))
+	for i, input := range f.Inputs {
+		types[i] = input.Type.String()
+	}
+	return f.Name + "(" + strings.Join(types, ",") + ")"
+}
+
+// Selector returns the first 4 bytes of the hash of the signature, which start the call data of the function.
+func (f Function) Selector() []byte {
+	return utils.Keccak256([]byte(f.Signature()))[:4]
+}
+
+// EncodeCall returns the call data of a call to f with the given input values: the selector followed by the encoded
+// values. See Encode for the values accepted.
+func (f Function) EncodeCall(values ...interface{}) ([]byte, error) {
+	if len(values) != len(f.Inputs) {
+		return nil, fmt.Errorf("abi: function %s expects %d values, got %d", f.Name, len(f.Inputs), len(values))
+	}
+	types := make([]Type, len(f.Inputs))
+	for i, input := range f.Inputs {
+		types[i] = input.Type
+	}
+	encoded, err := encodeTuple(types, values)
+	if err != nil {
+		return nil, fmt.Errorf("abi: function %s: %w", f.Name, err)
+	}
+	return append(f.Selector(), encoded...), nil
+}
+
+// EncodeCallHex is like EncodeCall but returns the call data as `0x`-prefixed hex, as transactions take it.
+func (f Function) EncodeCallHex(values ...interface{}) (string, error) {
+	data, err := f.EncodeCall(values...)
+	if err != nil {
+		return "", err
+	}
+	return "0x" + hex.EncodeToString(data), nil
+}
@@ -0,0 +1,119 @@
+package abi
+
+import (
+	"strings"
+	"sync"
+
+	"github.com/covalenthq/covalent-api-sdk-go/genericmodels"
+)
+
+// Registry maps topic hashes back to the canonical signatures of the events that log them. It is safe for
+// concurrent use.
+type Registry struct {
+	mu         sync.RWMutex
+	signatures map[string][]string
+}
+
+// NewRegistry returns a registry holding the given event signatures. See ParseEvent for the accepted forms.
+func NewRegistry(signatures ...string) (*Registry, error) {
+	r := &Registry{signatures: map[string][]string{}}
+	if err := r.Register(signatures...); err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+// DefaultRegistry holds the signatures of common token, wrapped native token, ownership and Uniswap-style pool events.
+// Register more signatures to label the logs of other contracts.
+var DefaultRegistry = mustNewRegistry(
+	"Transfer(address,address,uint256)",
+	"Approval(address,address,uint256)",
+	"ApprovalForAll(address,address,bool)",
+	"TransferSingle(address,address,address,uint256,uint256)",
+	"TransferBatch(address,address,address,uint256[],uint256[])",
+	"URI(string,uint256)",
+	"Deposit(address,uint256)",
+	"Withdrawal(address,uint256)",
+	"OwnershipTransferred(address,address)",
+	"Paused(address)",
+	"Unpaused(address)",
+	"Upgraded(address)",
+	"PairCreated(address,address,address,uint256)",
+	"PoolCreated(address,address,uint24,int24,address)",
+	"Swap(address,uint256,uint256,uint256,uint256,address)",
+	"Swap(address,address,int256,int256,uint160,uint128,int24)",
+	"Sync(uint112,uint112)",
+	"Mint(address,uint256,uint256)",
+	"Mint(address,address,int24,int24,uint128,uint256,uint256)",
+	"Burn(address,uint256,uint256,address)",
+	"Burn(address,int24,int24,uint128,uint256,uint256)",
+)
+
+func mustNewRegistry(signatures ...string) *Registry {
+	r, err := NewRegistry(signatures...)
+	if err != nil {
+		panic(err)
+	}
+	return r
+}
+
+// Register adds the canonical form of each signature under its topic hash.
+func (r *Registry) Register(signatures ...string) error {
+	events := make([]Event, len(signatures))
+	for i, signature := range signatures {
+		event, err := ParseEvent(signature)
+		if err != nil {
+			return err
+		}
+		events[i] = event
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for _, event := range events {
+		topic, signature := event.Topic(), event.Signature()
+		known := false
+		for _, existing := range r.signatures[topic] {
+			known = known || existing == signature
+		}
+		if !known {
+			r.signatures[topic] = append(r.signatures[topic], signature)
+		}
+	}
+	return nil
+}
+
+// RegisterABI adds the signatures of the non-anonymous events of a.
+func (r *Registry) RegisterABI(a *ABI) {
+	var signatures []string
+	for _, event := range a.Events {
+		if !event.Anonymous {
+			signatures = append(signatures, event.Signature())
+		}
+	}
+	// Signatures of parsed events are canonical, so they always parse.
+	_ = r.Register(signatures...)
+}
+
+// Lookup returns the signatures registered under topic, compared case-insensitively. Several signatures are returned
+// only on a hash collision.
+func (r *Registry) Lookup(topic string) ([]string, bool) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	signatures, ok := r.signatures[strings.ToLower(topic)]
+	return append([]string(nil), signatures...), ok
+}
+
+// Label returns the signature of the event log logged, from the registry when its first topic is registered and from
+// the signature of Decoded otherwise.
+func (r *Registry) Label(log genericmodels.LogEvent) (string, bool) {
+	if log.RawLogTopics != nil && len(*log.RawLogTopics) > 0 {
+		if signatures, ok := r.Lookup((*log.RawLogTopics)[0]); ok {
+			return signatures[0], true
+		}
+	}
+	if log.Decoded != nil && log.Decoded.Signature != nil {
+		return *log.Decoded.Signature, true
+	}
+	return "", false
+}
@@ -0,0 +1,186 @@
+package abi
+
+import (
+	"encoding/hex"
+	"fmt"
+	"strings"
+)
+
+// ParseEvent reads a human-readable event signature, either canonical such as `Transfer(address,address,uint256)`
+// or as declared in Solidity such as `event Transfer(address indexed from, address indexed to, uint value)`.
+// Argument names and `indexed` are optional, `uint` and `int` stand for their 256-bit types, and tuples are written
+// in parentheses, eg: `Settled((address,uint256)[] legs)`.
+func ParseEvent(signature string) (Event, error) {
+	s := strings.TrimSpace(signature)
+	s = strings.TrimSpace(strings.TrimPrefix(s, "event "))
+	anonymous := false
+	if strings.HasSuffix(s, " anonymous") {
+		s, anonymous = strings.TrimSpace(strings.TrimSuffix(s, " anonymous")), true
+	}
+	s = strings.TrimSuffix(s, ";")
+	open := strings.Index(s, "(")
+	if open <= 0 || !strings.HasSuffix(s, ")") || !isIdentifier(strings.TrimSpace(s[:open])) {
+		return Event{}, fmt.Errorf("abi: invalid event signature %q", signature)
+	}
+	inputs, err := parseArguments(s[open+1 : len(s)-1])
+	if err != nil {
+		return Event{}, fmt.Errorf("abi: invalid event signature %q: %w", signature, err)
+	}
+	return Event{Name: strings.TrimSpace(s[:open]), Inputs: inputs, Anonymous: anonymous}, nil
+}
+
+// TopicHash returns the topic an event with the given human-readable signature logs first, as lowercase
+// `0x`-prefixed hex. See ParseEvent for the accepted forms.
+func TopicHash(signature string) (string, error) {
+	event, err := ParseEvent(signature)
+	if err != nil {
+		return "", err
+	}
+	return event.Topic(), nil
+}
+
+// IsTopicHash reports whether s is a `0x`-prefixed 32-byte hex topic.
+func IsTopicHash(s string) bool {
+	if len(s) != 66 || (s[:2] != "0x" && s[:2] != "0X") {
+		return false
+	}
+	_, err := hex.DecodeString(s[2:])
+	return err == nil
+}
+
+// ResolveTopic returns s if it is a topic hash, and the topic hash of s if it is an event signature.
+func ResolveTopic(s string) (string, error) {
+	s = strings.TrimSpace(s)
+	if IsTopicHash(s) {
+		return s, nil
+	}
+	return TopicHash(s)
+}
+
+// ResolveTopics resolves each entry of a comma-separated list of topic hashes and event signatures, see
+// ResolveTopic. Commas inside the parentheses of a signature do not separate entries.
+func ResolveTopics(list string) (string, error) {
+	entries, err := splitTopLevel(list)
+	if err != nil {
+		return "", fmt.Errorf("abi: invalid topics %q: %w", list, err)
+	}
+	for i, entry := range entries {
+		if entries[i], err = ResolveTopic(entry); err != nil {
+			return "", err
+		}
+	}
+	return strings.Join(entries, ","), nil
+}
+
+func parseArguments(list string) ([]Argument, error) {
+	if strings.TrimSpace(list) == "" {
+		return nil, nil
+	}
+	entries, err := splitTopLevel(list)
+	if err != nil {
+		return nil, err
+	}
+	arguments := make([]Argument, len(entries))
+	for i, entry := range entries {
+		if arguments[i], err = parseArgument(strings.TrimSpace(entry)); err != nil {
+			return nil, err
+		}
+	}
+	return arguments, nil
+}
+
+// parseArgument reads a type followed by an optional `indexed` and name, eg: `(address,uint256)[2] indexed pairs`.
+func parseArgument(s string) (Argument, error) {
+	var components []Argument
+	typeName := s
+	if strings.HasPrefix(s, "tuple(") {
+		s = strings.TrimPrefix(s, "tuple")
+	}
+	if strings.HasPrefix(s, "(") {
+		end := matchingParen(s)
+		if end < 0 {
+			return Argument{}, fmt.Errorf("unbalanced parentheses in %q", s)
+		}
+		var err error
+		if components, err = parseArguments(s[1:end]); err != nil {
+			return Argument{}, err
+		}
+		s = "tuple" + s[end+1:]
+	}
+	fields := strings.Fields(s)
+	if len(fields) == 0 {
+		return Argument{}, fmt.Errorf("empty argument")
+	}
+	argument := Argument{}
+	rest := fields[1:]
+	if len(rest) > 0 && rest[0] == "indexed" {
+		argument.Indexed, rest = true, rest[1:]
+	}
+	if len(rest) > 1 || (len(rest) == 1 && !isIdentifier(rest[0])) {
+		return Argument{}, fmt.Errorf("invalid argument %q", typeName)
+	}
+	if len(rest) == 1 {
+		argument.Name = rest[0]
+	}
+	t, err := ParseType(fields[0], components...)
+	if err != nil {
+		return Argument{}, err
+	}
+	argument.Type = t
+	return argument, nil
+}
+
+// splitTopLevel splits s at the commas outside parentheses.
+func splitTopLevel(s string) ([]string, error) {
+	var entries []string
+	depth, start := 0, 0
+	for i, r := range s {
+		switch r {
+		case '(':
+			depth++
+		case ')':
+			depth--
+			if depth < 0 {
+				return nil, fmt.Errorf("unbalanced parentheses")
+			}
+		case ',':
+			if depth == 0 {
+				entries = append(entries, strings.TrimSpace(s[start:i]))
+				start = i + 1
+			}
+		}
+	}
+	if depth != 0 {
+		return nil, fmt.Errorf("unbalanced parentheses")
+	}
+	return append(entries, strings.TrimSpace(s[start:])), nil
+}
+
+// matchingParen returns the index of the parenthesis closing the one s starts with, or -1.
+func matchingParen(s string) int {
+	depth := 0
+	for i, r := range s {
+		switch r {
+		case '(':
+			depth++
+		case ')':
+			depth--
+			if depth == 0 {
+				return i
+			}
+		}
+	}
+	return -1
+}
+
+func isIdentifier(s string) bool {
+	if s == "" {
+		return false
+	}
+	for i, r := range s {
+		if !(r == '_' || r == '$' || r >= 'a' && r <= 'z' || r >= 'A' && r <= 'Z' || i > 0 && r >= '0' && r <= '9') {
+			return false
+		}
+	}
+	return true
+}
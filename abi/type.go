@@ -0,0 +1,181 @@
+package abi
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// Kind is the kind of an ABI type.
+type Kind int
+
+const (
+	UintKind Kind = iota
+	IntKind
+	AddressKind
+	BoolKind
+	FixedBytesKind
+	BytesKind
+	StringKind
+	ArrayKind
+	SliceKind
+	TupleKind
+)
+
+// Type is a parsed ABI type, eg: `uint256`, `bytes32[]` or a tuple with its components.
+type Type struct {
+	Kind Kind
+	// The bit size of integers and the byte size of fixed bytes.
+	Size int
+	// The length of fixed arrays.
+	Length int
+	// The element type of arrays and slices.
+	Elem *Type
+	// The components of tuples.
+	Components []Argument
+	// Whether the type is `function`: an address followed by a selector, encoded as bytes24.
+	Function bool
+}
+
+// ParseType reads an ABI type string such as `uint256[2][]`. Tuples take their components from the ABI JSON,
+// see Argument.
+func ParseType(s string, components ...Argument) (Type, error) {
+	s = strings.TrimSpace(s)
+	if strings.HasSuffix(s, "]") {
+		open := strings.LastIndex(s, "[")
+		if open < 0 {
+			return Type{}, fmt.Errorf("invalid ABI type %q", s)
+		}
+		elem, err := ParseType(s[:open], components...)
+		if err != nil {
+			return Type{}, err
+		}
+		size := s[open+1 : len(s)-1]
+		if size == "" {
+			return Type{Kind: SliceKind, Elem: &elem}, nil
+		}
+		length, err := strconv.Atoi(size)
+		if err != nil || length <= 0 {
+			return Type{}, fmt.Errorf("invalid array length in ABI type %q", s)
+		}
+		return Type{Kind: ArrayKind, Length: length, Elem: &elem}, nil
+	}
+
+	switch {
+	case s == "address":
+		return Type{Kind: AddressKind, Size: 160}, nil
+	case s == "bool":
+		return Type{Kind: BoolKind}, nil
+	case s == "string":
+		return Type{Kind: StringKind}, nil
+	case s == "bytes":
+		return Type{Kind: BytesKind}, nil
+	case s == "function":
+		return Type{Kind: FixedBytesKind, Size: 24, Function: true}, nil
+	case s == "tuple":
+		if len(components) == 0 {
+			return Type{}, fmt.Errorf("tuple ABI type without components")
+		}
+		return Type{Kind: TupleKind, Components: components}, nil
+	case strings.HasPrefix(s, "uint"), strings.HasPrefix(s, "int"):
+		kind, bits := UintKind, strings.TrimPrefix(s, "uint")
+		if !strings.HasPrefix(s, "uint") {
+			kind, bits = IntKind, strings.TrimPrefix(s, "int")
+		}
+		size := 256
+		if bits != "" {
+			var err error
+			size, err = strconv.Atoi(bits)
+			if err != nil || size <= 0 || size > 256 || size%8 != 0 {
+				return Type{}, fmt.Errorf("invalid integer size in ABI type %q", s)
+			}
+		}
+		return Type{Kind: kind, Size: size}, nil
+	case strings.HasPrefix(s, "bytes"):
+		size, err := strconv.Atoi(strings.TrimPrefix(s, "bytes"))
+		if err != nil || size <= 0 || size > 32 {
+			return Type{}, fmt.Errorf("invalid size in ABI type %q", s)
+		}
+		return Type{Kind: FixedBytesKind, Size: size}, nil
+	}
+	return Type{}, fmt.Errorf("unsupported ABI type %q", s)
+}
+
+// String returns the canonical form of the type used in signatures, eg: `(address,uint256)[]`.
+func (t Type) String() string {
+	switch t.Kind {
+	case UintKind:
+		return "uint" + strconv.Itoa(t.Size)
+	case IntKind:
+		return "int" + strconv.Itoa(t.Size)
+	case AddressKind:
+		return "address"
+	case BoolKind:
+		return "bool"
+	case FixedBytesKind:
+		if t.Function {
+			return "function"
+		}
+		return "bytes" + strconv.Itoa(t.Size)
+	case BytesKind:
+		return "bytes"
+	case StringKind:
+		return "string"
+	case ArrayKind:
+		return t.Elem.String() + "[" + strconv.Itoa(t.Length) + "]"
+	case SliceKind:
+		return t.Elem.String() + "[]"
+	case TupleKind:
+		types := make([]string, len(t.Components))
+		for i, component := range t.Components {
+			types[i] = component.Type.String()
+		}
+		return "(" + strings.Join(types, ",") + ")"
+	}
+	return ""
+}
+
+// IsDynamic reports whether the encoding of the type has a variable size and is referenced by an offset.
+func (t Type) IsDynamic() bool {
+	switch t.Kind {
+	case BytesKind, StringKind, SliceKind:
+		return true
+	case ArrayKind:
+		return t.Elem.IsDynamic()
+	case TupleKind:
+		for _, component := range t.Components {
+			if component.Type.IsDynamic() {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// headSize returns the number of bytes the type takes in the head of an enclosing tuple.
+func (t Type) headSize() int {
+	if t.IsDynamic() {
+		return wordSize
+	}
+	switch t.Kind {
+	case ArrayKind:
+		return t.Length * t.Elem.headSize()
+	case TupleKind:
+		size := 0
+		for _, component := range t.Components {
+			size += component.Type.headSize()
+		}
+		return size
+	}
+	return wordSize
+}
+
+// isElementary reports whether a value of the type fits in a single word, which is what indexed arguments of that type
+// store in their topic. Other indexed arguments only store the hash of their encoding.
+func (t Type) isElementary() bool {
+	switch t.Kind {
+	case UintKind, IntKind, AddressKind, BoolKind, FixedBytesKind:
+		return true
+	}
+	return false
+}
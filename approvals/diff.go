@@ -0,0 +1,209 @@
+package approvals
+
+import (
+	"math"
+	"math/big"
+	"sort"
+	"strings"
+
+	"github.com/covalenthq/covalent-api-sdk-go/services"
+)
+
+// ChangeKind is the kind of a change of an approval between two snapshots.
+type ChangeKind string
+
+const (
+	// NewSpender is an approval granted since the earlier snapshot.
+	NewSpender ChangeKind = "new_spender"
+	// AllowanceIncreased is an allowance that grew, or an NFT approval that covers more tokens.
+	AllowanceIncreased ChangeKind = "allowance_increased"
+	// AllowanceDecreased is an allowance that shrank without reaching zero, or an NFT approval that covers fewer
+	// tokens.
+	AllowanceDecreased ChangeKind = "allowance_decreased"
+	// Revoked is an approval gone since the earlier snapshot, or set to zero.
+	Revoked ChangeKind = "revoked"
+	// ValueAtRiskChanged is a change of the value at risk of an approval, eg: with the price of the token.
+	ValueAtRiskChanged ChangeKind = "value_at_risk_changed"
+)
+
+type DiffOpts struct {
+	// The smallest change of the value at risk, in the quote currency, reported as ValueAtRiskChanged. Defaults to 0:
+	// any change.
+	MinValueAtRiskChange *float64 `json:"minValueAtRiskChange,omitempty"`
+}
+
+// Change is a change of an approval between two snapshots.
+type Change struct {
+	Kind ChangeKind
+	// The approval in the earlier and the later snapshot. Before is nil for NewSpender, After for an approval gone.
+	Before *Approval
+	After  *Approval
+}
+
+// Approval returns the approval the change is about, in the later snapshot when it is there.
+func (c Change) Approval() Approval {
+	if c.After != nil {
+		return *c.After
+	}
+	return *c.Before
+}
+
+// ValueAtRiskDelta returns the change of the value at risk in the quote currency, unknown values counting as 0.
+func (c Change) ValueAtRiskDelta() float64 {
+	var before, after float64
+	if c.Before != nil {
+		before = valueOf(c.Before.ValueAtRiskQuote)
+	}
+	if c.After != nil {
+		after = valueOf(c.After.ValueAtRiskQuote)
+	}
+	return after - before
+}
+
+// DiffTokenApprovals returns the changes of the ERC-20 approvals from before to after, two responses of GetApprovals
+// for the same wallet, sorted by contract, spender and kind.
+func DiffTokenApprovals(before services.ApprovalsResponse, after services.ApprovalsResponse, opts ...DiffOpts) ([]Change, error) {
+	beforeApprovals, err := TokenApprovals(before)
+	if err != nil {
+		return nil, err
+	}
+	afterApprovals, err := TokenApprovals(after)
+	if err != nil {
+		return nil, err
+	}
+	return diffApprovals(beforeApprovals, afterApprovals, opts...), nil
+}
+
+// DiffNftApprovals returns the changes of the NFT approvals from before to after, two responses of GetNftApprovals for
+// the same wallet, sorted by contract, spender and kind.
+func DiffNftApprovals(before services.NftApprovalsResponse, after services.NftApprovalsResponse, opts ...DiffOpts) ([]Change, error) {
+	beforeApprovals, err := NftApprovals(before)
+	if err != nil {
+		return nil, err
+	}
+	afterApprovals, err := NftApprovals(after)
+	if err != nil {
+		return nil, err
+	}
+	return diffApprovals(beforeApprovals, afterApprovals, opts...), nil
+}
+
+func diffApprovals(before []Approval, after []Approval, opts ...DiffOpts) []Change {
+	var options DiffOpts
+	if len(opts) > 0 {
+		options = opts[0]
+	}
+	minValueChange := 0.0
+	if options.MinValueAtRiskChange != nil {
+		minValueChange = *options.MinValueAtRiskChange
+	}
+
+	previous := map[string]*Approval{}
+	for i := range before {
+		previous[approvalKey(before[i])] = &before[i]
+	}
+	var changes []Change
+	for i := range after {
+		a := &after[i]
+		key := approvalKey(*a)
+		b, ok := previous[key]
+		delete(previous, key)
+		switch {
+		case !ok && !isRevoked(*a):
+			changes = append(changes, Change{Kind: NewSpender, After: a})
+			continue
+		case !ok:
+			continue
+		case isRevoked(*a) && !isRevoked(*b):
+			changes = append(changes, Change{Kind: Revoked, Before: b, After: a})
+			continue
+		}
+		switch compareAllowances(*b, *a) {
+		case -1:
+			changes = append(changes, Change{Kind: AllowanceIncreased, Before: b, After: a})
+		case 1:
+			changes = append(changes, Change{Kind: AllowanceDecreased, Before: b, After: a})
+		}
+		if change := (Change{Kind: ValueAtRiskChanged, Before: b, After: a}); (b.ValueAtRiskQuote != nil || a.ValueAtRiskQuote != nil) &&
+			change.ValueAtRiskDelta() != 0 && math.Abs(change.ValueAtRiskDelta()) >= minValueChange {
+			changes = append(changes, change)
+		}
+	}
+	for _, b := range previous {
+		if !isRevoked(*b) {
+			changes = append(changes, Change{Kind: Revoked, Before: b})
+		}
+	}
+
+	sort.SliceStable(changes, func(i, j int) bool {
+		a, b := changes[i].Approval(), changes[j].Approval()
+		if a.ContractAddress != b.ContractAddress {
+			return a.ContractAddress < b.ContractAddress
+		}
+		if a.SpenderAddress != b.SpenderAddress {
+			return a.SpenderAddress < b.SpenderAddress
+		}
+		return changes[i].Kind < changes[j].Kind
+	})
+	return changes
+}
+
+func approvalKey(a Approval) string {
+	return string(a.Kind) + ":" + a.ContractAddress + ":" + a.SpenderAddress
+}
+
+// isRevoked reports whether an approval grants nothing: an allowance of zero, or an NFT approval reported as `false`.
+func isRevoked(a Approval) bool {
+	if a.Kind == NftApproval {
+		return strings.EqualFold(strings.TrimSpace(a.Allowance), "false")
+	}
+	if a.Unlimited {
+		return false
+	}
+	value, ok := new(big.Rat).SetString(strings.TrimSpace(a.Allowance))
+	return ok && value.Sign() == 0
+}
+
+// compareAllowances returns -1, 0 or 1 as the allowance of a is smaller than, equal to or larger than the one of b.
+// Unlimited allowances are the largest. NFT approvals over some tokens compare by the tokens they cover: 0 when
+// neither covers all the tokens of the other, as the change then is neither an increase nor a decrease.
+func compareAllowances(a Approval, b Approval) int {
+	switch {
+	case a.Unlimited && b.Unlimited:
+		return 0
+	case a.Unlimited:
+		return 1
+	case b.Unlimited:
+		return -1
+	}
+	if a.Kind == NftApproval {
+		aCoversB, bCoversA := covers(a.TokenIds, b.TokenIds), covers(b.TokenIds, a.TokenIds)
+		switch {
+		case aCoversB && !bCoversA:
+			return 1
+		case bCoversA && !aCoversB:
+			return -1
+		}
+		return 0
+	}
+	aValue, aOk := new(big.Rat).SetString(strings.TrimSpace(a.Allowance))
+	bValue, bOk := new(big.Rat).SetString(strings.TrimSpace(b.Allowance))
+	if !aOk || !bOk {
+		return 0
+	}
+	return aValue.Cmp(bValue)
+}
+
+// covers reports whether ids contains all of others.
+func covers(ids []*big.Int, others []*big.Int) bool {
+	set := map[string]bool{}
+	for _, id := range ids {
+		set[id.String()] = true
+	}
+	for _, id := range others {
+		if !set[id.String()] {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,313 @@
+// Package approvals builds risk reports of the token and NFT approvals of a wallet from SecurityService, with the
+// unsigned transactions that revoke them.
+package approvals
+
+import (
+	"math/big"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/covalenthq/covalent-api-sdk-go/services"
+)
+
+// Kind is the kind of an approval.
+type Kind string
+
+const (
+	// TokenAllowance is an ERC-20 allowance, from GetApprovals.
+	TokenAllowance Kind = "erc20"
+	// NftApproval is an NFT approval, from GetNftApprovals: over a whole collection, or over some of its tokens.
+	NftApproval Kind = "nft"
+)
+
+// Risk is a reason an approval is flagged.
+type Risk string
+
+const (
+	// UnlimitedAllowance flags ERC-20 allowances the spender can use without limit, and NFT approvals over a whole
+	// collection.
+	UnlimitedAllowance Risk = "unlimited_allowance"
+	// StaleApproval flags approvals granted longer ago than ReportOpts.StaleAfter.
+	StaleApproval Risk = "stale_approval"
+	// UnlabeledSpender flags spenders the API has no label for, ie: not a known protocol.
+	UnlabeledSpender Risk = "unlabeled_spender"
+)
+
+// DefaultStaleAfter is the age past which approvals are stale unless ReportOpts.StaleAfter is set.
+const DefaultStaleAfter = 180 * 24 * time.Hour
+
+// unlimitedThreshold is the raw allowance from which an allowance is unlimited: half of the largest uint256, as
+// wallets approve the largest uint256 or close to it.
+var unlimitedThreshold = new(big.Int).Lsh(big.NewInt(1), 255)
+
+type ReportOpts struct {
+	// The age past which approvals are stale. Defaults to DefaultStaleAfter.
+	StaleAfter *time.Duration `json:"staleAfter,omitempty"`
+	// The time approvals are aged at. Defaults to now.
+	Now *time.Time `json:"now,omitempty"`
+}
+
+// Approval is an approval granted by the wallet to a spender.
+type Approval struct {
+	Kind Kind
+	// The lowercase address of the token or the collection.
+	ContractAddress string
+	ContractLabel   string
+	TickerSymbol    string
+	// The lowercase address of the spender or operator, and its label when the API knows it.
+	SpenderAddress string
+	SpenderLabel   string
+	// The allowance as the API reports it, eg: `UNLIMITED`, and whether it is unlimited.
+	Allowance string
+	Unlimited bool
+	// The token ids of an NFT approval that is not over the whole collection.
+	TokenIds []*big.Int
+	// The value the spender can take, in the quote currency. nil when the API does not value it, eg: for NFTs.
+	ValueAtRiskQuote *float64
+	// The risk factor of the API, eg: `CONSIDER REVOKING`.
+	RiskFactor string
+	// When and in which transaction the approval was granted. nil when unknown.
+	ApprovedAt *time.Time
+	TxHash     string
+	Risks      []Risk
+	// The transactions that revoke the approval: one, or one per token id of an NFT approval over some tokens.
+	Revoke []RevokeTx
+}
+
+// Flagged reports whether the approval has any risk.
+func (a Approval) Flagged() bool {
+	return len(a.Risks) > 0
+}
+
+// HasRisk reports whether the approval is flagged with risk.
+func (a Approval) HasRisk(risk Risk) bool {
+	for _, r := range a.Risks {
+		if r == risk {
+			return true
+		}
+	}
+	return false
+}
+
+// Report is the risk report of the approvals of a wallet.
+type Report struct {
+	Address   string
+	ChainName string
+	// The approvals, the highest value at risk first, then the flagged ones, then by contract and spender.
+	Approvals []Approval
+	// The sum of the value at risk of Approvals, in the quote currency of the ERC-20 approvals.
+	TotalValueAtRiskQuote float64
+	// The number of flagged approvals.
+	Flagged int
+}
+
+// BuildReport returns the risk report of the approvals in tokens and nfts, the responses of GetApprovals and
+// GetNftApprovals for the same wallet. Either may be nil. Approvals that grant nothing are never flagged.
+func BuildReport(tokens *services.ApprovalsResponse, nfts *services.NftApprovalsResponse, opts ...ReportOpts) (*Report, error) {
+	var options ReportOpts
+	if len(opts) > 0 {
+		options = opts[0]
+	}
+	staleAfter, now := DefaultStaleAfter, time.Now()
+	if options.StaleAfter != nil {
+		staleAfter = *options.StaleAfter
+	}
+	if options.Now != nil {
+		now = *options.Now
+	}
+
+	report := &Report{}
+	if tokens != nil {
+		report.Address, report.ChainName = tokens.Address, tokens.ChainName
+		approvals, err := TokenApprovals(*tokens)
+		if err != nil {
+			return nil, err
+		}
+		report.Approvals = append(report.Approvals, approvals...)
+	}
+	if nfts != nil {
+		report.Address, report.ChainName = nfts.Address, nfts.ChainName
+		approvals, err := NftApprovals(*nfts)
+		if err != nil {
+			return nil, err
+		}
+		report.Approvals = append(report.Approvals, approvals...)
+	}
+
+	for i := range report.Approvals {
+		approval := &report.Approvals[i]
+		if isRevoked(*approval) {
+			continue
+		}
+		if approval.Unlimited {
+			approval.Risks = append(approval.Risks, UnlimitedAllowance)
+		}
+		if approval.ApprovedAt != nil && now.Sub(*approval.ApprovedAt) > staleAfter {
+			approval.Risks = append(approval.Risks, StaleApproval)
+		}
+		if approval.SpenderLabel == "" {
+			approval.Risks = append(approval.Risks, UnlabeledSpender)
+		}
+		if approval.Flagged() {
+			report.Flagged++
+		}
+		if approval.ValueAtRiskQuote != nil {
+			report.TotalValueAtRiskQuote += *approval.ValueAtRiskQuote
+		}
+	}
+	sort.SliceStable(report.Approvals, func(i, j int) bool {
+		a, b := report.Approvals[i], report.Approvals[j]
+		if va, vb := valueOf(a.ValueAtRiskQuote), valueOf(b.ValueAtRiskQuote); va != vb {
+			return va > vb
+		}
+		if a.Flagged() != b.Flagged() {
+			return a.Flagged()
+		}
+		if a.ContractAddress != b.ContractAddress {
+			return a.ContractAddress < b.ContractAddress
+		}
+		return a.SpenderAddress < b.SpenderAddress
+	})
+	return report, nil
+}
+
+// TokenApprovals returns the ERC-20 approvals of resp, a response of GetApprovals, with their revoke transactions
+// and without risks.
+func TokenApprovals(resp services.ApprovalsResponse) ([]Approval, error) {
+	var approvals []Approval
+	for _, item := range resp.Items {
+		if item.TokenAddress == nil || item.Spenders == nil {
+			continue
+		}
+		decimals := 0
+		if item.ContractDecimals != nil {
+			decimals = *item.ContractDecimals
+		}
+		for _, spender := range *item.Spenders {
+			if spender.SpenderAddress == nil {
+				continue
+			}
+			approval := Approval{
+				Kind:             TokenAllowance,
+				ContractAddress:  strings.ToLower(*item.TokenAddress),
+				ContractLabel:    stringOf(item.TokenAddressLabel),
+				TickerSymbol:     stringOf(item.TickerSymbol),
+				SpenderAddress:   strings.ToLower(*spender.SpenderAddress),
+				SpenderLabel:     stringOf(spender.SpenderAddressLabel),
+				Allowance:        stringOf(spender.Allowance),
+				Unlimited:        isUnlimited(stringOf(spender.Allowance), decimals),
+				ValueAtRiskQuote: spender.ValueAtRiskQuote,
+				RiskFactor:       stringOf(spender.RiskFactor),
+				ApprovedAt:       spender.BlockSignedAt,
+				TxHash:           stringOf(spender.TxHash),
+			}
+			revoke, err := RevokeAllowance(approval.ContractAddress, approval.SpenderAddress)
+			if err != nil {
+				return nil, err
+			}
+			approval.Revoke = []RevokeTx{revoke}
+			approvals = append(approvals, approval)
+		}
+	}
+	return approvals, nil
+}
+
+// NftApprovals returns the NFT approvals of resp, a response of GetNftApprovals, with their revoke transactions and
+// without risks. Approvals whose TokenIdsApproved lists token ids are revoked token by token, the others over the
+// whole collection. Approvals with an allowance of `false` grant nothing and have no revoke transactions.
+func NftApprovals(resp services.NftApprovalsResponse) ([]Approval, error) {
+	var approvals []Approval
+	for _, item := range resp.Items {
+		if item.ContractAddress == nil || item.Spenders == nil {
+			continue
+		}
+		for _, spender := range *item.Spenders {
+			if spender.SpenderAddress == nil {
+				continue
+			}
+			approval := Approval{
+				Kind:            NftApproval,
+				ContractAddress: strings.ToLower(*item.ContractAddress),
+				ContractLabel:   stringOf(item.ContractAddressLabel),
+				TickerSymbol:    stringOf(item.ContractTickerSymbol),
+				SpenderAddress:  strings.ToLower(*spender.SpenderAddress),
+				SpenderLabel:    stringOf(spender.SpenderAddressLabel),
+				Allowance:       stringOf(spender.Allowance),
+				TokenIds:        tokenIds(stringOf(spender.TokenIdsApproved)),
+				ApprovedAt:      spender.BlockSignedAt,
+				TxHash:          stringOf(spender.TxHash),
+			}
+			if isRevoked(approval) {
+				// An operator approval of `false` grants nothing, whatever TokenIdsApproved says.
+				approval.TokenIds = nil
+				approvals = append(approvals, approval)
+				continue
+			}
+			approval.Unlimited = approval.TokenIds == nil
+			if approval.Unlimited {
+				revoke, err := RevokeApprovalForAll(approval.ContractAddress, approval.SpenderAddress)
+				if err != nil {
+					return nil, err
+				}
+				approval.Revoke = []RevokeTx{revoke}
+			}
+			for _, id := range approval.TokenIds {
+				revoke, err := RevokeTokenApproval(approval.ContractAddress, id)
+				if err != nil {
+					return nil, err
+				}
+				approval.Revoke = append(approval.Revoke, revoke)
+			}
+			approvals = append(approvals, approval)
+		}
+	}
+	return approvals, nil
+}
+
+// isUnlimited reports whether an allowance is unlimited: `UNLIMITED`, or a number that reaches unlimitedThreshold
+// either raw or in token units.
+func isUnlimited(allowance string, decimals int) bool {
+	allowance = strings.TrimSpace(allowance)
+	if strings.EqualFold(allowance, "unlimited") {
+		return true
+	}
+	value, ok := new(big.Rat).SetString(allowance)
+	if !ok {
+		return false
+	}
+	scale := new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(decimals)), nil)
+	raw := value.Mul(value, new(big.Rat).SetInt(scale))
+	return raw.Cmp(new(big.Rat).SetInt(unlimitedThreshold)) >= 0
+}
+
+// tokenIds reads the token ids of TokenIdsApproved, eg: `1, 2`. It returns nil for approvals over the whole
+// collection, eg: `ALL`, and when an id is not a number.
+func tokenIds(approved string) []*big.Int {
+	fields := strings.FieldsFunc(approved, func(r rune) bool {
+		return r == ',' || r == ' ' || r == '[' || r == ']' || r == '"'
+	})
+	var ids []*big.Int
+	for _, field := range fields {
+		id, ok := new(big.Int).SetString(field, 10)
+		if !ok || id.Sign() < 0 {
+			return nil
+		}
+		ids = append(ids, id)
+	}
+	return ids
+}
+
+func valueOf(f *float64) float64 {
+	if f == nil {
+		return 0
+	}
+	return *f
+}
+
+func stringOf(s *string) string {
+	if s == nil {
+		return ""
+	}
+	return *s
+}
@@ -0,0 +1,56 @@
+package approvals
+
+import (
+	"math/big"
+	"strings"
+
+	"github.com/covalenthq/covalent-api-sdk-go/abi"
+)
+
+var (
+	// ApproveFunction is `approve(address,uint256)`, shared by ERC-20 allowances and ERC-721 single token approvals.
+	ApproveFunction = abi.MustParseFunction("approve(address spender, uint256 value)")
+	// SetApprovalForAllFunction is `setApprovalForAll(address,bool)` of ERC-721 and ERC-1155 collections.
+	SetApprovalForAllFunction = abi.MustParseFunction("setApprovalForAll(address operator, bool approved)")
+)
+
+const zeroAddress = "0x0000000000000000000000000000000000000000"
+
+// RevokeTx is an unsigned transaction that revokes an approval, to be signed and sent by the owner.
+type RevokeTx struct {
+	// The contract the transaction is sent to: the token or the collection.
+	To string
+	// The call data as `0x`-prefixed hex. The transaction sends no value.
+	Data        string
+	Description string
+}
+
+// RevokeAllowance returns the transaction that sets the ERC-20 allowance of spender on token to zero:
+// `approve(spender, 0)`.
+func RevokeAllowance(tokenAddress string, spenderAddress string) (RevokeTx, error) {
+	data, err := ApproveFunction.EncodeCallHex(spenderAddress, big.NewInt(0))
+	if err != nil {
+		return RevokeTx{}, err
+	}
+	return RevokeTx{To: strings.ToLower(tokenAddress), Data: data, Description: "approve(" + strings.ToLower(spenderAddress) + ", 0)"}, nil
+}
+
+// RevokeApprovalForAll returns the transaction that revokes the approval of operator over a whole collection:
+// `setApprovalForAll(operator, false)`.
+func RevokeApprovalForAll(collectionAddress string, operatorAddress string) (RevokeTx, error) {
+	data, err := SetApprovalForAllFunction.EncodeCallHex(operatorAddress, false)
+	if err != nil {
+		return RevokeTx{}, err
+	}
+	return RevokeTx{To: strings.ToLower(collectionAddress), Data: data, Description: "setApprovalForAll(" + strings.ToLower(operatorAddress) + ", false)"}, nil
+}
+
+// RevokeTokenApproval returns the transaction that clears the approval of a single ERC-721 token:
+// `approve(address(0), tokenId)`.
+func RevokeTokenApproval(collectionAddress string, tokenId *big.Int) (RevokeTx, error) {
+	data, err := ApproveFunction.EncodeCallHex(zeroAddress, tokenId)
+	if err != nil {
+		return RevokeTx{}, err
+	}
+	return RevokeTx{To: strings.ToLower(collectionAddress), Data: data, Description: "approve(" + zeroAddress + ", " + tokenId.String() + ")"}, nil
+}
@@ -0,0 +1,169 @@
+package approvals
+
+import (
+	"encoding/json"
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/covalenthq/covalent-api-sdk-go/services"
+)
+
+// Snapshot holds the approvals of a wallet at a point in time. Either response may be nil.
+type Snapshot struct {
+	TakenAt time.Time                      `json:"taken_at"`
+	Tokens  *services.ApprovalsResponse    `json:"tokens,omitempty"`
+	Nfts    *services.NftApprovalsResponse `json:"nfts,omitempty"`
+}
+
+// SnapshotStore persists the latest snapshot of each wallet, so that a polling job can diff the next one with it.
+type SnapshotStore interface {
+	// Load returns the snapshot saved under key, nil when there is none.
+	Load(key string) (*Snapshot, error)
+	// Save saves snapshot under key, replacing the one saved before.
+	Save(key string, snapshot Snapshot) error
+}
+
+// SnapshotKey returns the key of the snapshots of walletAddress on chainName, eg: `eth-mainnet:0xabc...`.
+func SnapshotKey(chainName string, walletAddress string) string {
+	return chainName + ":" + strings.ToLower(walletAddress)
+}
+
+// DiffSnapshots returns the changes of the approvals from before to after, ERC-20 changes first. A response missing
+// from either snapshot is not compared.
+func DiffSnapshots(before Snapshot, after Snapshot, opts ...DiffOpts) ([]Change, error) {
+	var changes []Change
+	if before.Tokens != nil && after.Tokens != nil {
+		tokenChanges, err := DiffTokenApprovals(*before.Tokens, *after.Tokens, opts...)
+		if err != nil {
+			return nil, err
+		}
+		changes = append(changes, tokenChanges...)
+	}
+	if before.Nfts != nil && after.Nfts != nil {
+		nftChanges, err := DiffNftApprovals(*before.Nfts, *after.Nfts, opts...)
+		if err != nil {
+			return nil, err
+		}
+		changes = append(changes, nftChanges...)
+	}
+	return changes, nil
+}
+
+// Record diffs snapshot with the one saved under key in store, then saves snapshot in its place. It returns no change
+// the first time a key is recorded. A response missing from snapshot is carried over from the saved one, so that the
+// next snapshot holding it is diffed with the last one seen.
+func Record(store SnapshotStore, key string, snapshot Snapshot, opts ...DiffOpts) ([]Change, error) {
+	previous, err := store.Load(key)
+	if err != nil {
+		return nil, err
+	}
+	var changes []Change
+	if previous != nil {
+		if changes, err = DiffSnapshots(*previous, snapshot, opts...); err != nil {
+			return nil, err
+		}
+		if snapshot.Tokens == nil {
+			snapshot.Tokens = previous.Tokens
+		}
+		if snapshot.Nfts == nil {
+			snapshot.Nfts = previous.Nfts
+		}
+	}
+	if err := store.Save(key, snapshot); err != nil {
+		return nil, err
+	}
+	return changes, nil
+}
+
+// MemoryStore is a SnapshotStore that keeps snapshots in memory. It is safe for concurrent use.
+type MemoryStore struct {
+	mu        sync.RWMutex
+	snapshots map[string]Snapshot
+}
+
+// NewMemoryStore is a constructor function for MemoryStore.
+func NewMemoryStore() *MemoryStore {
+	return &MemoryStore{snapshots: map[string]Snapshot{}}
+}
+
+func (s *MemoryStore) Load(key string) (*Snapshot, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	snapshot, ok := s.snapshots[key]
+	if !ok {
+		return nil, nil
+	}
+	return &snapshot, nil
+}
+
+func (s *MemoryStore) Save(key string, snapshot Snapshot) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.snapshots[key] = snapshot
+	return nil
+}
+
+// FileStore is a SnapshotStore that keeps each snapshot in a JSON file of a directory.
+type FileStore struct {
+	Dir string
+}
+
+// NewFileStore is a constructor function for FileStore. The directory is created on the first save.
+func NewFileStore(dir string) *FileStore {
+	return &FileStore{Dir: dir}
+}
+
+func (s *FileStore) Load(key string) (*Snapshot, error) {
+	data, err := os.ReadFile(s.path(key))
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	var snapshot Snapshot
+	if err := json.Unmarshal(data, &snapshot); err != nil {
+		return nil, err
+	}
+	return &snapshot, nil
+}
+
+// Save writes the snapshot to a temporary file renamed over the previous one, so that a crash never leaves a partial
+// snapshot.
+func (s *FileStore) Save(key string, snapshot Snapshot) error {
+	data, err := json.Marshal(snapshot)
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(s.Dir, 0o755); err != nil {
+		return err
+	}
+	tmp, err := os.CreateTemp(s.Dir, ".snapshot-*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+	if _, err := tmp.Write(data); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+	return os.Rename(tmp.Name(), s.path(key))
+}
+
+// path returns the file of key, with the characters that are not safe in file names replaced.
+func (s *FileStore) path(key string) string {
+	name := strings.Map(func(r rune) rune {
+		if r >= 'a' && r <= 'z' || r >= 'A' && r <= 'Z' || r >= '0' && r <= '9' || r == '-' || r == '_' || r == '.' {
+			return r
+		}
+		return '_'
+	}, key)
+	return filepath.Join(s.Dir, name+".json")
+}
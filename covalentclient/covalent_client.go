@@ -1,6 +1,8 @@
 package covalentclient
 
 import (
+	"context"
+
 	"github.com/covalenthq/covalent-api-sdk-go/services"
 	"github.com/covalenthq/covalent-api-sdk-go/utils"
 )
@@ -10,6 +12,8 @@ type CovalentClientSettings struct {
 	Debug *bool `json:"debug,omitempty"`
 	//  The number of concurrent requests allowed.
 	ThreadCount *int `json:"thread_count,omitempty"`
+	// Supplies the API key for each request, eg: a utils.KeyPool. Takes the place of the apiKey argument.
+	KeyProvider utils.KeyProvider `json:"-"`
 }
 
 type CovalentClientType struct {
@@ -22,6 +26,11 @@ type CovalentClientType struct {
 	XykService         services.XykService
 	Debug              bool
 	ThreadCount        int
+	KeyProvider        utils.KeyProvider
+
+	apiKey          string
+	isValidKey      bool
+	requestSettings utils.RequestSettings
 }
 
 var defaultDebug bool = false
@@ -50,16 +59,41 @@ func CovalentClient(apiKey string, settings ...CovalentClientSettings) *Covalent
 		} else {
 			client.ThreadCount = *setting.ThreadCount
 		}
+
+		if setting.KeyProvider != nil {
+			client.KeyProvider = setting.KeyProvider
+			isValidKey = true
+		}
 	}
 
-	client.SecurityService = services.NewSecurityServiceImpl(apiKey, client.Debug, client.ThreadCount, isValidKey)
-	client.BalanceService = services.NewBalanceServiceImpl(apiKey, client.Debug, client.ThreadCount, isValidKey)
-	client.BaseService = services.NewBaseServiceImpl(apiKey, client.Debug, client.ThreadCount, isValidKey)
-	client.NftService = services.NewNftServiceImpl(apiKey, client.Debug, client.ThreadCount, isValidKey)
-	client.PricingService = services.NewPricingServiceImpl(apiKey, client.Debug, client.ThreadCount, isValidKey)
-	client.TransactionService = services.NewTransactionServiceImpl(apiKey, client.Debug, client.ThreadCount, isValidKey)
-	client.XykService = services.NewXykServiceImpl(apiKey, client.Debug, client.ThreadCount, isValidKey)
+	client.apiKey = apiKey
+	client.isValidKey = isValidKey
+	client.requestSettings = utils.NewRequestSettings(apiKey, utils.RequestSettings{KeyProvider: client.KeyProvider})
+	client.KeyProvider = client.requestSettings.KeyProvider
+	client.initServices()
 
 	return client
 
 }
+
+// WithContext returns a copy of the client whose services make their requests with ctx.
+// Use utils.WithAPIKey on ctx to override the client's API key for the calls made through the copy.
+func (c *CovalentClientType) WithContext(ctx context.Context) *CovalentClientType {
+	client := *c
+	client.requestSettings.Context = ctx
+	if apiKey, ok := utils.APIKeyFromContext(ctx); ok {
+		client.isValidKey = utils.NewApiKeyValidator(apiKey).IsValidApiKey()
+	}
+	client.initServices()
+	return &client
+}
+
+func (c *CovalentClientType) initServices() {
+	c.SecurityService = services.NewSecurityServiceImpl(c.apiKey, c.Debug, c.ThreadCount, c.isValidKey, c.requestSettings)
+	c.BalanceService = services.NewBalanceServiceImpl(c.apiKey, c.Debug, c.ThreadCount, c.isValidKey, c.requestSettings)
+	c.BaseService = services.NewBaseServiceImpl(c.apiKey, c.Debug, c.ThreadCount, c.isValidKey, c.requestSettings)
+	c.NftService = services.NewNftServiceImpl(c.apiKey, c.Debug, c.ThreadCount, c.isValidKey, c.requestSettings)
+	c.PricingService = services.NewPricingServiceImpl(c.apiKey, c.Debug, c.ThreadCount, c.isValidKey, c.requestSettings)
+	c.TransactionService = services.NewTransactionServiceImpl(c.apiKey, c.Debug, c.ThreadCount, c.isValidKey, c.requestSettings)
+	c.XykService = services.NewXykServiceImpl(c.apiKey, c.Debug, c.ThreadCount, c.isValidKey, c.requestSettings)
+}
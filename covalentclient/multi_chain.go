@@ -0,0 +1,87 @@
+package covalentclient
+
+import (
+	"fmt"
+	"sync"
+
+	"github.com/covalenthq/covalent-api-sdk-go/chains"
+	"github.com/covalenthq/covalent-api-sdk-go/services"
+)
+
+// MultiChainResult holds the outcome of a call fanned out over several chains.
+// A chain appears in exactly one of Results or Errors.
+type MultiChainResult[T any] struct {
+	// The successful result of the call for each chain.
+	Results map[chains.Chain]T
+	// The error returned by the call for each chain that failed.
+	Errors map[chains.Chain]error
+}
+
+// ActiveChains returns the chains on which walletAddress has activity, as reported by GetAddressActivity.
+func ActiveChains(client *CovalentClientType, walletAddress string, queryParamOpts ...services.GetAddressActivityQueryParamOpts) ([]chains.Chain, error) {
+	resp, err := client.BaseService.GetAddressActivity(walletAddress, queryParamOpts...)
+	if err != nil {
+		return nil, err
+	}
+	if resp.Data == nil {
+		return nil, fmt.Errorf("no address activity returned for %s", walletAddress)
+	}
+
+	activeChains := make([]chains.Chain, 0, len(resp.Data.Items))
+	for _, item := range resp.Data.Items {
+		if item.Name != nil {
+			activeChains = append(activeChains, chains.Chain(*item.Name))
+		}
+	}
+	return activeChains, nil
+}
+
+// ForEachChain runs call for walletAddress on every chain in chainNames, at most client.ThreadCount at a time.
+// When chainNames is empty, the call runs on every chain where the address is active.
+// A failing chain is recorded in the result's Errors and does not stop the others; the returned error is only
+// set when the active chains could not be looked up.
+func ForEachChain[T any](client *CovalentClientType, walletAddress string, chainNames []chains.Chain, call func(chainName chains.Chain, walletAddress string) (T, error)) (*MultiChainResult[T], error) {
+	if len(chainNames) == 0 {
+		activeChains, err := ActiveChains(client, walletAddress)
+		if err != nil {
+			return nil, err
+		}
+		chainNames = activeChains
+	}
+
+	threadCount := client.ThreadCount
+	if threadCount < 1 {
+		threadCount = 1
+	}
+
+	result := &MultiChainResult[T]{
+		Results: make(map[chains.Chain]T),
+		Errors:  make(map[chains.Chain]error),
+	}
+
+	var mu sync.Mutex
+	var wg sync.WaitGroup
+	sem := make(chan struct{}, threadCount)
+
+	for _, chainName := range chainNames {
+		wg.Add(1)
+		sem <- struct{}{}
+		go func(chainName chains.Chain) {
+			defer wg.Done()
+			defer func() { <-sem }()
+
+			value, err := call(chainName, walletAddress)
+
+			mu.Lock()
+			defer mu.Unlock()
+			if err != nil {
+				result.Errors[chainName] = err
+			} else {
+				result.Results[chainName] = value
+			}
+		}(chainName)
+	}
+	wg.Wait()
+
+	return result, nil
+}
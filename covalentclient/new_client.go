@@ -0,0 +1,298 @@
+package covalentclient
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/covalenthq/covalent-api-sdk-go/utils"
+)
+
+// Environment variables read by WithEnv.
+const (
+	EnvAPIKey      = "COVALENT_API_KEY"
+	EnvBaseURL     = "COVALENT_BASE_URL"
+	EnvTimeout     = "COVALENT_TIMEOUT"
+	EnvMaxRetries  = "COVALENT_MAX_RETRIES"
+	EnvRateLimit   = "COVALENT_RATE_LIMIT"
+	EnvThreadCount = "COVALENT_THREAD_COUNT"
+	EnvDebug       = "COVALENT_DEBUG"
+)
+
+// ClientConfig holds the settings NewClient builds a client from. Options fill it in order, so later options win.
+type ClientConfig struct {
+	// The Covalent API key. Must match the v1 or v2 key pattern unless a KeyProvider is set.
+	APIKey string `json:"api_key,omitempty"`
+	// The scheme and host requests are sent to. Defaults to `https://api.covalenthq.com`.
+	BaseURL string `json:"base_url,omitempty"`
+	// The time limit for each request, eg: `30s`. Zero means no limit.
+	Timeout Duration `json:"timeout,omitempty"`
+	// The number of retries after the first attempt of a rate limited request. 0 disables retries, so the 429 response is
+	// returned. Defaults to 5 when unset.
+	MaxRetries *int `json:"max_retries,omitempty"`
+	// The number of requests sent per second across all services. Zero means no limit.
+	RateLimit float64 `json:"rate_limit,omitempty"`
+	// The number of concurrent requests allowed. Defaults to 3.
+	ThreadCount int `json:"thread_count,omitempty"`
+	// Toggle to analyze the execution of each api request.
+	Debug bool `json:"debug,omitempty"`
+	// Supplies the API key for each request instead of APIKey.
+	KeyProvider utils.KeyProvider `json:"-"`
+	// The HTTP client requests are sent with. Timeout and RateLimit are applied to a copy of it.
+	HTTPClient *http.Client `json:"-"`
+	// Called with the metadata of every response, including each page of a paginated endpoint.
+	OnResponse func(*utils.ResponseMeta) `json:"-"`
+	// Checks every response for fields missing from or mistyped in the SDK models. Off when nil.
+	StrictDecoding *utils.StrictDecoding `json:"-"`
+}
+
+// Duration is a time.Duration that reads from JSON as a string such as `30s` or as a number of seconds.
+type Duration time.Duration
+
+func (d *Duration) UnmarshalJSON(b []byte) error {
+	var s string
+	if err := json.Unmarshal(b, &s); err == nil {
+		parsed, err := time.ParseDuration(s)
+		if err != nil {
+			return err
+		}
+		*d = Duration(parsed)
+		return nil
+	}
+	var seconds float64
+	if err := json.Unmarshal(b, &seconds); err != nil {
+		return fmt.Errorf("invalid duration: %s", string(b))
+	}
+	*d = Duration(seconds * float64(time.Second))
+	return nil
+}
+
+func (d Duration) MarshalJSON() ([]byte, error) {
+	return json.Marshal(time.Duration(d).String())
+}
+
+// Option changes the ClientConfig NewClient builds a client from.
+type Option func(*ClientConfig) error
+
+func WithAPIKey(apiKey string) Option {
+	return func(c *ClientConfig) error {
+		c.APIKey = apiKey
+		return nil
+	}
+}
+
+func WithBaseURL(baseURL string) Option {
+	return func(c *ClientConfig) error {
+		c.BaseURL = baseURL
+		return nil
+	}
+}
+
+func WithTimeout(timeout time.Duration) Option {
+	return func(c *ClientConfig) error {
+		c.Timeout = Duration(timeout)
+		return nil
+	}
+}
+
+func WithMaxRetries(maxRetries int) Option {
+	return func(c *ClientConfig) error {
+		c.MaxRetries = &maxRetries
+		return nil
+	}
+}
+
+func WithRateLimit(requestsPerSecond float64) Option {
+	return func(c *ClientConfig) error {
+		c.RateLimit = requestsPerSecond
+		return nil
+	}
+}
+
+func WithThreadCount(threadCount int) Option {
+	return func(c *ClientConfig) error {
+		c.ThreadCount = threadCount
+		return nil
+	}
+}
+
+func WithDebug(debug bool) Option {
+	return func(c *ClientConfig) error {
+		c.Debug = debug
+		return nil
+	}
+}
+
+func WithKeyProvider(keyProvider utils.KeyProvider) Option {
+	return func(c *ClientConfig) error {
+		c.KeyProvider = keyProvider
+		return nil
+	}
+}
+
+func WithHTTPClient(httpClient *http.Client) Option {
+	return func(c *ClientConfig) error {
+		c.HTTPClient = httpClient
+		return nil
+	}
+}
+
+func WithResponseHook(onResponse func(*utils.ResponseMeta)) Option {
+	return func(c *ClientConfig) error {
+		c.OnResponse = onResponse
+		return nil
+	}
+}
+
+// WithStrictDecoding reports the schema drift found in each response to onDrift.
+// Calls only fail because of drift when failOnDrift is set.
+func WithStrictDecoding(onDrift func(*utils.SchemaDriftError), failOnDrift bool) Option {
+	return func(c *ClientConfig) error {
+		c.StrictDecoding = &utils.StrictDecoding{OnDrift: onDrift, FailOnDrift: failOnDrift}
+		return nil
+	}
+}
+
+// WithEnv reads the settings that are set among the COVALENT_* environment variables.
+// COVALENT_TIMEOUT takes a duration such as `30s`.
+func WithEnv() Option {
+	return func(c *ClientConfig) error {
+		if value, ok := os.LookupEnv(EnvAPIKey); ok {
+			c.APIKey = strings.TrimSpace(value)
+		}
+		if value, ok := os.LookupEnv(EnvBaseURL); ok {
+			c.BaseURL = strings.TrimSpace(value)
+		}
+		if value, ok := os.LookupEnv(EnvTimeout); ok {
+			timeout, err := time.ParseDuration(strings.TrimSpace(value))
+			if err != nil {
+				return fmt.Errorf("%s: %w", EnvTimeout, err)
+			}
+			c.Timeout = Duration(timeout)
+		}
+		if value, ok := os.LookupEnv(EnvMaxRetries); ok {
+			maxRetries, err := strconv.Atoi(strings.TrimSpace(value))
+			if err != nil {
+				return fmt.Errorf("%s: %w", EnvMaxRetries, err)
+			}
+			c.MaxRetries = &maxRetries
+		}
+		if value, ok := os.LookupEnv(EnvRateLimit); ok {
+			rateLimit, err := strconv.ParseFloat(strings.TrimSpace(value), 64)
+			if err != nil {
+				return fmt.Errorf("%s: %w", EnvRateLimit, err)
+			}
+			c.RateLimit = rateLimit
+		}
+		if value, ok := os.LookupEnv(EnvThreadCount); ok {
+			threadCount, err := strconv.Atoi(strings.TrimSpace(value))
+			if err != nil {
+				return fmt.Errorf("%s: %w", EnvThreadCount, err)
+			}
+			c.ThreadCount = threadCount
+		}
+		if value, ok := os.LookupEnv(EnvDebug); ok {
+			debug, err := strconv.ParseBool(strings.TrimSpace(value))
+			if err != nil {
+				return fmt.Errorf("%s: %w", EnvDebug, err)
+			}
+			c.Debug = debug
+		}
+		return nil
+	}
+}
+
+// WithConfigFile reads the settings present in a JSON file with the same keys as ClientConfig's json tags.
+func WithConfigFile(path string) Option {
+	return func(c *ClientConfig) error {
+		content, err := os.ReadFile(path)
+		if err != nil {
+			return err
+		}
+		decoder := json.NewDecoder(bytes.NewReader(content))
+		decoder.DisallowUnknownFields()
+		if err := decoder.Decode(c); err != nil {
+			return fmt.Errorf("config file %s: %w", path, err)
+		}
+		return nil
+	}
+}
+
+// NewClient builds a client from opts and returns an error when the resulting configuration is invalid,
+// such as a missing or malformed API key.
+func NewClient(opts ...Option) (*CovalentClientType, error) {
+	config := ClientConfig{ThreadCount: defaultThreadCount, Debug: defaultDebug}
+	for _, opt := range opts {
+		if err := opt(&config); err != nil {
+			return nil, err
+		}
+	}
+
+	if config.KeyProvider == nil && !utils.NewApiKeyValidator(config.APIKey).IsValidApiKey() {
+		return nil, fmt.Errorf(utils.InvalidAPIKeyMessage)
+	}
+	if config.BaseURL == "" {
+		config.BaseURL = utils.DefaultBaseURL
+	}
+	baseURL, err := url.Parse(config.BaseURL)
+	if err != nil || (baseURL.Scheme != "http" && baseURL.Scheme != "https") || baseURL.Host == "" {
+		return nil, fmt.Errorf("invalid base URL: %q", config.BaseURL)
+	}
+	if config.Timeout < 0 {
+		return nil, fmt.Errorf("timeout cannot be negative: %s", time.Duration(config.Timeout))
+	}
+	maxRetries := 0
+	if config.MaxRetries != nil {
+		if *config.MaxRetries < 0 {
+			return nil, fmt.Errorf("max retries cannot be negative: %d", *config.MaxRetries)
+		}
+		maxRetries = *config.MaxRetries
+		if maxRetries == 0 {
+			// RequestSettings reads 0 as the default
+			maxRetries = -1
+		}
+	}
+	if config.RateLimit < 0 {
+		return nil, fmt.Errorf("rate limit cannot be negative: %v", config.RateLimit)
+	}
+	if config.ThreadCount < 1 {
+		return nil, fmt.Errorf("thread count must be at least 1: %d", config.ThreadCount)
+	}
+
+	httpClient := &http.Client{}
+	if config.HTTPClient != nil {
+		copied := *config.HTTPClient
+		httpClient = &copied
+	}
+	if config.Timeout > 0 {
+		httpClient.Timeout = time.Duration(config.Timeout)
+	}
+	if config.RateLimit > 0 {
+		httpClient.Transport = &utils.RateLimitedTransport{Base: httpClient.Transport, Limiter: utils.NewRateLimiter(config.RateLimit)}
+	}
+
+	client := &CovalentClientType{
+		Debug:       config.Debug,
+		ThreadCount: config.ThreadCount,
+		apiKey:      config.APIKey,
+		isValidKey:  true,
+	}
+	client.requestSettings = utils.NewRequestSettings(config.APIKey, utils.RequestSettings{
+		KeyProvider:    config.KeyProvider,
+		BaseURL:        strings.TrimRight(config.BaseURL, "/"),
+		HTTPClient:     httpClient,
+		MaxRetries:     maxRetries,
+		OnResponse:     config.OnResponse,
+		StrictDecoding: config.StrictDecoding,
+	})
+	client.KeyProvider = client.requestSettings.KeyProvider
+	client.initServices()
+
+	return client, nil
+}
@@ -0,0 +1,143 @@
+// Package dexevents decodes the Swap, Mint, Burn and Sync events of Uniswap V2 and V3 style pools from log events,
+// and converts their raw amounts and prices with the decimals of the pool tokens.
+package dexevents
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/covalenthq/covalent-api-sdk-go/abi"
+	"github.com/covalenthq/covalent-api-sdk-go/genericmodels"
+)
+
+// ErrNotDexEvent is returned when a log event is not a pool event of this package.
+var ErrNotDexEvent = errors.New("dexevents: log event is not a Uniswap V2 or V3 pool event")
+
+// Kind identifies a pool event.
+type Kind string
+
+const (
+	Unknown    Kind = ""
+	V2SwapKind Kind = "v2_swap"
+	V2MintKind Kind = "v2_mint"
+	V2BurnKind Kind = "v2_burn"
+	V2SyncKind Kind = "v2_sync"
+	V3SwapKind Kind = "v3_swap"
+	V3MintKind Kind = "v3_mint"
+	V3BurnKind Kind = "v3_burn"
+)
+
+var poolABI = abi.MustParse(`[
+	{"type":"event","name":"Swap","inputs":[
+		{"name":"sender","type":"address","indexed":true},
+		{"name":"amount0In","type":"uint256","indexed":false},
+		{"name":"amount1In","type":"uint256","indexed":false},
+		{"name":"amount0Out","type":"uint256","indexed":false},
+		{"name":"amount1Out","type":"uint256","indexed":false},
+		{"name":"to","type":"address","indexed":true}]},
+	{"type":"event","name":"Mint","inputs":[
+		{"name":"sender","type":"address","indexed":true},
+		{"name":"amount0","type":"uint256","indexed":false},
+		{"name":"amount1","type":"uint256","indexed":false}]},
+	{"type":"event","name":"Burn","inputs":[
+		{"name":"sender","type":"address","indexed":true},
+		{"name":"amount0","type":"uint256","indexed":false},
+		{"name":"amount1","type":"uint256","indexed":false},
+		{"name":"to","type":"address","indexed":true}]},
+	{"type":"event","name":"Sync","inputs":[
+		{"name":"reserve0","type":"uint112","indexed":false},
+		{"name":"reserve1","type":"uint112","indexed":false}]},
+	{"type":"event","name":"Swap","inputs":[
+		{"name":"sender","type":"address","indexed":true},
+		{"name":"recipient","type":"address","indexed":true},
+		{"name":"amount0","type":"int256","indexed":false},
+		{"name":"amount1","type":"int256","indexed":false},
+		{"name":"sqrtPriceX96","type":"uint160","indexed":false},
+		{"name":"liquidity","type":"uint128","indexed":false},
+		{"name":"tick","type":"int24","indexed":false}]},
+	{"type":"event","name":"Mint","inputs":[
+		{"name":"sender","type":"address","indexed":false},
+		{"name":"owner","type":"address","indexed":true},
+		{"name":"tickLower","type":"int24","indexed":true},
+		{"name":"tickUpper","type":"int24","indexed":true},
+		{"name":"amount","type":"uint128","indexed":false},
+		{"name":"amount0","type":"uint256","indexed":false},
+		{"name":"amount1","type":"uint256","indexed":false}]},
+	{"type":"event","name":"Burn","inputs":[
+		{"name":"owner","type":"address","indexed":true},
+		{"name":"tickLower","type":"int24","indexed":true},
+		{"name":"tickUpper","type":"int24","indexed":true},
+		{"name":"amount","type":"uint128","indexed":false},
+		{"name":"amount0","type":"uint256","indexed":false},
+		{"name":"amount1","type":"uint256","indexed":false}]}
+]`)
+
+var kinds = []Kind{V2SwapKind, V2MintKind, V2BurnKind, V2SyncKind, V3SwapKind, V3MintKind, V3BurnKind}
+
+// The topics pool events log first, as lowercase `0x`-prefixed hex.
+var (
+	V2SwapTopic = poolABI.Events[0].Topic()
+	V2MintTopic = poolABI.Events[1].Topic()
+	V2BurnTopic = poolABI.Events[2].Topic()
+	V2SyncTopic = poolABI.Events[3].Topic()
+	V3SwapTopic = poolABI.Events[4].Topic()
+	V3MintTopic = poolABI.Events[5].Topic()
+	V3BurnTopic = poolABI.Events[6].Topic()
+)
+
+// Classify returns the kind of pool event log is, or Unknown. It reads the first raw topic when the log has one, and
+// otherwise the name of Decoded and its number of params, which tells V2 and V3 events apart.
+func Classify(log genericmodels.LogEvent) Kind {
+	if log.RawLogTopics != nil && len(*log.RawLogTopics) > 0 {
+		topic := strings.ToLower((*log.RawLogTopics)[0])
+		for i, event := range poolABI.Events {
+			if event.Topic() == topic {
+				return kinds[i]
+			}
+		}
+		return Unknown
+	}
+	if log.Decoded == nil || log.Decoded.Name == nil || log.Decoded.Params == nil {
+		return Unknown
+	}
+	for i, event := range poolABI.Events {
+		if event.Name == *log.Decoded.Name && len(event.Inputs) == len(*log.Decoded.Params) {
+			return kinds[i]
+		}
+	}
+	return Unknown
+}
+
+// Decode classifies log and returns it as one of the event structs of this package: *V2Swap, *V2Mint, *V2Burn,
+// *V2Sync, *V3Swap, *V3Mint or *V3Burn. It returns ErrNotDexEvent for other log events.
+func Decode(log genericmodels.LogEvent) (interface{}, error) {
+	kind := Classify(log)
+	var event interface{}
+	switch kind {
+	case V2SwapKind:
+		event = &V2Swap{}
+	case V2MintKind:
+		event = &V2Mint{}
+	case V2BurnKind:
+		event = &V2Burn{}
+	case V2SyncKind:
+		event = &V2Sync{}
+	case V3SwapKind:
+		event = &V3Swap{}
+	case V3MintKind:
+		event = &V3Mint{}
+	case V3BurnKind:
+		event = &V3Burn{}
+	default:
+		return nil, ErrNotDexEvent
+	}
+	for i := range kinds {
+		if kinds[i] == kind {
+			if err := poolABI.Events[i].Bind(log, event); err != nil {
+				return nil, fmt.Errorf("dexevents: %s: %w", kind, err)
+			}
+		}
+	}
+	return event, nil
+}
@@ -0,0 +1,220 @@
+package dexevents
+
+import (
+	"fmt"
+	"math/big"
+
+	"github.com/covalenthq/covalent-api-sdk-go/services"
+	"github.com/covalenthq/covalent-api-sdk-go/utils"
+)
+
+// V2Swap is a Uniswap V2 `Swap(address,uint256,uint256,uint256,uint256,address)` event.
+type V2Swap struct {
+	Sender     utils.Address `abi:"sender"`
+	Amount0In  *big.Int      `abi:"amount0In"`
+	Amount1In  *big.Int      `abi:"amount1In"`
+	Amount0Out *big.Int      `abi:"amount0Out"`
+	Amount1Out *big.Int      `abi:"amount1Out"`
+	To         utils.Address `abi:"to"`
+}
+
+// V2Mint is a Uniswap V2 `Mint(address,uint256,uint256)` event.
+type V2Mint struct {
+	Sender  utils.Address `abi:"sender"`
+	Amount0 *big.Int      `abi:"amount0"`
+	Amount1 *big.Int      `abi:"amount1"`
+}
+
+// V2Burn is a Uniswap V2 `Burn(address,uint256,uint256,address)` event.
+type V2Burn struct {
+	Sender  utils.Address `abi:"sender"`
+	Amount0 *big.Int      `abi:"amount0"`
+	Amount1 *big.Int      `abi:"amount1"`
+	To      utils.Address `abi:"to"`
+}
+
+// V2Sync is a Uniswap V2 `Sync(uint112,uint112)` event, logged with the reserves of the pool after each change.
+type V2Sync struct {
+	Reserve0 *big.Int `abi:"reserve0"`
+	Reserve1 *big.Int `abi:"reserve1"`
+}
+
+// V3Swap is a Uniswap V3 `Swap(address,address,int256,int256,uint160,uint128,int24)` event. Amount0 and Amount1
+// are the amounts the pool received, negative for the amounts it paid out.
+type V3Swap struct {
+	Sender       utils.Address `abi:"sender"`
+	Recipient    utils.Address `abi:"recipient"`
+	Amount0      *big.Int      `abi:"amount0"`
+	Amount1      *big.Int      `abi:"amount1"`
+	SqrtPriceX96 *big.Int      `abi:"sqrtPriceX96"`
+	Liquidity    *big.Int      `abi:"liquidity"`
+	Tick         int32         `abi:"tick"`
+}
+
+// V3Mint is a Uniswap V3 `Mint(address,address,int24,int24,uint128,uint256,uint256)` event.
+type V3Mint struct {
+	Sender    utils.Address `abi:"sender"`
+	Owner     utils.Address `abi:"owner"`
+	TickLower int32         `abi:"tickLower"`
+	TickUpper int32         `abi:"tickUpper"`
+	Amount    *big.Int      `abi:"amount"`
+	Amount0   *big.Int      `abi:"amount0"`
+	Amount1   *big.Int      `abi:"amount1"`
+}
+
+// V3Burn is a Uniswap V3 `Burn(address,int24,int24,uint128,uint256,uint256)` event.
+type V3Burn struct {
+	Owner     utils.Address `abi:"owner"`
+	TickLower int32         `abi:"tickLower"`
+	TickUpper int32         `abi:"tickUpper"`
+	Amount    *big.Int      `abi:"amount"`
+	Amount0   *big.Int      `abi:"amount0"`
+	Amount1   *big.Int      `abi:"amount1"`
+}
+
+// Pair holds the decimals of the two tokens of a pool, whose events only log raw amounts.
+type Pair struct {
+	Decimals0 int
+	Decimals1 int
+}
+
+// PairOf returns the pair of the tokens of a pool, eg: Pool.Token0 and Pool.Token1.
+func PairOf(token0 *services.Token, token1 *services.Token) (Pair, error) {
+	if token0 == nil || token0.ContractDecimals == nil || *token0.ContractDecimals < 0 ||
+		token1 == nil || token1.ContractDecimals == nil || *token1.ContractDecimals < 0 {
+		return Pair{}, fmt.Errorf("dexevents: pool tokens without contract decimals")
+	}
+	return Pair{Decimals0: *token0.ContractDecimals, Decimals1: *token1.ContractDecimals}, nil
+}
+
+// PairOfPool returns the pair of the tokens of pool.
+func PairOfPool(pool services.Pool) (Pair, error) {
+	return PairOf(pool.Token0, pool.Token1)
+}
+
+// Swap is a swap of either pool version in token units.
+type Swap struct {
+	Sender    utils.Address
+	Recipient utils.Address
+	// Whether token0 was sold to the pool for token1.
+	ZeroForOne bool
+	// The amounts of token0 and token1 the pool received, negative for the amounts it paid out.
+	Amount0 utils.TokenAmount
+	Amount1 utils.TokenAmount
+	// The amount of the token sold to the pool, and of the token bought from it.
+	AmountIn  utils.TokenAmount
+	AmountOut utils.TokenAmount
+	// The effective price of token0 in token1 of the swap. nil when no token0 was exchanged.
+	Price *big.Rat
+	// The price of token0 in token1 of the pool after the swap. nil for V2 swaps, see V2Sync.Price.
+	PoolPrice *big.Rat
+}
+
+// Swap returns s in the token units of pair. Amounts in and out of the same token are netted.
+func (s V2Swap) Swap(pair Pair) Swap {
+	amount0 := new(big.Int).Sub(orZero(s.Amount0In), orZero(s.Amount0Out))
+	amount1 := new(big.Int).Sub(orZero(s.Amount1In), orZero(s.Amount1Out))
+	return newSwap(s.Sender, s.To, amount0, amount1, pair)
+}
+
+// Swap returns s in the token units of pair.
+func (s V3Swap) Swap(pair Pair) Swap {
+	swap := newSwap(s.Sender, s.Recipient, orZero(s.Amount0), orZero(s.Amount1), pair)
+	swap.PoolPrice = s.Price(pair)
+	return swap
+}
+
+// Price returns the price of token0 in token1 of the pool after the swap.
+func (s V3Swap) Price(pair Pair) *big.Rat {
+	return SqrtPriceX96ToPrice(orZero(s.SqrtPriceX96), pair)
+}
+
+// Price returns the price of token0 in token1 of the pool from its reserves. Returns nil when Reserve0 is zero.
+func (s V2Sync) Price(pair Pair) *big.Rat {
+	return price(orZero(s.Reserve0), orZero(s.Reserve1), pair)
+}
+
+// Reserves returns the reserves of the pool in token units.
+func (s V2Sync) Reserves(pair Pair) (utils.TokenAmount, utils.TokenAmount) {
+	return amounts(s.Reserve0, s.Reserve1, pair)
+}
+
+// Amounts returns the amounts of token0 and token1 added to the pool.
+func (m V2Mint) Amounts(pair Pair) (utils.TokenAmount, utils.TokenAmount) {
+	return amounts(m.Amount0, m.Amount1, pair)
+}
+
+// Amounts returns the amounts of token0 and token1 removed from the pool.
+func (b V2Burn) Amounts(pair Pair) (utils.TokenAmount, utils.TokenAmount) {
+	return amounts(b.Amount0, b.Amount1, pair)
+}
+
+// Amounts returns the amounts of token0 and token1 added to the position.
+func (m V3Mint) Amounts(pair Pair) (utils.TokenAmount, utils.TokenAmount) {
+	return amounts(m.Amount0, m.Amount1, pair)
+}
+
+// Amounts returns the amounts of token0 and token1 removed from the position. They are owed to the owner until collected.
+func (b V3Burn) Amounts(pair Pair) (utils.TokenAmount, utils.TokenAmount) {
+	return amounts(b.Amount0, b.Amount1, pair)
+}
+
+// SqrtPriceX96ToPrice converts the square root of a V3 pool price, as a Q64.96 fixed point number of raw token1 per
+// raw token0, to the price of token0 in token1 in token units: sqrtPriceX96² / 2^192 × 10^(decimals0 - decimals1).
+func SqrtPriceX96ToPrice(sqrtPriceX96 *big.Int, pair Pair) *big.Rat {
+	squared := new(big.Int).Mul(sqrtPriceX96, sqrtPriceX96)
+	return scale(new(big.Rat).SetFrac(squared, new(big.Int).Lsh(big.NewInt(1), 192)), pair)
+}
+
+func newSwap(sender utils.Address, recipient utils.Address, amount0 *big.Int, amount1 *big.Int, pair Pair) Swap {
+	swap := Swap{
+		Sender:     sender,
+		Recipient:  recipient,
+		ZeroForOne: amount0.Sign() > 0,
+		Amount0:    utils.NewTokenAmount(amount0, pair.Decimals0),
+		Amount1:    utils.NewTokenAmount(amount1, pair.Decimals1),
+		Price:      price(new(big.Int).Abs(amount0), new(big.Int).Abs(amount1), pair),
+	}
+	if swap.ZeroForOne {
+		swap.AmountIn, swap.AmountOut = swap.Amount0, swap.Amount1.Neg()
+	} else {
+		swap.AmountIn, swap.AmountOut = swap.Amount1, swap.Amount0.Neg()
+	}
+	return swap
+}
+
+// price returns raw1 / raw0 in token units, or nil when raw0 is zero.
+func price(raw0 *big.Int, raw1 *big.Int, pair Pair) *big.Rat {
+	if raw0.Sign() == 0 {
+		return nil
+	}
+	return scale(new(big.Rat).SetFrac(raw1, raw0), pair)
+}
+
+// scale converts a price of raw token1 per raw token0 to token units.
+func scale(rawPrice *big.Rat, pair Pair) *big.Rat {
+	exponent := pair.Decimals0 - pair.Decimals1
+	factor := new(big.Rat).SetInt(new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(abs(exponent))), nil))
+	if exponent < 0 {
+		factor.Inv(factor)
+	}
+	return rawPrice.Mul(rawPrice, factor)
+}
+
+func amounts(raw0 *big.Int, raw1 *big.Int, pair Pair) (utils.TokenAmount, utils.TokenAmount) {
+	return utils.NewTokenAmount(orZero(raw0), pair.Decimals0), utils.NewTokenAmount(orZero(raw1), pair.Decimals1)
+}
+
+func orZero(n *big.Int) *big.Int {
+	if n == nil {
+		return new(big.Int)
+	}
+	return n
+}
+
+func abs(n int) int {
+	if n < 0 {
+		return -n
+	}
+	return n
+}
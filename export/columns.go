@@ -0,0 +1,181 @@
+package export
+
+import (
+	"encoding/json"
+	"math/big"
+	"reflect"
+	"strings"
+	"time"
+
+	"github.com/covalenthq/covalent-api-sdk-go/utils"
+)
+
+// column is a leaf field of an item, reached from the item through the field indexes of index.
+type column struct {
+	name  string
+	index []int
+}
+
+var (
+	errorType       = reflect.TypeOf((*error)(nil)).Elem()
+	timeType        = reflect.TypeOf(time.Time{})
+	customTimeType  = reflect.TypeOf(utils.CustomTime{})
+	bigIntType      = reflect.TypeOf(utils.BigInt{})
+	mathBigIntType  = reflect.TypeOf(big.Int{})
+	bigRatType      = reflect.TypeOf(big.Rat{})
+	tokenAmountType = reflect.TypeOf(utils.TokenAmount{})
+)
+
+// columnsOf returns the columns of items of type t: the leaf fields of t, named by the path of their JSON names joined
+// with dots, eg: `gas_metadata.contract_decimals`. Items that are not structs have the single column `value`.
+func columnsOf(t reflect.Type) []column {
+	t = indirect(t)
+	if t.Kind() != reflect.Struct || isLeaf(t) {
+		return []column{{name: "value"}}
+	}
+	var columns []column
+	walkColumns(t, "", nil, map[reflect.Type]bool{t: true}, &columns)
+	return columns
+}
+
+func walkColumns(t reflect.Type, prefix string, index []int, seen map[reflect.Type]bool, columns *[]column) {
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		if !field.IsExported() || field.Type == errorType {
+			continue
+		}
+		name, tagged := jsonName(field)
+		if name == "-" {
+			continue
+		}
+		fieldIndex := append(append([]int(nil), index...), i)
+		fieldType := indirect(field.Type)
+		if fieldType.Kind() == reflect.Struct && !isLeaf(fieldType) && !seen[fieldType] {
+			path := prefix + name + "."
+			if field.Anonymous && !tagged {
+				path = prefix
+			}
+			seen[fieldType] = true
+			walkColumns(fieldType, path, fieldIndex, seen, columns)
+			delete(seen, fieldType)
+			continue
+		}
+		*columns = append(*columns, column{name: prefix + name, index: fieldIndex})
+	}
+}
+
+// selectColumns returns the columns named by names, in order. A name also selects the columns nested under it, eg:
+// `gas_metadata` selects `gas_metadata.contract_decimals`.
+func selectColumns(columns []column, names []string) ([]column, error) {
+	var selected []column
+	for _, name := range names {
+		found := false
+		for _, c := range columns {
+			if c.name == name || strings.HasPrefix(c.name, name+".") {
+				selected = append(selected, c)
+				found = true
+			}
+		}
+		if !found {
+			return nil, &UnknownColumnError{Column: name}
+		}
+	}
+	return selected, nil
+}
+
+// valueOf returns the value of column c of item, formatted: nil when a pointer on the way is nil, big integers and
+// token amounts as decimal strings, times with timeLayout, slices, maps and other values as JSON strings.
+func valueOf(item reflect.Value, c column, timeLayout string) interface{} {
+	v := item
+	for _, i := range c.index {
+		if v = deref(v); !v.IsValid() {
+			return nil
+		}
+		v = v.Field(i)
+	}
+	if v = deref(v); !v.IsValid() {
+		return nil
+	}
+
+	switch v.Type() {
+	case timeType:
+		return v.Interface().(time.Time).Format(timeLayout)
+	case customTimeType:
+		if t := v.Interface().(utils.CustomTime); t.Time != nil {
+			return t.Time.Format(timeLayout)
+		}
+		return nil
+	case bigIntType:
+		if b := v.Interface().(utils.BigInt); b.Int != nil {
+			return b.Int.String()
+		}
+		return nil
+	case tokenAmountType:
+		return v.Interface().(utils.TokenAmount).String()
+	}
+	if v.CanAddr() {
+		switch v.Type() {
+		case mathBigIntType:
+			return v.Addr().Interface().(*big.Int).String()
+		case bigRatType:
+			return v.Addr().Interface().(*big.Rat).RatString()
+		}
+	}
+
+	switch v.Kind() {
+	case reflect.String:
+		return v.String()
+	case reflect.Bool:
+		return v.Bool()
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return v.Int()
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return v.Uint()
+	case reflect.Float32, reflect.Float64:
+		return v.Float()
+	case reflect.Slice, reflect.Map:
+		if v.IsNil() {
+			return nil
+		}
+	}
+	encoded, err := json.Marshal(v.Interface())
+	if err != nil {
+		return nil
+	}
+	return string(encoded)
+}
+
+// jsonName returns the name of field in JSON, and whether it is set by a tag.
+func jsonName(field reflect.StructField) (string, bool) {
+	tag := field.Tag.Get("json")
+	if name, _, _ := strings.Cut(tag, ","); name != "" {
+		return name, true
+	}
+	return field.Name, false
+}
+
+func isLeaf(t reflect.Type) bool {
+	switch t {
+	case timeType, customTimeType, bigIntType, mathBigIntType, bigRatType, tokenAmountType:
+		return true
+	}
+	return false
+}
+
+func indirect(t reflect.Type) reflect.Type {
+	for t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+	return t
+}
+
+// deref follows pointers and interfaces from v. It returns the zero Value when one is nil.
+func deref(v reflect.Value) reflect.Value {
+	for v.Kind() == reflect.Pointer || v.Kind() == reflect.Interface {
+		if v.IsNil() {
+			return reflect.Value{}
+		}
+		v = v.Elem()
+	}
+	return v
+}
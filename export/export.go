@@ -0,0 +1,265 @@
+// Package export writes the items of SDK result channels and paginated responses to files, as NDJSON or as CSV with
+// nested fields flattened into dotted columns.
+package export
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"reflect"
+	"strconv"
+	"time"
+)
+
+// Format is the format an Exporter writes.
+type Format string
+
+const (
+	// CSV writes a header row, then a row per item with a column per leaf field.
+	CSV Format = "csv"
+	// NDJSON writes a JSON object per line with the columns of the item, formatted as in CSV but with numbers and
+	// booleans kept as JSON values.
+	NDJSON Format = "ndjson"
+)
+
+// ErrorPolicy is what an Exporter does with the errors of the results it reads.
+type ErrorPolicy string
+
+const (
+	// AbortOnError stops the export at the first error and returns it.
+	AbortOnError ErrorPolicy = "abort"
+	// ErrorRows writes each error and goes on: in the `error` column added to CSV, or as `{"error":"..."}` in NDJSON.
+	ErrorRows ErrorPolicy = "rows"
+)
+
+// ErrorColumn is the column of errors added to CSV under the ErrorRows policy.
+const ErrorColumn = "error"
+
+// UnknownColumnError is returned when a selected column is not a column of the items exported.
+type UnknownColumnError struct {
+	Column string
+}
+
+func (e *UnknownColumnError) Error() string {
+	return fmt.Sprintf("export: unknown column %q", e.Column)
+}
+
+type ExporterOpts struct {
+	// The columns to write, in order, eg: `tx_hash` or `gas_metadata.contract_ticker_symbol`. A column also selects
+	// the columns nested under it. Defaults to all the columns of the items.
+	Columns []string `json:"columns,omitempty"`
+	// What to do with the errors of results. Defaults to AbortOnError.
+	OnError *ErrorPolicy `json:"onError,omitempty"`
+	// The layout of times. Defaults to time.RFC3339.
+	TimeLayout *string `json:"timeLayout,omitempty"`
+}
+
+// Exporter writes items to a writer in a Format. It is not safe for concurrent use.
+type Exporter struct {
+	format     Format
+	writer     io.Writer
+	csv        *csv.Writer
+	onError    ErrorPolicy
+	timeLayout string
+	names      []string
+
+	itemType reflect.Type
+	columns  []column
+	header   bool
+	count    int
+}
+
+// NewExporter is a constructor function for Exporter. It fails on an unknown format or error policy.
+func NewExporter(w io.Writer, format Format, opts ...ExporterOpts) (*Exporter, error) {
+	var options ExporterOpts
+	if len(opts) > 0 {
+		options = opts[0]
+	}
+	e := &Exporter{format: format, writer: w, onError: AbortOnError, timeLayout: time.RFC3339, names: options.Columns}
+	switch format {
+	case CSV:
+		e.csv = csv.NewWriter(w)
+	case NDJSON:
+	default:
+		return nil, fmt.Errorf("export: unknown format %q", format)
+	}
+	if options.OnError != nil {
+		switch *options.OnError {
+		case AbortOnError, ErrorRows:
+			e.onError = *options.OnError
+		default:
+			return nil, fmt.Errorf("export: unknown error policy %q", *options.OnError)
+		}
+	}
+	if options.TimeLayout != nil {
+		e.timeLayout = *options.TimeLayout
+	}
+	return e, nil
+}
+
+// Count returns the number of items written.
+func (e *Exporter) Count() int {
+	return e.count
+}
+
+// Columns returns the names of the columns written, nil before the type of the items is known.
+func (e *Exporter) Columns() []string {
+	if e.itemType == nil {
+		return nil
+	}
+	names := make([]string, len(e.columns))
+	for i, c := range e.columns {
+		names[i] = c.name
+	}
+	return names
+}
+
+// Write writes an item. All the items written must have the same type.
+func (e *Exporter) Write(item interface{}) error {
+	v := reflect.ValueOf(item)
+	if !v.IsValid() {
+		return fmt.Errorf("export: nil item")
+	}
+	if err := e.start(v.Type()); err != nil {
+		return err
+	}
+	if v.Type() != e.itemType {
+		return fmt.Errorf("export: item of type %s, expected %s", v.Type(), e.itemType)
+	}
+	// An addressable copy lets values such as big.Int format through their pointer methods.
+	addressable := reflect.New(v.Type()).Elem()
+	addressable.Set(v)
+
+	var err error
+	if e.format == NDJSON {
+		err = e.writeJSON(addressable)
+	} else {
+		err = e.writeCSV(addressable)
+	}
+	if err == nil {
+		e.count++
+	}
+	return err
+}
+
+// WriteError handles the error of a result under the error policy: it returns err under AbortOnError, and writes it
+// under ErrorRows.
+func (e *Exporter) WriteError(err error) error {
+	if e.onError != ErrorRows {
+		return err
+	}
+	if e.format == NDJSON {
+		return e.writeLine(map[string]string{ErrorColumn: err.Error()})
+	}
+	if err := e.writeHeader(); err != nil {
+		return err
+	}
+	record := make([]string, len(e.columns)+1)
+	record[len(e.columns)] = err.Error()
+	return e.csv.Write(record)
+}
+
+// Flush writes any buffered data to the underlying writer. A CSV file with no item has its header written when the
+// type of the items is known.
+func (e *Exporter) Flush() error {
+	if e.csv == nil {
+		return nil
+	}
+	if e.itemType != nil {
+		if err := e.writeHeader(); err != nil {
+			return err
+		}
+	}
+	e.csv.Flush()
+	return e.csv.Error()
+}
+
+// start sets the type of the items and their columns on the first call.
+func (e *Exporter) start(t reflect.Type) error {
+	if e.itemType != nil {
+		return nil
+	}
+	columns := columnsOf(t)
+	if len(e.names) > 0 {
+		selected, err := selectColumns(columns, e.names)
+		if err != nil {
+			return err
+		}
+		columns = selected
+	}
+	e.itemType, e.columns = t, columns
+	return nil
+}
+
+func (e *Exporter) writeHeader() error {
+	if e.csv == nil || e.header {
+		return nil
+	}
+	header := e.Columns()
+	if e.onError == ErrorRows {
+		header = append(header, ErrorColumn)
+	}
+	e.header = true
+	return e.csv.Write(header)
+}
+
+func (e *Exporter) writeCSV(item reflect.Value) error {
+	if err := e.writeHeader(); err != nil {
+		return err
+	}
+	record := make([]string, len(e.columns), len(e.columns)+1)
+	for i, c := range e.columns {
+		record[i] = formatCell(valueOf(item, c, e.timeLayout))
+	}
+	if e.onError == ErrorRows {
+		record = append(record, "")
+	}
+	return e.csv.Write(record)
+}
+
+func (e *Exporter) writeJSON(item reflect.Value) error {
+	// encoding/json sorts map keys, so columns are written as raw pairs to keep their order.
+	line := []byte{'{'}
+	for i, c := range e.columns {
+		if i > 0 {
+			line = append(line, ',')
+		}
+		key, _ := json.Marshal(c.name)
+		value, err := json.Marshal(valueOf(item, c, e.timeLayout))
+		if err != nil {
+			return err
+		}
+		line = append(append(append(line, key...), ':'), value...)
+	}
+	line = append(line, '}', '\n')
+	_, err := e.writer.Write(line)
+	return err
+}
+
+func (e *Exporter) writeLine(v interface{}) error {
+	line, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+	_, err = e.writer.Write(append(line, '\n'))
+	return err
+}
+
+func formatCell(value interface{}) string {
+	switch v := value.(type) {
+	case nil:
+		return ""
+	case string:
+		return v
+	case bool:
+		return strconv.FormatBool(v)
+	case int64:
+		return strconv.FormatInt(v, 10)
+	case uint64:
+		return strconv.FormatUint(v, 10)
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64)
+	}
+	return fmt.Sprint(value)
+}
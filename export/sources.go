@@ -0,0 +1,133 @@
+package export
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+
+	"github.com/covalenthq/covalent-api-sdk-go/utils"
+)
+
+// Channel writes the results of an SDK result channel, eg: the TransactionResult channel of
+// GetAllTransactionsForAddress, and flushes e. A result is written as its item, eg: TransactionResult.Transaction, or
+// whole without its Err field when it has more fields, eg: WalletBalanceBatchResult. The errors of results are
+// handled under the error policy of e. When the export stops early the rest of the channel is drained in the
+// background, so that the goroutine feeding it ends. It returns the number of items written.
+func Channel[R any](e *Exporter, results <-chan R) (int, error) {
+	written := e.count
+	if err := e.start(resultItemType(reflect.TypeOf((*R)(nil)).Elem())); err != nil {
+		go drain(results)
+		return 0, err
+	}
+	for result := range results {
+		item, resultErr := resultItem(reflect.ValueOf(result))
+		var err error
+		if resultErr != nil {
+			err = e.WriteError(resultErr)
+		} else {
+			err = e.Write(item.Interface())
+		}
+		if err != nil {
+			go drain(results)
+			e.Flush()
+			return e.count - written, err
+		}
+	}
+	return e.count - written, e.Flush()
+}
+
+// Pages writes the items of first and of the pages that follow it, eg: the response of
+// GetAllTransactionsForAddressByPage, and flushes e. Pages are fetched with Next until the link to the next page is
+// null. The error of a page is handled under the error policy of e and ends the export, as no later page can be
+// reached. It returns the number of items written.
+func Pages[T any, P interface {
+	*T
+	Next() (*utils.Response[T], error)
+}](e *Exporter, first *utils.Response[T]) (int, error) {
+	written := e.count
+	page := first
+	for {
+		if page == nil || page.Data == nil {
+			err := errors.New("export: page has no data")
+			if page != nil && page.ErrorMessage != nil {
+				err = fmt.Errorf("export: %s", *page.ErrorMessage)
+			}
+			if err = e.WriteError(err); err != nil {
+				e.Flush()
+				return e.count - written, err
+			}
+			return e.count - written, e.Flush()
+		}
+
+		data := reflect.ValueOf(page.Data).Elem()
+		items := data.FieldByName("Items")
+		if items.Kind() != reflect.Slice {
+			return 0, fmt.Errorf("export: %s has no items", data.Type())
+		}
+		if err := e.start(items.Type().Elem()); err != nil {
+			return 0, err
+		}
+		for i := 0; i < items.Len(); i++ {
+			if err := e.Write(items.Index(i).Interface()); err != nil {
+				e.Flush()
+				return e.count - written, err
+			}
+		}
+
+		if next := data.FieldByName("Links").FieldByName("Next"); !next.IsValid() || next.IsNil() {
+			return e.count - written, e.Flush()
+		}
+		var err error
+		if page, err = P(page.Data).Next(); err != nil {
+			if err = e.WriteError(err); err != nil {
+				e.Flush()
+				return e.count - written, err
+			}
+			return e.count - written, e.Flush()
+		}
+	}
+}
+
+// resultItemType returns the type of the items of results of type t.
+func resultItemType(t reflect.Type) reflect.Type {
+	if field, ok := singleField(t); ok {
+		return field.Type
+	}
+	return t
+}
+
+// resultItem returns the item and the error of result.
+func resultItem(result reflect.Value) (reflect.Value, error) {
+	var err error
+	if result.Kind() == reflect.Struct {
+		if errField := result.FieldByName("Err"); errField.IsValid() && errField.Type() == errorType && !errField.IsNil() {
+			err = errField.Interface().(error)
+		}
+	}
+	if field, ok := singleField(result.Type()); ok {
+		return result.FieldByIndex(field.Index), err
+	}
+	return result, err
+}
+
+// singleField returns the field of a result struct that is not its Err field, when it has only one.
+func singleField(t reflect.Type) (reflect.StructField, bool) {
+	if t.Kind() != reflect.Struct {
+		return reflect.StructField{}, false
+	}
+	var fields []reflect.StructField
+	for i := 0; i < t.NumField(); i++ {
+		if field := t.Field(i); field.IsExported() && field.Type != errorType {
+			fields = append(fields, field)
+		}
+	}
+	if len(fields) != 1 {
+		return reflect.StructField{}, false
+	}
+	return fields[0], true
+}
+
+func drain[R any](results <-chan R) {
+	for range results {
+	}
+}
@@ -0,0 +1,428 @@
+package genericmodels
+
+import (
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"math/big"
+	"reflect"
+	"strings"
+
+	"github.com/covalenthq/covalent-api-sdk-go/utils"
+)
+
+var (
+	// ErrParamNotFound is returned when a decoded item has no param of the requested name.
+	ErrParamNotFound = errors.New("param not found")
+	// ErrParamNotDecoded is returned when reading a param whose value was not decoded, such as an indexed string
+	// whose log topic only holds its hash.
+	ErrParamNotDecoded = errors.New("param value is not decoded")
+)
+
+// ParamError describes a decoded param that cannot be read as the requested type.
+type ParamError struct {
+	// The name of the param.
+	Param string
+	// The Solidity type the caller asked for, eg: `address` or `uint256`.
+	Expected string
+	// The Solidity type of the param, when the decoder reported it.
+	Actual string
+	// The value of the param.
+	Value interface{}
+	// The underlying conversion error, if any.
+	Err error
+}
+
+func (e *ParamError) Error() string {
+	message := fmt.Sprintf("param %q: expected %s", e.Param, e.Expected)
+	if e.Actual != "" {
+		message += fmt.Sprintf(", param is %s", e.Actual)
+	}
+	if e.Value == nil {
+		message += ", got null"
+	} else {
+		message += fmt.Sprintf(", got %T %v", e.Value, e.Value)
+	}
+	if e.Err != nil {
+		message += ": " + e.Err.Error()
+	}
+	return message
+}
+
+func (e *ParamError) Unwrap() error {
+	return e.Err
+}
+
+// Param returns the param named name.
+func (d *DecodedItem) Param(name string) (*Param, error) {
+	if d != nil && d.Params != nil {
+		for i, param := range *d.Params {
+			if param.Name != nil && *param.Name == name {
+				return &(*d.Params)[i], nil
+			}
+		}
+	}
+	return nil, fmt.Errorf("%w: %q", ErrParamNotFound, name)
+}
+
+// ParamValue returns the raw value of the param named name.
+func (d *DecodedItem) ParamValue(name string) (interface{}, error) {
+	param, err := d.Param(name)
+	if err != nil {
+		return nil, err
+	}
+	return param.value(), nil
+}
+
+// ParamAddress returns the value of an `address` param.
+func (d *DecodedItem) ParamAddress(name string) (utils.Address, error) {
+	var address utils.Address
+	err := d.bindParam(name, reflect.ValueOf(&address).Elem())
+	return address, err
+}
+
+// ParamBigInt returns the value of a `uintN` or `intN` param.
+func (d *DecodedItem) ParamBigInt(name string) (*big.Int, error) {
+	var value *big.Int
+	err := d.bindParam(name, reflect.ValueOf(&value).Elem())
+	return value, err
+}
+
+// ParamBool returns the value of a `bool` param.
+func (d *DecodedItem) ParamBool(name string) (bool, error) {
+	var value bool
+	err := d.bindParam(name, reflect.ValueOf(&value).Elem())
+	return value, err
+}
+
+// ParamBytes returns the value of a `bytes` or `bytesN` param.
+func (d *DecodedItem) ParamBytes(name string) ([]byte, error) {
+	var value []byte
+	err := d.bindParam(name, reflect.ValueOf(&value).Elem())
+	return value, err
+}
+
+// ParamString returns the value of a `string` param.
+func (d *DecodedItem) ParamString(name string) (string, error) {
+	var value string
+	err := d.bindParam(name, reflect.ValueOf(&value).Elem())
+	return value, err
+}
+
+// Bind sets the fields of the struct v points to from the params of the decoded item. A field is bound to the param
+// named in its `abi` tag, or else to the param whose name matches the field name case-insensitively; fields tagged
+// `abi:"-"` are skipped. A param named in a tag must exist. Supported field types are utils.Address, *big.Int,
+// utils.BigInt, Go integers, bool, string, []byte, byte arrays, slices and arrays of these, structs for tuples,
+// and interface{} for the raw value.
+func (d *DecodedItem) Bind(v interface{}) error {
+	target := reflect.ValueOf(v)
+	if target.Kind() != reflect.Pointer || target.IsNil() || target.Elem().Kind() != reflect.Struct {
+		return fmt.Errorf("bind: expected a pointer to a struct, got %T", v)
+	}
+	var params []Param
+	if d != nil && d.Params != nil {
+		params = *d.Params
+	}
+	return bindStruct(params, target.Elem())
+}
+
+func (d *DecodedItem) bindParam(name string, target reflect.Value) error {
+	param, err := d.Param(name)
+	if err != nil {
+		return err
+	}
+	return param.bind(target)
+}
+
+func (p Param) value() interface{} {
+	if p.Value == nil {
+		return nil
+	}
+	return *p.Value
+}
+
+func (p Param) bind(target reflect.Value) error {
+	var name, solidityType string
+	if p.Name != nil {
+		name = *p.Name
+	}
+	if p.Type != nil {
+		solidityType = *p.Type
+	}
+	if p.Decoded != nil && !*p.Decoded && target.Kind() != reflect.Interface {
+		return &ParamError{Param: name, Expected: "a decoded value", Actual: solidityType, Value: p.value(), Err: ErrParamNotDecoded}
+	}
+	return bindValue(name, solidityType, p.value(), target)
+}
+
+func bindStruct(params []Param, target reflect.Value) error {
+	targetType := target.Type()
+	for i := 0; i < targetType.NumField(); i++ {
+		field := targetType.Field(i)
+		if !field.IsExported() {
+			continue
+		}
+		tag, tagged := field.Tag.Lookup("abi")
+		if tag == "-" {
+			continue
+		}
+		var param *Param
+		for j := range params {
+			if params[j].Name == nil {
+				continue
+			}
+			if (tagged && *params[j].Name == tag) || (!tagged && strings.EqualFold(*params[j].Name, field.Name)) {
+				param = &params[j]
+				break
+			}
+		}
+		if param == nil {
+			if tagged {
+				return fmt.Errorf("%w: %q", ErrParamNotFound, tag)
+			}
+			continue
+		}
+		if err := param.bind(target.Field(i)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+var (
+	addressType = reflect.TypeOf(utils.Address{})
+	bigIntType  = reflect.TypeOf(big.Int{})
+	utilsBigInt = reflect.TypeOf(utils.BigInt{})
+)
+
+// bindValue converts a decoded value, as produced by the API or by the abi package, into target.
+func bindValue(name string, solidityType string, value interface{}, target reflect.Value) error {
+	fail := func(expected string, err error) error {
+		return &ParamError{Param: name, Expected: expected, Actual: solidityType, Value: value, Err: err}
+	}
+	// check rejects a param whose reported Solidity type does not fit the target before looking at its value.
+	check := func(expected string, accepts func(string) bool) error {
+		if solidityType != "" && !accepts(solidityType) {
+			return fail(expected, nil)
+		}
+		return nil
+	}
+
+	switch {
+	case target.Kind() == reflect.Interface:
+		if value == nil {
+			target.Set(reflect.Zero(target.Type()))
+		} else {
+			target.Set(reflect.ValueOf(value))
+		}
+		return nil
+	case target.Kind() == reflect.Pointer:
+		if value == nil {
+			target.Set(reflect.Zero(target.Type()))
+			return nil
+		}
+		elem := reflect.New(target.Type().Elem())
+		if err := bindValue(name, solidityType, value, elem.Elem()); err != nil {
+			return err
+		}
+		target.Set(elem)
+		return nil
+	case target.Type() == addressType:
+		if err := check("address", func(t string) bool { return t == "address" }); err != nil {
+			return err
+		}
+		s, ok := value.(string)
+		if !ok {
+			return fail("address", nil)
+		}
+		address, err := utils.ParseAddress(s)
+		if err != nil {
+			return fail("address", err)
+		}
+		target.Set(reflect.ValueOf(address))
+		return nil
+	case target.Type() == bigIntType, target.Type() == utilsBigInt:
+		if err := check("uint256", isIntegerType); err != nil {
+			return err
+		}
+		n, err := toBigInt(value)
+		if err != nil {
+			return fail(integerTypeName(solidityType), err)
+		}
+		if target.Type() == utilsBigInt {
+			target.Set(reflect.ValueOf(utils.BigInt{Int: n}))
+		} else {
+			target.Set(reflect.ValueOf(*n))
+		}
+		return nil
+	}
+
+	switch target.Kind() {
+	case reflect.Bool:
+		if err := check("bool", func(t string) bool { return t == "bool" }); err != nil {
+			return err
+		}
+		b, ok := value.(bool)
+		if !ok {
+			return fail("bool", nil)
+		}
+		target.SetBool(b)
+		return nil
+	case reflect.String:
+		if err := check("string", func(t string) bool { return t == "string" }); err != nil {
+			return err
+		}
+		s, ok := value.(string)
+		if !ok {
+			return fail("string", nil)
+		}
+		target.SetString(s)
+		return nil
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		expected := integerTypeName(solidityType)
+		if err := check(expected, isIntegerType); err != nil {
+			return err
+		}
+		n, err := toBigInt(value)
+		if err != nil {
+			return fail(expected, err)
+		}
+		if target.CanInt() {
+			if !n.IsInt64() || target.OverflowInt(n.Int64()) {
+				return fail(expected, fmt.Errorf("%s overflows %s", n, target.Type()))
+			}
+			target.SetInt(n.Int64())
+		} else {
+			if n.Sign() < 0 || !n.IsUint64() || target.OverflowUint(n.Uint64()) {
+				return fail(expected, fmt.Errorf("%s overflows %s", n, target.Type()))
+			}
+			target.SetUint(n.Uint64())
+		}
+		return nil
+	case reflect.Slice, reflect.Array:
+		if target.Type().Elem().Kind() == reflect.Uint8 {
+			if err := check("bytes", func(t string) bool { return strings.HasPrefix(t, "bytes") && !strings.HasSuffix(t, "]") }); err != nil {
+				return err
+			}
+			b, err := toBytes(value)
+			if err != nil {
+				return fail("bytes", err)
+			}
+			if target.Kind() == reflect.Slice {
+				target.SetBytes(b)
+				return nil
+			}
+			if len(b) != target.Len() {
+				return fail(fmt.Sprintf("bytes%d", target.Len()), fmt.Errorf("got %d bytes", len(b)))
+			}
+			reflect.Copy(target, reflect.ValueOf(b))
+			return nil
+		}
+		if err := check("an array", func(t string) bool { return strings.HasSuffix(t, "]") }); err != nil {
+			return err
+		}
+		items, ok := value.([]interface{})
+		if !ok {
+			return fail("an array", nil)
+		}
+		if target.Kind() == reflect.Array && len(items) != target.Len() {
+			return fail(fmt.Sprintf("an array of %d", target.Len()), fmt.Errorf("got %d items", len(items)))
+		}
+		if target.Kind() == reflect.Slice {
+			target.Set(reflect.MakeSlice(target.Type(), len(items), len(items)))
+		}
+		for i, item := range items {
+			if err := bindValue(fmt.Sprintf("%s[%d]", name, i), elementType(solidityType), item, target.Index(i)); err != nil {
+				return err
+			}
+		}
+		return nil
+	case reflect.Struct:
+		params, err := toParams(value)
+		if err != nil {
+			return fail("a tuple", err)
+		}
+		return bindStruct(params, target)
+	}
+	return fail(target.Type().String(), errors.New("unsupported field type"))
+}
+
+func isIntegerType(t string) bool {
+	return strings.HasPrefix(t, "uint") || strings.HasPrefix(t, "int")
+}
+
+// elementType returns the type of the items of an array type, eg: `uint256` for `uint256[2]`.
+func elementType(solidityType string) string {
+	if i := strings.LastIndex(solidityType, "["); i >= 0 {
+		return solidityType[:i]
+	}
+	return ""
+}
+
+func integerTypeName(solidityType string) string {
+	if isIntegerType(solidityType) {
+		return solidityType
+	}
+	return "uint256"
+}
+
+func toBigInt(value interface{}) (*big.Int, error) {
+	switch v := value.(type) {
+	case string:
+		base, digits := 10, v
+		if strings.HasPrefix(v, "0x") || strings.HasPrefix(v, "0X") {
+			base, digits = 16, v[2:]
+		}
+		if n, ok := new(big.Int).SetString(digits, base); ok {
+			return n, nil
+		}
+		return nil, fmt.Errorf("not an integer")
+	case float64:
+		if v != math.Trunc(v) || math.Abs(v) > 1<<53 {
+			return nil, fmt.Errorf("not an exact integer")
+		}
+		return big.NewInt(int64(v)), nil
+	case json.Number:
+		return toBigInt(v.String())
+	case *big.Int:
+		return new(big.Int).Set(v), nil
+	case int:
+		return big.NewInt(int64(v)), nil
+	case int64:
+		return big.NewInt(v), nil
+	}
+	return nil, fmt.Errorf("not an integer")
+}
+
+func toBytes(value interface{}) ([]byte, error) {
+	switch v := value.(type) {
+	case []byte:
+		return v, nil
+	case string:
+		if strings.HasPrefix(v, "0x") || strings.HasPrefix(v, "0X") {
+			return hex.DecodeString(v[2:])
+		}
+		return base64.StdEncoding.DecodeString(v)
+	}
+	return nil, fmt.Errorf("not hex or base64 bytes")
+}
+
+// toParams reads a tuple value: a []Param from the abi package or a JSON object keyed by component name.
+func toParams(value interface{}) ([]Param, error) {
+	switch v := value.(type) {
+	case []Param:
+		return v, nil
+	case map[string]interface{}:
+		params := make([]Param, 0, len(v))
+		for key, item := range v {
+			name, item := key, item
+			params = append(params, Param{Name: &name, Value: &item})
+		}
+		return params, nil
+	}
+	return nil, fmt.Errorf("not a tuple")
+}
@@ -0,0 +1,59 @@
+package nfturi
+
+import "strings"
+
+// mediaTypes are the media types of the file extensions of NFT assets.
+var mediaTypes = map[string]string{
+	"png":  "image/png",
+	"jpg":  "image/jpeg",
+	"jpeg": "image/jpeg",
+	"gif":  "image/gif",
+	"svg":  "image/svg+xml",
+	"webp": "image/webp",
+	"avif": "image/avif",
+	"bmp":  "image/bmp",
+	"mp4":  "video/mp4",
+	"m4v":  "video/mp4",
+	"webm": "video/webm",
+	"mov":  "video/quicktime",
+	"mp3":  "audio/mpeg",
+	"wav":  "audio/wav",
+	"ogg":  "audio/ogg",
+	"flac": "audio/flac",
+	"glb":  "model/gltf-binary",
+	"gltf": "model/gltf+json",
+	"html": "text/html",
+	"htm":  "text/html",
+	"json": "application/json",
+	"pdf":  "application/pdf",
+}
+
+// MediaType returns the media type of the content s points to, eg: `image/png`: the media type of a data: URI, or
+// the one of the extension of the path. It returns "" when it is unknown.
+func MediaType(s string) string {
+	uri, err := Parse(s)
+	if err != nil {
+		return ""
+	}
+	if uri.Kind == Data {
+		return uri.MediaType
+	}
+	return mediaTypes[uri.extension()]
+}
+
+// IsImage, IsVideo, IsAudio and IsModel report the category of a media type, eg: from MediaType.
+func IsImage(mediaType string) bool {
+	return strings.HasPrefix(mediaType, "image/")
+}
+
+func IsVideo(mediaType string) bool {
+	return strings.HasPrefix(mediaType, "video/")
+}
+
+func IsAudio(mediaType string) bool {
+	return strings.HasPrefix(mediaType, "audio/")
+}
+
+func IsModel(mediaType string) bool {
+	return strings.HasPrefix(mediaType, "model/")
+}
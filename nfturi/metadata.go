@@ -0,0 +1,78 @@
+package nfturi
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/covalenthq/covalent-api-sdk-go/genericmodels"
+)
+
+// Metadata is the JSON metadata of an NFT, as returned by its token URI.
+type Metadata struct {
+	Name        string
+	Description string
+	// The image, from `image` or `image_url`, and the inline SVG of `image_data`.
+	Image        string
+	ImageData    string
+	AnimationUrl string
+	ExternalUrl  string
+	Attributes   []genericmodels.NftCollectionAttribute
+	// All the fields of the document.
+	Raw map[string]interface{}
+}
+
+type metadataJSON struct {
+	Name         string                                 `json:"name"`
+	Description  string                                 `json:"description"`
+	Image        string                                 `json:"image"`
+	ImageUrl     string                                 `json:"image_url"`
+	ImageData    string                                 `json:"image_data"`
+	AnimationUrl string                                 `json:"animation_url"`
+	ExternalUrl  string                                 `json:"external_url"`
+	Attributes   []genericmodels.NftCollectionAttribute `json:"attributes"`
+}
+
+// ParseMetadata reads a JSON metadata document. Fields of an unexpected type are left empty rather than failing.
+func ParseMetadata(data []byte) (*Metadata, error) {
+	raw := map[string]interface{}{}
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return nil, fmt.Errorf("nfturi: invalid metadata: %w", err)
+	}
+	metadata := &Metadata{Raw: raw}
+	var document metadataJSON
+	for name, value := range raw {
+		field, err := json.Marshal(map[string]interface{}{name: value})
+		if err != nil {
+			continue
+		}
+		// Each field is read on its own, so that one of an unexpected type does not lose the others.
+		_ = json.Unmarshal(field, &document)
+	}
+	metadata.Name, metadata.Description = document.Name, document.Description
+	metadata.Image, metadata.ImageData = document.Image, document.ImageData
+	if metadata.Image == "" {
+		metadata.Image = document.ImageUrl
+	}
+	metadata.AnimationUrl, metadata.ExternalUrl = document.AnimationUrl, document.ExternalUrl
+	metadata.Attributes = document.Attributes
+	return metadata, nil
+}
+
+// DecodeMetadata decodes the JSON metadata inline in a data: URI, eg: `data:application/json;base64,eyJuYW1lIjo…`, as
+// on-chain collections return from their token URI.
+func DecodeMetadata(s string) (*Metadata, error) {
+	uri, err := Parse(s)
+	if err != nil {
+		return nil, err
+	}
+	data, err := uri.Decode()
+	if err != nil {
+		return nil, err
+	}
+	if !strings.Contains(uri.MediaType, "json") && !bytes.HasPrefix(bytes.TrimSpace(data), []byte("{")) {
+		return nil, fmt.Errorf("nfturi: data URI holds %s, not JSON", uri.MediaType)
+	}
+	return ParseMetadata(data)
+}
@@ -0,0 +1,249 @@
+// Package nfturi parses the URIs of NFT metadata, images and animations in their ipfs://, ar://, data: and gateway
+// forms, rewrites them to a configurable gateway, and decodes inline metadata.
+package nfturi
+
+import (
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"net/url"
+	"path"
+	"strings"
+)
+
+// Kind is the kind of storage a URI points to.
+type Kind string
+
+const (
+	// HTTP is a plain web URL.
+	HTTP Kind = "http"
+	// IPFS is content on IPFS, as `ipfs://<cid>/<path>` or through a gateway.
+	IPFS Kind = "ipfs"
+	// Arweave is a transaction on Arweave, as `ar://<id>/<path>` or through a gateway.
+	Arweave Kind = "arweave"
+	// Data is content inline in a `data:` URI.
+	Data Kind = "data"
+)
+
+var (
+	// ErrEmptyURI is returned when parsing an empty URI.
+	ErrEmptyURI = errors.New("nfturi: empty URI")
+	// ErrNotData is returned when decoding a URI that is not a data: URI.
+	ErrNotData = errors.New("nfturi: not a data URI")
+)
+
+// arweaveHosts are the gateways Arweave content is read from, with their subdomains.
+var arweaveHosts = []string{"arweave.net", "ar-io.net", "arweave.dev", "ar.io"}
+
+// URI is a parsed NFT URI.
+type URI struct {
+	Kind Kind
+	// The URI as given.
+	Raw string
+	// The CID of IPFS content or the transaction id of Arweave content, and the path under it, eg: `/1.json`.
+	ID   string
+	Path string
+	// The media type of a data: URI, eg: `application/json`, whether its payload is base64, and the payload as written.
+	MediaType string
+	Base64    bool
+	Payload   string
+}
+
+// Gateway is where IPFS and Arweave content is read from over HTTP. Each field is a base URL the id and path are
+// appended to, eg: `https://ipfs.io/ipfs/`, or a template with an `{id}` placeholder for subdomain gateways, eg:
+// `https://{id}.ipfs.dweb.link`.
+type Gateway struct {
+	IPFS    string `json:"ipfs,omitempty"`
+	Arweave string `json:"arweave,omitempty"`
+}
+
+// DefaultGateway is the public IPFS and Arweave gateways.
+var DefaultGateway = Gateway{IPFS: "https://ipfs.io/ipfs/", Arweave: "https://arweave.net/"}
+
+// Parse reads an NFT URI: `ipfs://`, `ar://` and `data:` URIs, a bare CID, and HTTP URLs, which are IPFS or Arweave
+// content when they go through a path gateway such as `https://gateway.pinata.cloud/ipfs/<cid>`, a subdomain gateway
+// such as `https://<cid>.ipfs.dweb.link`, or an Arweave gateway such as `https://arweave.net/<id>`.
+func Parse(s string) (URI, error) {
+	raw := s
+	s = strings.TrimSpace(s)
+	if s == "" {
+		return URI{}, ErrEmptyURI
+	}
+	lower := strings.ToLower(s)
+	switch {
+	case strings.HasPrefix(lower, "ipfs://"):
+		rest := strings.TrimPrefix(s[len("ipfs://"):], "ipfs/")
+		return contentURI(raw, IPFS, rest)
+	case strings.HasPrefix(lower, "ar://"):
+		return contentURI(raw, Arweave, s[len("ar://"):])
+	case strings.HasPrefix(lower, "data:"):
+		return parseData(raw, s)
+	case strings.HasPrefix(lower, "/ipfs/"):
+		return contentURI(raw, IPFS, s[len("/ipfs/"):])
+	case isCID(strings.SplitN(s, "/", 2)[0]):
+		return contentURI(raw, IPFS, s)
+	case strings.HasPrefix(lower, "http://"), strings.HasPrefix(lower, "https://"):
+		return parseHTTP(raw, s)
+	}
+	return URI{}, fmt.Errorf("nfturi: unsupported URI %q", raw)
+}
+
+// Rewrite returns s resolved with gateway, or s unchanged when it cannot be parsed.
+func Rewrite(s string, gateway Gateway) string {
+	uri, err := Parse(s)
+	if err != nil {
+		return s
+	}
+	return uri.Resolve(gateway)
+}
+
+// Resolve returns the URL the content of u is read from: IPFS and Arweave content through gateway, other URIs as they
+// are. A gateway field left empty falls back to DefaultGateway.
+func (u URI) Resolve(gateway Gateway) string {
+	switch u.Kind {
+	case IPFS:
+		return resolveWith(gateway.IPFS, DefaultGateway.IPFS, u.ID, u.Path)
+	case Arweave:
+		return resolveWith(gateway.Arweave, DefaultGateway.Arweave, u.ID, u.Path)
+	}
+	return strings.TrimSpace(u.Raw)
+}
+
+// String returns the canonical form of u: `ipfs://<cid><path>` and `ar://<id><path>` whichever gateway they were
+// read through, and other URIs as they are.
+func (u URI) String() string {
+	switch u.Kind {
+	case IPFS:
+		return "ipfs://" + u.ID + u.Path
+	case Arweave:
+		return "ar://" + u.ID + u.Path
+	}
+	return strings.TrimSpace(u.Raw)
+}
+
+// Decode returns the content of a data: URI: its payload base64-decoded or percent-decoded.
+func (u URI) Decode() ([]byte, error) {
+	if u.Kind != Data {
+		return nil, ErrNotData
+	}
+	if !u.Base64 {
+		decoded, err := url.PathUnescape(u.Payload)
+		if err != nil {
+			// Payloads such as raw SVG may hold a literal `%`.
+			return []byte(u.Payload), nil
+		}
+		return []byte(decoded), nil
+	}
+	payload := strings.Map(func(r rune) rune {
+		if r == ' ' || r == '\n' || r == '\r' || r == '\t' {
+			return -1
+		}
+		return r
+	}, u.Payload)
+	if unescaped, err := url.PathUnescape(payload); err == nil {
+		payload = unescaped
+	}
+	for _, encoding := range []*base64.Encoding{base64.StdEncoding, base64.RawStdEncoding, base64.URLEncoding, base64.RawURLEncoding} {
+		if decoded, err := encoding.DecodeString(payload); err == nil {
+			return decoded, nil
+		}
+	}
+	return nil, fmt.Errorf("nfturi: invalid base64 payload in data URI")
+}
+
+func contentURI(raw string, kind Kind, rest string) (URI, error) {
+	rest, _, _ = strings.Cut(rest, "?")
+	rest, _, _ = strings.Cut(rest, "#")
+	id, p, _ := strings.Cut(rest, "/")
+	if id == "" {
+		return URI{}, fmt.Errorf("nfturi: missing %s id in %q", kind, raw)
+	}
+	if p != "" {
+		p = "/" + p
+	}
+	return URI{Kind: kind, Raw: raw, ID: id, Path: p}, nil
+}
+
+// parseData reads `data:[<media type>][;base64],<payload>`.
+func parseData(raw string, s string) (URI, error) {
+	header, payload, ok := strings.Cut(s[len("data:"):], ",")
+	if !ok {
+		return URI{}, fmt.Errorf("nfturi: invalid data URI %q", truncate(raw))
+	}
+	uri := URI{Kind: Data, Raw: raw, Payload: payload}
+	params := strings.Split(header, ";")
+	uri.MediaType = strings.ToLower(strings.TrimSpace(params[0]))
+	for _, param := range params[1:] {
+		if strings.EqualFold(strings.TrimSpace(param), "base64") {
+			uri.Base64 = true
+		}
+	}
+	if uri.MediaType == "" {
+		uri.MediaType = "text/plain"
+	}
+	return uri, nil
+}
+
+func parseHTTP(raw string, s string) (URI, error) {
+	parsed, err := url.Parse(s)
+	if err != nil {
+		return URI{}, fmt.Errorf("nfturi: invalid URL %q: %w", raw, err)
+	}
+	host := strings.ToLower(parsed.Hostname())
+	if label, _, ok := strings.Cut(host, ".ipfs."); ok && isCID(label) {
+		return URI{Kind: IPFS, Raw: raw, ID: label, Path: strings.TrimSuffix(parsed.Path, "/")}, nil
+	}
+	if i := strings.Index(parsed.Path, "/ipfs/"); i >= 0 {
+		rest := parsed.Path[i+len("/ipfs/"):]
+		if isCID(strings.SplitN(rest, "/", 2)[0]) {
+			return contentURI(raw, IPFS, rest)
+		}
+	}
+	for _, gateway := range arweaveHosts {
+		if host == gateway || strings.HasSuffix(host, "."+gateway) {
+			rest := strings.TrimPrefix(parsed.Path, "/")
+			if isArweaveID(strings.SplitN(rest, "/", 2)[0]) {
+				return contentURI(raw, Arweave, rest)
+			}
+		}
+	}
+	return URI{Kind: HTTP, Raw: raw, Path: parsed.Path}, nil
+}
+
+func resolveWith(base string, fallback string, id string, p string) string {
+	if base == "" {
+		base = fallback
+	}
+	if strings.Contains(base, "{id}") {
+		return strings.TrimSuffix(strings.ReplaceAll(base, "{id}", id), "/") + p
+	}
+	return strings.TrimSuffix(base, "/") + "/" + id + p
+}
+
+// isCID reports whether s looks like an IPFS CID: base58 `Qm…` v0, or base32 `b…` v1.
+func isCID(s string) bool {
+	switch {
+	case len(s) == 46 && strings.HasPrefix(s, "Qm"):
+		return strings.Trim(s, "123456789ABCDEFGHJKLMNPQRSTUVWXYZabcdefghijkmnopqrstuvwxyz") == ""
+	case len(s) >= 50 && s[0] == 'b':
+		return strings.Trim(strings.ToLower(s), "abcdefghijklmnopqrstuvwxyz234567") == ""
+	}
+	return false
+}
+
+// isArweaveID reports whether s looks like an Arweave transaction id: 43 base64url characters.
+func isArweaveID(s string) bool {
+	return len(s) == 43 && strings.Trim(s, "ABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyz0123456789-_") == ""
+}
+
+// extension returns the lowercase extension of the path of u, without the dot.
+func (u URI) extension() string {
+	return strings.TrimPrefix(strings.ToLower(path.Ext(u.Path)), ".")
+}
+
+func truncate(s string) string {
+	if len(s) > 64 {
+		return s[:64] + "…"
+	}
+	return s
+}
@@ -0,0 +1,251 @@
+// Package pnl computes the cost basis and the realized and unrealized profit and loss of a wallet per token, from its
+// token transfers and transactions, under the FIFO, LIFO and average cost methods.
+package pnl
+
+import (
+	"fmt"
+	"math/big"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/covalenthq/covalent-api-sdk-go/utils"
+)
+
+// Method is a cost basis method: which acquired units a disposal is matched with.
+type Method string
+
+const (
+	// FIFO matches disposals with the units acquired first.
+	FIFO Method = "fifo"
+	// LIFO matches disposals with the units acquired last.
+	LIFO Method = "lifo"
+	// AverageCost matches disposals with the average cost of all units held.
+	AverageCost Method = "average_cost"
+)
+
+// NativeTokenAddress is the contract address the API uses for the native gas token of a chain.
+const NativeTokenAddress = "0xeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeee"
+
+// Trade is a change of the balance of a token held by a wallet.
+type Trade struct {
+	Time   time.Time
+	TxHash string
+	// The lowercase contract address of the token, NativeTokenAddress for the native gas token.
+	ContractAddress string
+	Symbol          string
+	// The amount received, negative for the amount sent.
+	Amount utils.TokenAmount
+	// The price of one token in the quote currency at the time of the trade. nil when unknown: inflows are then
+	// acquired at zero cost, and outflows are treated as transfers out that realize no gain or loss.
+	Price *float64
+	// The fees in the quote currency paid for the trade. They add to the cost of inflows and reduce the proceeds of
+	// outflows.
+	Fee float64
+	// Whether the trade is the native token spent on gas by a transaction of the wallet. Gas trades are counted once
+	// per transaction hash.
+	Gas bool
+}
+
+// Report is the profit and loss of a wallet under one method, in the quote currency of the trade prices.
+type Report struct {
+	Method Method
+	// The tokens, sorted by contract address.
+	Tokens []TokenPnL
+	// The sums over Tokens.
+	Realized   float64
+	Unrealized float64
+	// The value of the native token spent on gas, included in the realized PnL of the native token.
+	GasCosts float64
+}
+
+// TokenPnL is the profit and loss of a wallet on one token.
+type TokenPnL struct {
+	ContractAddress string
+	Symbol          string
+	// The amount held after the last trade, in token units.
+	Balance *big.Rat
+	// The cost of the units held.
+	CostBasis float64
+	// The gain or loss of disposals: proceeds less the cost of the units disposed of, less fees.
+	Realized float64
+	// The gain or loss of the units held at CurrentPrice. Zero when the current price is unknown.
+	Unrealized   float64
+	CurrentPrice *float64
+	// The fees paid on trades of the token.
+	Fees float64
+	// The number of inflows acquired at zero cost, and of outflows that realized nothing, for lack of a price.
+	UnpricedInflows  int
+	UnpricedOutflows int
+	// The amount disposed of beyond the units held, eg: when the trades do not start at the first acquisition.
+	// It is disposed of at zero cost.
+	Unmatched *big.Rat
+}
+
+// lot is a quantity of a token acquired at a unit cost.
+type lot struct {
+	quantity *big.Rat
+	unitCost *big.Rat
+}
+
+type position struct {
+	pnl  TokenPnL
+	lots []lot
+}
+
+// Compute returns the profit and loss of trades under method. Trades are processed in time order, trades at the
+// same time in the order given. currentPrices holds the current price of tokens by contract address, compared
+// case-insensitively, to value the units held.
+func Compute(trades []Trade, method Method, currentPrices map[string]float64) (*Report, error) {
+	switch method {
+	case FIFO, LIFO, AverageCost:
+	default:
+		return nil, fmt.Errorf("pnl: unknown method %q", method)
+	}
+
+	sorted := append([]Trade(nil), trades...)
+	sort.SliceStable(sorted, func(i, j int) bool { return sorted[i].Time.Before(sorted[j].Time) })
+
+	prices := map[string]float64{}
+	for address, price := range currentPrices {
+		prices[strings.ToLower(address)] = price
+	}
+
+	report := &Report{Method: method}
+	positions := map[string]*position{}
+	gasTxs := map[string]bool{}
+	for _, trade := range sorted {
+		if trade.Gas && trade.TxHash != "" {
+			if gasTxs[trade.TxHash] {
+				continue
+			}
+			gasTxs[trade.TxHash] = true
+		}
+		address := strings.ToLower(trade.ContractAddress)
+		p, ok := positions[address]
+		if !ok {
+			p = &position{pnl: TokenPnL{ContractAddress: address, Symbol: trade.Symbol, Balance: new(big.Rat), Unmatched: new(big.Rat)}}
+			positions[address] = p
+		}
+		if trade.Gas {
+			report.GasCosts += trade.Fee
+		}
+		p.apply(trade, method)
+	}
+
+	for _, p := range positions {
+		cost, _ := p.cost().Float64()
+		p.pnl.CostBasis = cost
+		if price, ok := prices[p.pnl.ContractAddress]; ok {
+			price := price
+			value, _ := new(big.Rat).Mul(p.pnl.Balance, rat(price)).Float64()
+			p.pnl.CurrentPrice = &price
+			p.pnl.Unrealized = value - cost
+		}
+		report.Realized += p.pnl.Realized
+		report.Unrealized += p.pnl.Unrealized
+		report.Tokens = append(report.Tokens, p.pnl)
+	}
+	sort.Slice(report.Tokens, func(i, j int) bool { return report.Tokens[i].ContractAddress < report.Tokens[j].ContractAddress })
+	return report, nil
+}
+
+// ComputeAll returns the reports of trades under FIFO, LIFO and average cost.
+func ComputeAll(trades []Trade, currentPrices map[string]float64) map[Method]*Report {
+	reports := map[Method]*Report{}
+	for _, method := range []Method{FIFO, LIFO, AverageCost} {
+		reports[method], _ = Compute(trades, method, currentPrices)
+	}
+	return reports
+}
+
+func (p *position) apply(trade Trade, method Method) {
+	quantity := trade.Amount.Rat()
+	p.pnl.Fees += trade.Fee
+	if quantity.Sign() > 0 {
+		if trade.Price == nil {
+			p.pnl.UnpricedInflows++
+		}
+		cost := new(big.Rat).Mul(quantity, priceRat(trade.Price))
+		cost.Add(cost, rat(trade.Fee))
+		p.lots = append(p.lots, lot{quantity: quantity, unitCost: cost.Quo(cost, quantity)})
+		p.pnl.Balance.Add(p.pnl.Balance, quantity)
+		return
+	}
+	if quantity.Sign() == 0 {
+		p.pnl.Realized -= trade.Fee
+		return
+	}
+
+	quantity.Neg(quantity)
+	matched := p.dispose(quantity, method)
+	p.pnl.Balance.Sub(p.pnl.Balance, quantity)
+	if p.pnl.Balance.Sign() < 0 {
+		p.pnl.Balance.SetInt64(0)
+	}
+	if trade.Price == nil {
+		// A transfer out: the units leave at their cost.
+		p.pnl.UnpricedOutflows++
+		p.pnl.Realized -= trade.Fee
+		return
+	}
+	proceeds := new(big.Rat).Mul(quantity, rat(*trade.Price))
+	realized, _ := proceeds.Sub(proceeds, matched).Float64()
+	p.pnl.Realized += realized - trade.Fee
+}
+
+// dispose removes quantity from the lots held and returns their cost.
+func (p *position) dispose(quantity *big.Rat, method Method) *big.Rat {
+	cost := new(big.Rat)
+	remaining := new(big.Rat).Set(quantity)
+	if method == AverageCost && len(p.lots) > 1 {
+		held, total := new(big.Rat), new(big.Rat)
+		for _, l := range p.lots {
+			held.Add(held, l.quantity)
+			total.Add(total, new(big.Rat).Mul(l.quantity, l.unitCost))
+		}
+		p.lots = []lot{{quantity: held, unitCost: total.Quo(total, held)}}
+	}
+	for remaining.Sign() > 0 && len(p.lots) > 0 {
+		i := 0
+		if method == LIFO {
+			i = len(p.lots) - 1
+		}
+		l := &p.lots[i]
+		taken := l.quantity
+		if taken.Cmp(remaining) > 0 {
+			taken = remaining
+		}
+		cost.Add(cost, new(big.Rat).Mul(taken, l.unitCost))
+		l.quantity = new(big.Rat).Sub(l.quantity, taken)
+		remaining.Sub(remaining, taken)
+		if l.quantity.Sign() == 0 {
+			p.lots = append(p.lots[:i], p.lots[i+1:]...)
+		}
+	}
+	p.pnl.Unmatched.Add(p.pnl.Unmatched, remaining)
+	return cost
+}
+
+func (p *position) cost() *big.Rat {
+	total := new(big.Rat)
+	for _, l := range p.lots {
+		total.Add(total, new(big.Rat).Mul(l.quantity, l.unitCost))
+	}
+	return total
+}
+
+func priceRat(price *float64) *big.Rat {
+	if price == nil {
+		return new(big.Rat)
+	}
+	return rat(*price)
+}
+
+func rat(f float64) *big.Rat {
+	r := new(big.Rat)
+	if r.SetFloat64(f) == nil {
+		return new(big.Rat)
+	}
+	return r
+}
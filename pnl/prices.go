@@ -0,0 +1,91 @@
+package pnl
+
+import (
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/covalenthq/covalent-api-sdk-go/chains"
+	"github.com/covalenthq/covalent-api-sdk-go/quotes"
+	"github.com/covalenthq/covalent-api-sdk-go/services"
+)
+
+// PriceSource returns the price of a token in a quote currency on the day of date. ok is false when the source has
+// no price for that day.
+type PriceSource interface {
+	Price(contractAddress string, date time.Time) (price float64, ok bool, err error)
+}
+
+// HistoricalPrices is a PriceSource backed by PricingService.GetTokenPrices. It requests each token once per day
+// and caches the prices.
+type HistoricalPrices struct {
+	pricing       services.PricingService
+	chainName     chains.Chain
+	quoteCurrency quotes.Quote
+
+	mu     sync.Mutex
+	prices map[string]*float64
+}
+
+// NewHistoricalPrices is a constructor function for HistoricalPrices.
+func NewHistoricalPrices(pricing services.PricingService, chainName chains.Chain, quoteCurrency quotes.Quote) *HistoricalPrices {
+	return &HistoricalPrices{pricing: pricing, chainName: chainName, quoteCurrency: quoteCurrency, prices: map[string]*float64{}}
+}
+
+// Price returns the price of the token on the day of date, in UTC.
+func (h *HistoricalPrices) Price(contractAddress string, date time.Time) (float64, bool, error) {
+	day := date.UTC().Format(time.DateOnly)
+	key := strings.ToLower(contractAddress) + "@" + day
+
+	// The lock is not held during the request, so lookups for other tokens are not blocked by it. Concurrent
+	// misses for the same key may both fetch; the results are the same.
+	h.mu.Lock()
+	price, cached := h.prices[key]
+	h.mu.Unlock()
+	if cached {
+		if price == nil {
+			return 0, false, nil
+		}
+		return *price, true, nil
+	}
+
+	resp, err := h.pricing.GetTokenPrices(h.chainName, h.quoteCurrency, contractAddress, services.GetTokenPricesQueryParamOpts{From: &day, To: &day})
+	if err != nil {
+		return 0, false, err
+	}
+	if resp.Data != nil {
+		if token := resp.Data.ForContract(contractAddress); token != nil {
+			for _, p := range append(token.Prices, token.Items...) {
+				if p.Price != nil {
+					price = p.Price
+					break
+				}
+			}
+		}
+	}
+	h.mu.Lock()
+	h.prices[key] = price
+	h.mu.Unlock()
+	if price == nil {
+		return 0, false, nil
+	}
+	return *price, true, nil
+}
+
+// PriceInflows sets the price of each inflow of trades from source, on the day of the trade. Inflows source has no
+// price for keep their price.
+func PriceInflows(trades []Trade, source PriceSource) error {
+	for i := range trades {
+		if trades[i].Amount.Sign() <= 0 {
+			continue
+		}
+		price, ok, err := source.Price(trades[i].ContractAddress, trades[i].Time)
+		if err != nil {
+			return err
+		}
+		if ok {
+			trades[i].Price = &price
+		}
+	}
+	return nil
+}
@@ -0,0 +1,142 @@
+package pnl
+
+import (
+	"strings"
+	"time"
+
+	"github.com/covalenthq/covalent-api-sdk-go/genericmodels"
+	"github.com/covalenthq/covalent-api-sdk-go/services"
+	"github.com/covalenthq/covalent-api-sdk-go/utils"
+)
+
+// TradesFromTransfers returns the trades of walletAddress in the results of GetErc20TransfersForWalletAddress,
+// priced with the QuoteRate of each transfer, and the gas spent on the transactions walletAddress sent.
+func TradesFromTransfers(walletAddress string, transactions []services.BlockTransactionWithContractTransfers) []Trade {
+	var trades []Trade
+	for _, tx := range transactions {
+		if tx.Transfers != nil {
+			for _, transfer := range *tx.Transfers {
+				if trade, ok := transferTrade(walletAddress, transfer); ok {
+					trades = append(trades, trade)
+				}
+			}
+		}
+		if trade, ok := gasTrade(walletAddress, tx.BlockSignedAt, tx.TxHash, tx.FromAddress, tx.FeesPaidAmount(), tx.GasMetadata, tx.GasQuote, tx.GasQuoteRate); ok {
+			trades = append(trades, trade)
+		}
+	}
+	return trades
+}
+
+// TradesFromTransactions returns the native token trades of walletAddress in transactions, eg: the results of
+// GetAllTransactionsForAddress: the value of successful transactions sent or received, priced with the native token
+// quote rate, and the gas spent on the transactions walletAddress sent.
+func TradesFromTransactions(walletAddress string, transactions []services.Transaction) []Trade {
+	var trades []Trade
+	for _, tx := range transactions {
+		successful := tx.Successful == nil || *tx.Successful
+		if amount := tx.ValueAmount(); successful && amount != nil && !amount.IsZero() {
+			received := sameAddress(tx.ToAddress, walletAddress)
+			sent := sameAddress(tx.FromAddress, walletAddress)
+			if received != sent {
+				if sent {
+					*amount = amount.Neg()
+				}
+				trades = append(trades, Trade{
+					Time:            timeOf(tx.BlockSignedAt),
+					TxHash:          stringOf(tx.TxHash),
+					ContractAddress: NativeTokenAddress,
+					Symbol:          nativeSymbol(tx.GasMetadata),
+					Amount:          *amount,
+					Price:           tx.GasQuoteRate,
+				})
+			}
+		}
+		if trade, ok := gasTrade(walletAddress, tx.BlockSignedAt, tx.TxHash, tx.FromAddress, tx.FeesPaidAmount(), tx.GasMetadata, tx.GasQuote, tx.GasQuoteRate); ok {
+			trades = append(trades, trade)
+		}
+	}
+	return trades
+}
+
+// CurrentPrices returns the quote rates of balance items by contract address, eg: for the results of
+// GetTokenBalancesForWalletAddress.
+func CurrentPrices(items []services.BalanceItem) map[string]float64 {
+	prices := map[string]float64{}
+	for _, item := range items {
+		if item.ContractAddress != nil && item.QuoteRate != nil {
+			prices[strings.ToLower(*item.ContractAddress)] = *item.QuoteRate
+		}
+	}
+	return prices
+}
+
+func transferTrade(walletAddress string, transfer services.TokenTransferItem) (Trade, bool) {
+	amount := transfer.DeltaAmount()
+	if amount == nil || transfer.ContractAddress == nil {
+		return Trade{}, false
+	}
+	switch {
+	case transfer.TransferType != nil && *transfer.TransferType == "transfer-out",
+		transfer.TransferType == nil && sameAddress(transfer.FromAddress, walletAddress):
+		if amount.Sign() > 0 {
+			*amount = amount.Neg()
+		}
+	case transfer.TransferType != nil && *transfer.TransferType == "transfer-in",
+		transfer.TransferType == nil && sameAddress(transfer.ToAddress, walletAddress):
+	default:
+		return Trade{}, false
+	}
+	return Trade{
+		Time:            timeOf(transfer.BlockSignedAt),
+		TxHash:          stringOf(transfer.TxHash),
+		ContractAddress: strings.ToLower(*transfer.ContractAddress),
+		Symbol:          stringOf(transfer.ContractTickerSymbol),
+		Amount:          *amount,
+		Price:           transfer.QuoteRate,
+	}, true
+}
+
+func gasTrade(walletAddress string, signedAt *time.Time, txHash *string, from *string, feesPaid *utils.TokenAmount, gas *genericmodels.ContractMetadata, gasQuote *float64, gasQuoteRate *float64) (Trade, bool) {
+	if !sameAddress(from, walletAddress) || feesPaid == nil || feesPaid.IsZero() {
+		return Trade{}, false
+	}
+	trade := Trade{
+		Time:            timeOf(signedAt),
+		TxHash:          stringOf(txHash),
+		ContractAddress: NativeTokenAddress,
+		Symbol:          nativeSymbol(gas),
+		Amount:          feesPaid.Neg(),
+		Price:           gasQuoteRate,
+		Gas:             true,
+	}
+	if gasQuote != nil {
+		trade.Fee = *gasQuote
+	}
+	return trade, true
+}
+
+func nativeSymbol(gas *genericmodels.ContractMetadata) string {
+	if gas != nil && gas.ContractTickerSymbol != nil {
+		return *gas.ContractTickerSymbol
+	}
+	return ""
+}
+
+func sameAddress(address *string, walletAddress string) bool {
+	return address != nil && strings.EqualFold(*address, walletAddress)
+}
+
+func timeOf(t *time.Time) time.Time {
+	if t == nil {
+		return time.Time{}
+	}
+	return *t
+}
+
+func stringOf(s *string) string {
+	if s == nil {
+		return ""
+	}
+	return *s
+}
@@ -0,0 +1,232 @@
+// Package portfolio merges token balances of several wallets across chains into one report.
+package portfolio
+
+import (
+	"fmt"
+	"math/big"
+	"sort"
+	"strings"
+
+	"github.com/covalenthq/covalent-api-sdk-go/services"
+)
+
+// DefaultAliases maps the ticker symbols of common tokens and of their bridged equivalents to the symbol of the asset
+// they represent. It is used when AggregateOpts.Aliases is nil.
+var DefaultAliases = map[string]string{
+	"USDC":   "USDC",
+	"USDT":   "USDT",
+	"DAI":    "DAI",
+	"WETH":   "WETH",
+	"WBTC":   "WBTC",
+	"USDC.E": "USDC",
+	"USDBC":  "USDC",
+	"USDT.E": "USDT",
+	"DAI.E":  "DAI",
+	"WETH.E": "WETH",
+	"WBTC.E": "WBTC",
+	"BTC.B":  "BTC",
+}
+
+type AggregateOpts struct {
+	// If `true`, tokens with `is_spam` set are excluded.
+	ExcludeSpam *bool `json:"excludeSpam,omitempty"`
+	// If `true`, tokens of type `dust` are excluded.
+	ExcludeDust *bool `json:"excludeDust,omitempty"`
+	// Holdings with a quote below this value are excluded as dust.
+	MinQuote *float64 `json:"minQuote,omitempty"`
+	// Maps upper case ticker symbols to the symbol of the asset they are grouped under, eg: `USDC.E` to `USDC`. Tokens
+	// flagged as spam are never grouped by their symbol. Defaults to DefaultAliases; an empty map groups by contract only.
+	Aliases map[string]string `json:"aliases,omitempty"`
+	// Maps `chain_name:contract_address` keys, in lower case, to the asset the contract is grouped under, eg:
+	// `eth-mainnet:0xa0b8...` to `USDC`. Takes precedence over Aliases.
+	Contracts map[string]string `json:"contracts,omitempty"`
+}
+
+// Portfolio is the merged view of the balances of several wallets across chains.
+type Portfolio struct {
+	// The quote currency shared by all balances, eg: `USD`.
+	QuoteCurrency string
+	// The wallet addresses and chain names the balances came from, sorted.
+	Wallets []string
+	Chains  []string
+	// The current and 24h value of all assets in the quote currency.
+	TotalQuote    float64
+	TotalQuote24h float64
+	// The change in value over 24h, absolute and as a percentage. The percentage is nil when the 24h value is zero.
+	Change24h        float64
+	Change24hPercent *float64
+	// The assets, by descending value.
+	Assets []Asset
+	// The number of balance items left out by the exclusion options.
+	Excluded int
+}
+
+// Asset is a token held across wallets and chains. Holdings are grouped by chain and contract, and across chains only
+// for native tokens and through AggregateOpts.Aliases and Contracts.
+type Asset struct {
+	// The asset the holdings are grouped under: the upper case ticker symbol of native tokens, the asset of an alias or
+	// of a listed contract, or `chain_name:contract_address` otherwise.
+	Key string
+	// The ticker symbol and name of the first holding.
+	Symbol string
+	Name   string
+	// The balance across holdings in token units.
+	Balance *big.Rat
+	// The current and 24h value of the asset in the quote currency.
+	Quote    float64
+	Quote24h float64
+	// The share of TotalQuote this asset accounts for, as a percentage.
+	Allocation float64
+	// The change in value over 24h, absolute and as a percentage. The percentage is nil when the 24h value is zero.
+	Change24h        float64
+	Change24hPercent *float64
+	Holdings         []Holding
+}
+
+// Holding is a balance of an asset held by one wallet on one chain.
+type Holding struct {
+	WalletAddress string
+	ChainName     string
+	ChainId       int
+	Item          services.BalanceItem
+	// Quote of Item, and Quote24h of Item or Quote when it is missing.
+	Quote    float64
+	Quote24h float64
+}
+
+// Aggregate merges balance responses, eg: those of GetTokenBalancesForWalletAddress for several wallets and chains,
+// into a Portfolio. It makes no requests. All responses must share a quote currency.
+func Aggregate(responses []services.BalancesResponse, opts ...AggregateOpts) (*Portfolio, error) {
+	var options AggregateOpts
+	if len(opts) > 0 {
+		options = opts[0]
+	}
+	aliases := options.Aliases
+	if aliases == nil {
+		aliases = DefaultAliases
+	}
+
+	portfolio := &Portfolio{}
+	wallets := map[string]bool{}
+	chainNames := map[string]bool{}
+	assets := map[string]*Asset{}
+	for _, response := range responses {
+		if portfolio.QuoteCurrency == "" {
+			portfolio.QuoteCurrency = response.QuoteCurrency
+		} else if response.QuoteCurrency != "" && !strings.EqualFold(response.QuoteCurrency, portfolio.QuoteCurrency) {
+			return nil, fmt.Errorf("portfolio: cannot aggregate balances quoted in %s and %s", portfolio.QuoteCurrency, response.QuoteCurrency)
+		}
+		wallet := strings.ToLower(response.Address)
+		wallets[wallet] = true
+		chainNames[response.ChainName] = true
+
+		for _, item := range response.Items {
+			if excluded(item, options) {
+				portfolio.Excluded++
+				continue
+			}
+			holding := Holding{WalletAddress: wallet, ChainName: response.ChainName, ChainId: response.ChainId, Item: item}
+			if item.Quote != nil {
+				holding.Quote = *item.Quote
+			}
+			holding.Quote24h = holding.Quote
+			if item.Quote24h != nil {
+				holding.Quote24h = *item.Quote24h
+			}
+
+			key := assetKey(response.ChainName, item, aliases, options.Contracts)
+			asset, ok := assets[key]
+			if !ok {
+				asset = &Asset{Key: key, Balance: new(big.Rat)}
+				if item.ContractTickerSymbol != nil {
+					asset.Symbol = *item.ContractTickerSymbol
+				}
+				if item.ContractName != nil {
+					asset.Name = *item.ContractName
+				}
+				assets[key] = asset
+			}
+			if amount := item.BalanceAmount(); amount != nil {
+				asset.Balance.Add(asset.Balance, amount.Rat())
+			}
+			asset.Quote += holding.Quote
+			asset.Quote24h += holding.Quote24h
+			asset.Holdings = append(asset.Holdings, holding)
+			portfolio.TotalQuote += holding.Quote
+			portfolio.TotalQuote24h += holding.Quote24h
+		}
+	}
+
+	for _, asset := range assets {
+		if portfolio.TotalQuote != 0 {
+			asset.Allocation = asset.Quote / portfolio.TotalQuote * 100
+		}
+		asset.Change24h, asset.Change24hPercent = change(asset.Quote, asset.Quote24h)
+		portfolio.Assets = append(portfolio.Assets, *asset)
+	}
+	sort.Slice(portfolio.Assets, func(i, j int) bool {
+		if portfolio.Assets[i].Quote != portfolio.Assets[j].Quote {
+			return portfolio.Assets[i].Quote > portfolio.Assets[j].Quote
+		}
+		return portfolio.Assets[i].Key < portfolio.Assets[j].Key
+	})
+	portfolio.Change24h, portfolio.Change24hPercent = change(portfolio.TotalQuote, portfolio.TotalQuote24h)
+	portfolio.Wallets = sortedKeys(wallets)
+	portfolio.Chains = sortedKeys(chainNames)
+	return portfolio, nil
+}
+
+func excluded(item services.BalanceItem, options AggregateOpts) bool {
+	if options.ExcludeSpam != nil && *options.ExcludeSpam && item.IsSpam != nil && *item.IsSpam {
+		return true
+	}
+	if options.ExcludeDust != nil && *options.ExcludeDust && item.Type != nil && *item.Type == "dust" {
+		return true
+	}
+	if options.MinQuote != nil && (item.Quote == nil || *item.Quote < *options.MinQuote) {
+		return true
+	}
+	return false
+}
+
+// assetKey returns the key item is grouped under. Any token can copy a ticker symbol, so symbols only group native
+// tokens and tokens not flagged as spam that have an alias.
+func assetKey(chainName string, item services.BalanceItem, aliases map[string]string, contracts map[string]string) string {
+	var address string
+	if item.ContractAddress != nil {
+		address = strings.ToLower(*item.ContractAddress)
+	}
+	contractKey := chainName + ":" + address
+	if asset, ok := contracts[contractKey]; ok {
+		return asset
+	}
+	if item.ContractTickerSymbol == nil || *item.ContractTickerSymbol == "" || (item.IsSpam != nil && *item.IsSpam) {
+		return contractKey
+	}
+	symbol := strings.ToUpper(*item.ContractTickerSymbol)
+	if item.NativeToken != nil && *item.NativeToken {
+		return symbol
+	}
+	if alias, ok := aliases[symbol]; ok {
+		return alias
+	}
+	return contractKey
+}
+
+func change(quote float64, quote24h float64) (float64, *float64) {
+	delta := quote - quote24h
+	if quote24h == 0 {
+		return delta, nil
+	}
+	percent := delta / quote24h * 100
+	return delta, &percent
+}
+
+func sortedKeys(set map[string]bool) []string {
+	keys := make([]string, 0, len(set))
+	for key := range set {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
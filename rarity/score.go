@@ -0,0 +1,250 @@
+// Package rarity scores and ranks the tokens of an NFT collection by the rarity of their traits, from the trait
+// summaries of NftService and the attributes of token metadata.
+package rarity
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"math/big"
+	"sort"
+	"strconv"
+)
+
+// Method is a rarity scoring method. For every method, a higher score is rarer.
+type Method string
+
+const (
+	// Statistical scores a token by the inverse of the product of the frequencies of its trait values: the inverse of
+	// the probability of drawing its traits at random.
+	Statistical Method = "statistical"
+	// TraitRarity scores a token by the sum of the inverses of the frequencies of its trait values.
+	TraitRarity Method = "trait_rarity"
+	// InformationContent scores a token by the information of its trait values, the sum of -log2 of their
+	// frequencies, divided by the entropy of the collection.
+	InformationContent Method = "information_content"
+)
+
+// TraitCount is the pseudo-trait whose value is the number of traits of a token.
+const TraitCount = "Trait Count"
+
+// NoneValue is the value of the traits a token does not have.
+const NoneValue = "None"
+
+// ErrNoTotal is returned when a token is scored with a table whose number of tokens is unknown.
+var ErrNoTotal = errors.New("rarity: the number of tokens of the collection is unknown")
+
+type ScoreOpts struct {
+	// Whether the TraitCount pseudo-trait is left out. It is included by default, when the table has trait counts.
+	ExcludeTraitCount *bool `json:"excludeTraitCount,omitempty"`
+	// Whether the traits a token does not have are left out, instead of counting as NoneValue.
+	ExcludeMissing *bool `json:"excludeMissing,omitempty"`
+}
+
+// Score is the rarity of a token.
+type Score struct {
+	TokenId string
+	Score   float64
+	// The rank in the collection, from 1 for the rarest, equal for equal scores. 0 for tokens scored alone.
+	Rank   int
+	Traits []TraitScore
+}
+
+// TraitScore is the rarity of the value of a trait of a token.
+type TraitScore struct {
+	Trait string
+	Value string
+	// The number of tokens with the value, and their share of the collection.
+	Count     int
+	Frequency float64
+}
+
+// Score returns the score of token under method.
+func (t *Table) Score(token Token, method Method, opts ...ScoreOpts) (Score, error) {
+	if t.Total <= 0 {
+		return Score{}, ErrNoTotal
+	}
+	var options ScoreOpts
+	if len(opts) > 0 {
+		options = opts[0]
+	}
+	return t.score(token, method, options, t.entropy(options))
+}
+
+// score returns the score of token under method, given the entropy of the table. Traits are added up in the order of
+// Traits, so that tokens with the same trait frequencies get exactly the same score.
+func (t *Table) score(token Token, method Method, options ScoreOpts, entropy float64) (Score, error) {
+	traits := t.traitScores(token, options)
+
+	score := Score{TokenId: token.TokenId, Traits: traits}
+	switch method {
+	case Statistical:
+		probability := 1.0
+		for _, ts := range traits {
+			probability *= ts.Frequency
+		}
+		score.Score = 1 / probability
+	case TraitRarity:
+		for _, ts := range traits {
+			score.Score += 1 / ts.Frequency
+		}
+	case InformationContent:
+		for _, ts := range traits {
+			score.Score -= math.Log2(ts.Frequency)
+		}
+		if entropy > 0 {
+			score.Score /= entropy
+		}
+	default:
+		return Score{}, fmt.Errorf("rarity: unknown method %q", method)
+	}
+	return score, nil
+}
+
+// Rank scores tokens under method and returns their scores, rarest first. tokens are usually the whole collection,
+// eg: from Collect. When table is nil it is built from tokens. A table built from summaries has the trait counts of
+// tokens when they are the whole collection, ie: as many as Total; TraitCount is left out otherwise. table is not
+// modified: these are derived on a copy.
+func Rank(tokens []Token, table *Table, method Method, opts ...ScoreOpts) ([]Score, error) {
+	if table == nil {
+		table = TableFromTokens(tokens)
+	}
+	derived := *table
+	table = &derived
+	if table.Total == 0 {
+		table.Total = len(tokens)
+	}
+	if len(table.traitCounts) == 0 && table.Total == len(tokens) {
+		table.traitCounts = map[int]int{}
+		for _, token := range tokens {
+			table.traitCounts[len(token.Attributes)]++
+		}
+	}
+
+	if table.Total <= 0 {
+		return nil, ErrNoTotal
+	}
+	var options ScoreOpts
+	if len(opts) > 0 {
+		options = opts[0]
+	}
+	entropy := table.entropy(options)
+
+	scores := make([]Score, 0, len(tokens))
+	for _, token := range tokens {
+		score, err := table.score(token, method, options, entropy)
+		if err != nil {
+			return nil, err
+		}
+		scores = append(scores, score)
+	}
+	sort.SliceStable(scores, func(i, j int) bool {
+		if scores[i].Score != scores[j].Score {
+			return scores[i].Score > scores[j].Score
+		}
+		return lessTokenId(scores[i].TokenId, scores[j].TokenId)
+	})
+	for i := range scores {
+		scores[i].Rank = i + 1
+		if i > 0 && scores[i].Score == scores[i-1].Score {
+			scores[i].Rank = scores[i-1].Rank
+		}
+	}
+	return scores, nil
+}
+
+// traitScores returns the value of each trait of the table for token, with NoneValue for the ones it does not have,
+// then its TraitCount, in trait order.
+func (t *Table) traitScores(token Token, options ScoreOpts) []TraitScore {
+	values := map[string]string{}
+	for name, v := range token.Attributes {
+		values[key(name)] = v
+	}
+	var scores []TraitScore
+	for _, name := range t.Traits() {
+		v, ok := values[key(name)]
+		if !ok && options.ExcludeMissing != nil && *options.ExcludeMissing {
+			continue
+		}
+		count := t.Count(name, v)
+		if !ok {
+			v, count = NoneValue, t.missing(name)
+		}
+		scores = append(scores, t.traitScore(name, v, count))
+	}
+	if len(t.traitCounts) > 0 && (options.ExcludeTraitCount == nil || !*options.ExcludeTraitCount) {
+		n := len(token.Attributes)
+		scores = append(scores, t.traitScore(TraitCount, strconv.Itoa(n), t.traitCounts[n]))
+	}
+	return scores
+}
+
+// traitScore returns the score of a value, counted at least once as the token has it.
+func (t *Table) traitScore(name string, v string, count int) TraitScore {
+	if count < 1 {
+		count = 1
+	}
+	return TraitScore{Trait: name, Value: v, Count: count, Frequency: float64(count) / float64(t.Total)}
+}
+
+// missing returns the number of tokens without the trait.
+func (t *Table) missing(name string) int {
+	having := 0
+	if tr, ok := t.traits[key(name)]; ok {
+		for _, v := range tr.values {
+			having += v.count
+		}
+	}
+	if having > t.Total {
+		return 0
+	}
+	return t.Total - having
+}
+
+// entropy returns the expected information of a token of the collection: the sum over traits of the entropy of their
+// values, including NoneValue and TraitCount as scored. It adds up traits, values and trait counts in sorted order, so
+// that it does not vary with the order of the maps.
+func (t *Table) entropy(options ScoreOpts) float64 {
+	entropy := 0.0
+	add := func(count int) {
+		if count > 0 {
+			p := float64(count) / float64(t.Total)
+			entropy -= p * math.Log2(p)
+		}
+	}
+	for _, name := range t.Traits() {
+		tr := t.traits[key(name)]
+		values := make([]string, 0, len(tr.values))
+		for k := range tr.values {
+			values = append(values, k)
+		}
+		sort.Strings(values)
+		for _, k := range values {
+			add(tr.values[k].count)
+		}
+		if options.ExcludeMissing == nil || !*options.ExcludeMissing {
+			add(t.missing(tr.name))
+		}
+	}
+	if options.ExcludeTraitCount == nil || !*options.ExcludeTraitCount {
+		counts := make([]int, 0, len(t.traitCounts))
+		for n := range t.traitCounts {
+			counts = append(counts, n)
+		}
+		sort.Ints(counts)
+		for _, n := range counts {
+			add(t.traitCounts[n])
+		}
+	}
+	return entropy
+}
+
+// lessTokenId orders token ids numerically, and as strings when they are not numbers.
+func lessTokenId(a string, b string) bool {
+	x, okX := new(big.Int).SetString(a, 10)
+	y, okY := new(big.Int).SetString(b, 10)
+	if okX && okY {
+		return x.Cmp(y) < 0
+	}
+	return a < b
+}
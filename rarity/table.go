@@ -0,0 +1,201 @@
+package rarity
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/covalenthq/covalent-api-sdk-go/genericmodels"
+	"github.com/covalenthq/covalent-api-sdk-go/services"
+)
+
+// Table holds how many tokens of a collection have each value of each trait.
+type Table struct {
+	// The number of tokens in the collection. Rank takes the number of tokens ranked when it is 0.
+	Total int
+	// The counts of values by trait, with the names of traits and values as the API or the metadata writes them.
+	traits map[string]*trait
+	// The counts of tokens by number of traits, filled from tokens only.
+	traitCounts map[int]int
+}
+
+type trait struct {
+	name   string
+	values map[string]*value
+}
+
+type value struct {
+	name  string
+	count int
+}
+
+// NewTable is a constructor function for Table. total is the number of tokens in the collection, 0 when unknown.
+func NewTable(total int) *Table {
+	return &Table{Total: total, traits: map[string]*trait{}, traitCounts: map[int]int{}}
+}
+
+// TableFromTokens returns the table of tokens, counted from their attributes. Total is the number of tokens.
+func TableFromTokens(tokens []Token) *Table {
+	table := NewTable(len(tokens))
+	for _, token := range tokens {
+		table.AddToken(token)
+	}
+	return table
+}
+
+// AddSummary sets the counts of the traits of resp, the response of GetCollectionTraitsSummary, replacing the counts
+// of those traits.
+func (t *Table) AddSummary(resp services.NftCollectionTraitSummaryResponse) {
+	for _, summary := range resp.Items {
+		if summary.Attributes != nil {
+			for _, attribute := range *summary.Attributes {
+				name := summary.Name
+				if attribute.TraitType != nil {
+					name = attribute.TraitType
+				}
+				t.setAttribute(name, attribute.Values)
+			}
+		}
+		if summary.Name != nil && summary.ValueString != nil && summary.ValueString.Value != nil && summary.ValueString.TokenCount != nil {
+			t.set(*summary.Name, *summary.ValueString.Value, *summary.ValueString.TokenCount)
+		}
+	}
+}
+
+// AddAttributes sets the counts of the traits of resp, the response of GetAttributesForTraitInCollection, replacing
+// the counts of those traits.
+func (t *Table) AddAttributes(resp services.NftCollectionAttributesForTraitResponse) {
+	for _, attribute := range resp.Items {
+		t.setAttribute(attribute.TraitType, attribute.Values)
+	}
+}
+
+// AddToken counts the attributes of token, and its number of traits. It is meant for tables counted from tokens: the
+// counts of summaries already include every token.
+func (t *Table) AddToken(token Token) {
+	for name, v := range token.Attributes {
+		entry := t.value(name, v)
+		entry.count++
+	}
+	t.traitCounts[len(token.Attributes)]++
+}
+
+// Count returns how many tokens have the value of the trait, compared case-insensitively.
+func (t *Table) Count(traitName string, valueName string) int {
+	if tr, ok := t.traits[key(traitName)]; ok {
+		if v, ok := tr.values[key(valueName)]; ok {
+			return v.count
+		}
+	}
+	return 0
+}
+
+// Traits returns the names of the traits, sorted.
+func (t *Table) Traits() []string {
+	names := make([]string, 0, len(t.traits))
+	for _, tr := range t.traits {
+		names = append(names, tr.name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+func (t *Table) setAttribute(name *string, values *[]services.NftAttribute) {
+	if name == nil || values == nil {
+		return
+	}
+	delete(t.traits, key(*name))
+	for _, v := range *values {
+		if v.Value != nil && v.Count != nil {
+			t.set(*name, *v.Value, *v.Count)
+		}
+	}
+}
+
+func (t *Table) set(traitName string, valueName string, count int) {
+	t.value(traitName, valueName).count = count
+}
+
+func (t *Table) value(traitName string, valueName string) *value {
+	tr, ok := t.traits[key(traitName)]
+	if !ok {
+		tr = &trait{name: traitName, values: map[string]*value{}}
+		t.traits[key(traitName)] = tr
+	}
+	v, ok := tr.values[key(valueName)]
+	if !ok {
+		v = &value{name: valueName}
+		tr.values[key(valueName)] = v
+	}
+	return v
+}
+
+// Token is a token of a collection with its attributes.
+type Token struct {
+	TokenId string
+	// The values of the traits of the token, by trait name.
+	Attributes map[string]string
+}
+
+// TokenOf returns the token of data, eg: the NftData of GetNftMetadataForGivenTokenIdForContract. ok is false when
+// data has no token id.
+func TokenOf(data services.NftData) (Token, bool) {
+	if data.TokenId == nil || data.TokenId.Int == nil {
+		return Token{}, false
+	}
+	token := Token{TokenId: data.TokenId.String(), Attributes: map[string]string{}}
+	if data.ExternalData != nil && data.ExternalData.Attributes != nil {
+		token.Attributes = Attributes(*data.ExternalData.Attributes)
+	}
+	return token, true
+}
+
+// Attributes returns the values of attributes by trait name, as strings. Attributes without a trait type or a value
+// are left out.
+func Attributes(attributes []genericmodels.NftCollectionAttribute) map[string]string {
+	values := map[string]string{}
+	for _, attribute := range attributes {
+		if attribute.TraitType == nil || attribute.Value == nil || *attribute.Value == nil {
+			continue
+		}
+		var s string
+		switch v := (*attribute.Value).(type) {
+		case string:
+			s = v
+		case float64:
+			s = strconv.FormatFloat(v, 'f', -1, 64)
+		default:
+			s = fmt.Sprint(v)
+		}
+		if strings.TrimSpace(s) != "" {
+			values[*attribute.TraitType] = s
+		}
+	}
+	return values
+}
+
+// Collect returns the tokens of the results of GetTokenIdsForContractWithMetadata. It stops at the first error.
+func Collect(results <-chan services.NftTokenContractResult) ([]Token, error) {
+	var tokens []Token
+	for result := range results {
+		if result.Err != nil {
+			go func() {
+				for range results {
+				}
+			}()
+			return tokens, result.Err
+		}
+		if result.NftTokenContract.NftData == nil {
+			continue
+		}
+		if token, ok := TokenOf(*result.NftTokenContract.NftData); ok {
+			tokens = append(tokens, token)
+		}
+	}
+	return tokens, nil
+}
+
+func key(s string) string {
+	return strings.ToLower(strings.TrimSpace(s))
+}
@@ -1,12 +1,13 @@
 package services
 
 import (
-	"encoding/json"
+	"context"
 	"errors"
 	"fmt"
 	"net/http"
 	"net/url"
 	"strconv"
+	"sync"
 	"time"
 
 	"github.com/covalenthq/covalent-api-sdk-go/chains"
@@ -416,6 +417,42 @@ type TokenHolderResult struct {
 	Err         error
 }
 
+type WalletBalanceRequest struct {
+	// The chain name eg: `eth-mainnet`.
+	ChainName chains.Chain
+	// The requested address.
+	WalletAddress string
+}
+
+type WalletBalanceBatchResult[T any] struct {
+	// The request this result belongs to.
+	Request WalletBalanceRequest
+	// The response for the request. Nil when Err is set.
+	Response *utils.Response[T]
+	// The number of attempts made for the request, including retries.
+	Attempts int
+	// The number of requests completed so far, including this one.
+	Completed int
+	// The total number of requests in the batch.
+	Total int
+	Err   error
+}
+
+type WalletBalanceBatchOpts struct {
+	// The number of requests run at the same time. Defaults to, and is capped at, the client's thread count.
+	Concurrency *int `json:"concurrency,omitempty"`
+	// The number of times a request that failed with a network, rate limit or server error is retried, with exponential
+	// backoff between attempts. Defaults to 0.
+	MaxRetries *int `json:"maxRetries,omitempty"`
+	// Returns the wait before a retry, given its number from 1. Defaults to DefaultBatchRetryDelay.
+	RetryDelay func(retry int) time.Duration `json:"-"`
+	// Called after every completed request with the number of completed requests and the batch total.
+	OnProgress func(completed int, total int) `json:"-"`
+	// Cancels the batch when done: requests not yet started are skipped and the result channel is closed, so a consumer
+	// may stop reading. Defaults to the client's context.
+	Context context.Context `json:"-"`
+}
+
 type GetTokenBalancesForWalletAddressQueryParamOpts struct {
 	// The currency to convert. Supports `USD`, `CAD`, `EUR`, `SGD`, `INR`, `JPY`, `VND`, `CNY`, `KRW`, `RUB`, `TRY`, `NGN`, `ARS`, `AUD`, `CHF`, and `GBP`.
 	QuoteCurrency *quotes.Quote `json:"quoteCurrency,omitempty"`
@@ -481,9 +518,9 @@ type GetNativeTokenBalanceQueryParamOpts struct {
 	BlockHeight *string `json:"blockHeight,omitempty"`
 }
 
-func NewBalanceServiceImpl(apiKey string, debug bool, threadCount int, isValidKey bool) BalanceService {
+func NewBalanceServiceImpl(apiKey string, debug bool, threadCount int, isValidKey bool, settings ...utils.RequestSettings) BalanceService {
 
-	return &balanceServiceImpl{APIKey: apiKey, Debug: debug, ThreadCount: threadCount, IskeyValid: isValidKey}
+	return &balanceServiceImpl{APIKey: apiKey, Debug: debug, ThreadCount: threadCount, IskeyValid: isValidKey, Settings: utils.NewRequestSettings(apiKey, settings...)}
 }
 
 type BalanceService interface {
@@ -535,6 +572,18 @@ type BalanceService interface {
 	// chainName: The chain name eg: `eth-mainnet`.. Type: chains.Chain
 	// walletAddress: The requested address. Passing in an `ENS`, `RNS`, `Lens Handle`, or an `Unstoppable Domain` resolves automatically.. Type: string
 	GetNativeTokenBalance(chainName chains.Chain, walletAddress string, queryParamOpts ...GetNativeTokenBalanceQueryParamOpts) (*utils.Response[TokenBalanceNativeResponse], error)
+
+	// Commonly used to fetch the token balances of many wallets across chains in one batch. Results are sent on the channel as they complete, in no particular order.
+	//   Parameters:
+	// requests: The chain and wallet address pairs to fetch balances for.. Type: []WalletBalanceRequest
+	// batchOpts: Concurrency, retry and progress settings for the batch.. Type: WalletBalanceBatchOpts
+	GetTokenBalancesForWalletAddresses(requests []WalletBalanceRequest, batchOpts WalletBalanceBatchOpts, queryParamOpts ...GetTokenBalancesForWalletAddressQueryParamOpts) <-chan WalletBalanceBatchResult[BalancesResponse]
+
+	// Commonly used to fetch the native token balances of many wallets across chains in one batch. Results are sent on the channel as they complete, in no particular order.
+	//   Parameters:
+	// requests: The chain and wallet address pairs to fetch balances for.. Type: []WalletBalanceRequest
+	// batchOpts: Concurrency, retry and progress settings for the batch.. Type: WalletBalanceBatchOpts
+	GetNativeTokenBalances(requests []WalletBalanceRequest, batchOpts WalletBalanceBatchOpts, queryParamOpts ...GetNativeTokenBalanceQueryParamOpts) <-chan WalletBalanceBatchResult[TokenBalanceNativeResponse]
 }
 
 type balanceServiceImpl struct {
@@ -542,11 +591,12 @@ type balanceServiceImpl struct {
 	Debug       bool
 	ThreadCount int
 	IskeyValid  bool
+	Settings    utils.RequestSettings
 }
 
 func (s *balanceServiceImpl) GetTokenBalancesForWalletAddress(chainName chains.Chain, walletAddress string, queryParamOpts ...GetTokenBalancesForWalletAddressQueryParamOpts) (*utils.Response[BalancesResponse], error) {
 
-	apiURL := fmt.Sprintf("https://api.covalenthq.com/v1/%v/address/%s/balances_v2/", chainName, walletAddress)
+	apiURL := fmt.Sprintf("%s/v1/%v/address/%s/balances_v2/", s.Settings.BaseURL, chainName, walletAddress)
 
 	if !s.IskeyValid {
 		errorCode := 401
@@ -589,10 +639,10 @@ func (s *balanceServiceImpl) GetTokenBalancesForWalletAddress(chainName chains.C
 	parsedURL.RawQuery = params.Encode()
 
 	// Create an HTTP client
-	client := &http.Client{}
+	client := s.Settings.HTTPClient
 
 	// Create a GET request
-	req, err := http.NewRequest("GET", parsedURL.String(), nil)
+	req, err := http.NewRequestWithContext(s.Settings.Context, "GET", parsedURL.String(), nil)
 
 	if err != nil {
 		errorCode := 500
@@ -600,7 +650,14 @@ func (s *balanceServiceImpl) GetTokenBalancesForWalletAddress(chainName chains.C
 		return &utils.Response[BalancesResponse]{Data: nil, Error: true, ErrorCode: &errorCode, ErrorMessage: &errorMessage}, err
 	}
 
-	req.Header.Set("Authorization", `Bearer `+s.APIKey)
+	apiKey, err := s.Settings.APIKey()
+	if err != nil {
+		errorCode := 401
+		errorMessage := err.Error()
+		return &utils.Response[BalancesResponse]{Data: nil, Error: true, ErrorCode: &errorCode, ErrorMessage: &errorMessage}, err
+	}
+
+	req.Header.Set("Authorization", `Bearer `+apiKey)
 	req.Header.Set("X-Requested-With", utils.UserAgent)
 
 	var startTime time.Time // Declares startTime, initially set to zero value of time.Time
@@ -610,6 +667,7 @@ func (s *balanceServiceImpl) GetTokenBalancesForWalletAddress(chainName chains.C
 	}
 
 	// Perform the request
+	requestStart := time.Now()
 	resp, err := client.Do(req)
 	if err != nil {
 		// create a &Response that returns data: nil, error: true, errorCode: "Unknown Error Code", errorMessage: "Unknown Error"
@@ -618,46 +676,49 @@ func (s *balanceServiceImpl) GetTokenBalancesForWalletAddress(chainName chains.C
 
 	defer resp.Body.Close()
 
+	s.Settings.Report(apiKey, resp.StatusCode)
+	meta := s.Settings.NewResponseMeta(resp, requestStart)
 	utils.DebugOutput(resp.Request.URL.String(), resp.StatusCode, startTime)
-	backoff := utils.NewExponentialBackoff(s.APIKey, s.Debug, 0, utils.UserAgent)
+	backoff := utils.NewExponentialBackoffWithSettings(s.Settings, s.Debug, 0, utils.UserAgent)
 
 	// // Read the response body
 	var data utils.Response[BalancesResponse]
 
-	if resp.StatusCode == 429 {
+	if resp.StatusCode == 429 && backoff.Enabled() {
 		res, err := backoff.BackOff(resp.Request.URL.String())
 		if err != nil {
 			errorCode := resp.StatusCode
 			errorMessage := err.Error()
-			return &utils.Response[BalancesResponse]{Data: nil, Error: true, ErrorCode: &errorCode, ErrorMessage: &errorMessage}, err
+			return &utils.Response[BalancesResponse]{Data: nil, Error: true, ErrorCode: &errorCode, ErrorMessage: &errorMessage, Meta: meta.Finish()}, err
 		}
+		meta.Retried(res, 1+backoff.RetryCount)
 
-		if err := json.NewDecoder(res.Body).Decode(&data); err != nil {
+		if err := s.Settings.Decode(res.Body, &data, "GetTokenBalancesForWalletAddress"); err != nil {
 			res.Body.Close()
 			errorCode := 500
 			errorMessage := err.Error()
-			return &utils.Response[BalancesResponse]{Data: nil, Error: true, ErrorCode: &errorCode, ErrorMessage: &errorMessage}, err
+			return &utils.Response[BalancesResponse]{Data: nil, Error: true, ErrorCode: &errorCode, ErrorMessage: &errorMessage, Meta: meta.Finish()}, err
 		}
 		res.Body.Close()
 	} else {
-		err = json.NewDecoder(resp.Body).Decode(&data)
+		err = s.Settings.Decode(resp.Body, &data, "GetTokenBalancesForWalletAddress")
 		if err != nil {
 			errorCode := 500
 			errorMessage := err.Error()
-			return &utils.Response[BalancesResponse]{Data: nil, Error: true, ErrorCode: &errorCode, ErrorMessage: &errorMessage}, err
+			return &utils.Response[BalancesResponse]{Data: nil, Error: true, ErrorCode: &errorCode, ErrorMessage: &errorMessage, Meta: meta.Finish()}, err
 		}
 	}
 
 	if data.Error {
-		return &utils.Response[BalancesResponse]{Data: data.Data, Error: data.Error, ErrorCode: data.ErrorCode, ErrorMessage: data.ErrorMessage}, fmt.Errorf(*data.ErrorMessage)
+		return &utils.Response[BalancesResponse]{Data: data.Data, Error: data.Error, ErrorCode: data.ErrorCode, ErrorMessage: data.ErrorMessage, Meta: meta.Finish()}, fmt.Errorf(*data.ErrorMessage)
 	}
 
-	return &utils.Response[BalancesResponse]{Data: data.Data, Error: data.Error, ErrorCode: data.ErrorCode, ErrorMessage: data.ErrorMessage}, nil
+	return &utils.Response[BalancesResponse]{Data: data.Data, Error: data.Error, ErrorCode: data.ErrorCode, ErrorMessage: data.ErrorMessage, Meta: meta.Finish()}, nil
 }
 
 func (s *balanceServiceImpl) GetHistoricalPortfolioForWalletAddress(chainName chains.Chain, walletAddress string, queryParamOpts ...GetHistoricalPortfolioForWalletAddressQueryParamOpts) (*utils.Response[PortfolioResponse], error) {
 
-	apiURL := fmt.Sprintf("https://api.covalenthq.com/v1/%v/address/%s/portfolio_v2/", chainName, walletAddress)
+	apiURL := fmt.Sprintf("%s/v1/%v/address/%s/portfolio_v2/", s.Settings.BaseURL, chainName, walletAddress)
 
 	if !s.IskeyValid {
 		errorCode := 401
@@ -688,10 +749,10 @@ func (s *balanceServiceImpl) GetHistoricalPortfolioForWalletAddress(chainName ch
 	parsedURL.RawQuery = params.Encode()
 
 	// Create an HTTP client
-	client := &http.Client{}
+	client := s.Settings.HTTPClient
 
 	// Create a GET request
-	req, err := http.NewRequest("GET", parsedURL.String(), nil)
+	req, err := http.NewRequestWithContext(s.Settings.Context, "GET", parsedURL.String(), nil)
 
 	if err != nil {
 		errorCode := 500
@@ -699,7 +760,14 @@ func (s *balanceServiceImpl) GetHistoricalPortfolioForWalletAddress(chainName ch
 		return &utils.Response[PortfolioResponse]{Data: nil, Error: true, ErrorCode: &errorCode, ErrorMessage: &errorMessage}, err
 	}
 
-	req.Header.Set("Authorization", `Bearer `+s.APIKey)
+	apiKey, err := s.Settings.APIKey()
+	if err != nil {
+		errorCode := 401
+		errorMessage := err.Error()
+		return &utils.Response[PortfolioResponse]{Data: nil, Error: true, ErrorCode: &errorCode, ErrorMessage: &errorMessage}, err
+	}
+
+	req.Header.Set("Authorization", `Bearer `+apiKey)
 	req.Header.Set("X-Requested-With", utils.UserAgent)
 
 	var startTime time.Time // Declares startTime, initially set to zero value of time.Time
@@ -709,6 +777,7 @@ func (s *balanceServiceImpl) GetHistoricalPortfolioForWalletAddress(chainName ch
 	}
 
 	// Perform the request
+	requestStart := time.Now()
 	resp, err := client.Do(req)
 	if err != nil {
 		// create a &Response that returns data: nil, error: true, errorCode: "Unknown Error Code", errorMessage: "Unknown Error"
@@ -717,41 +786,44 @@ func (s *balanceServiceImpl) GetHistoricalPortfolioForWalletAddress(chainName ch
 
 	defer resp.Body.Close()
 
+	s.Settings.Report(apiKey, resp.StatusCode)
+	meta := s.Settings.NewResponseMeta(resp, requestStart)
 	utils.DebugOutput(resp.Request.URL.String(), resp.StatusCode, startTime)
-	backoff := utils.NewExponentialBackoff(s.APIKey, s.Debug, 0, utils.UserAgent)
+	backoff := utils.NewExponentialBackoffWithSettings(s.Settings, s.Debug, 0, utils.UserAgent)
 
 	// // Read the response body
 	var data utils.Response[PortfolioResponse]
 
-	if resp.StatusCode == 429 {
+	if resp.StatusCode == 429 && backoff.Enabled() {
 		res, err := backoff.BackOff(resp.Request.URL.String())
 		if err != nil {
 			errorCode := resp.StatusCode
 			errorMessage := err.Error()
-			return &utils.Response[PortfolioResponse]{Data: nil, Error: true, ErrorCode: &errorCode, ErrorMessage: &errorMessage}, err
+			return &utils.Response[PortfolioResponse]{Data: nil, Error: true, ErrorCode: &errorCode, ErrorMessage: &errorMessage, Meta: meta.Finish()}, err
 		}
+		meta.Retried(res, 1+backoff.RetryCount)
 
-		if err := json.NewDecoder(res.Body).Decode(&data); err != nil {
+		if err := s.Settings.Decode(res.Body, &data, "GetHistoricalPortfolioForWalletAddress"); err != nil {
 			res.Body.Close()
 			errorCode := 500
 			errorMessage := err.Error()
-			return &utils.Response[PortfolioResponse]{Data: nil, Error: true, ErrorCode: &errorCode, ErrorMessage: &errorMessage}, err
+			return &utils.Response[PortfolioResponse]{Data: nil, Error: true, ErrorCode: &errorCode, ErrorMessage: &errorMessage, Meta: meta.Finish()}, err
 		}
 		res.Body.Close()
 	} else {
-		err = json.NewDecoder(resp.Body).Decode(&data)
+		err = s.Settings.Decode(resp.Body, &data, "GetHistoricalPortfolioForWalletAddress")
 		if err != nil {
 			errorCode := 500
 			errorMessage := err.Error()
-			return &utils.Response[PortfolioResponse]{Data: nil, Error: true, ErrorCode: &errorCode, ErrorMessage: &errorMessage}, err
+			return &utils.Response[PortfolioResponse]{Data: nil, Error: true, ErrorCode: &errorCode, ErrorMessage: &errorMessage, Meta: meta.Finish()}, err
 		}
 	}
 
 	if data.Error {
-		return &utils.Response[PortfolioResponse]{Data: data.Data, Error: data.Error, ErrorCode: data.ErrorCode, ErrorMessage: data.ErrorMessage}, fmt.Errorf(*data.ErrorMessage)
+		return &utils.Response[PortfolioResponse]{Data: data.Data, Error: data.Error, ErrorCode: data.ErrorCode, ErrorMessage: data.ErrorMessage, Meta: meta.Finish()}, fmt.Errorf(*data.ErrorMessage)
 	}
 
-	return &utils.Response[PortfolioResponse]{Data: data.Data, Error: data.Error, ErrorCode: data.ErrorCode, ErrorMessage: data.ErrorMessage}, nil
+	return &utils.Response[PortfolioResponse]{Data: data.Data, Error: data.Error, ErrorCode: data.ErrorCode, ErrorMessage: data.ErrorMessage, Meta: meta.Finish()}, nil
 }
 
 func (s *balanceServiceImpl) GetErc20TransfersForWalletAddress(chainName chains.Chain, walletAddress string, queryParamOpts ...GetErc20TransfersForWalletAddressQueryParamOpts) <-chan BlockTransactionWithContractTransfersResult {
@@ -767,7 +839,7 @@ func (s *balanceServiceImpl) GetErc20TransfersForWalletAddress(chainName chains.
 			return
 		}
 
-		apiURL := fmt.Sprintf("https://api.covalenthq.com/v1/%v/address/%s/transfers_v2/", chainName, walletAddress)
+		apiURL := fmt.Sprintf("%s/v1/%v/address/%s/transfers_v2/", s.Settings.BaseURL, chainName, walletAddress)
 
 		// Parse the formatted URL
 		parsedURL, err := url.Parse(apiURL)
@@ -823,13 +895,15 @@ func (s *balanceServiceImpl) GetErc20TransfersForWalletAddress(chainName chains.
 		var data utils.Response[Erc20TransfersResponse]
 		for hasNext {
 
-			res, err := utils.PaginateEndpoint(apiURL, s.APIKey, params, page, s.Debug, s.ThreadCount, utils.UserAgent)
+			res, err := utils.PaginateEndpointWithSettings(s.Settings, apiURL, params, page, s.Debug, s.ThreadCount, utils.UserAgent)
 			if err != nil {
 				blockTransactionWithContractTransfersChannel <- BlockTransactionWithContractTransfersResult{Err: err}
 				hasNext = false
 				return
 			}
-			if err := json.NewDecoder(res.Body).Decode(&data); err != nil {
+			if err := utils.DecodeItems(s.Settings, res.Body, &data, "GetErc20TransfersForWalletAddress", func(item BlockTransactionWithContractTransfers) {
+				blockTransactionWithContractTransfersChannel <- BlockTransactionWithContractTransfersResult{BlockTransactionWithContractTransfers: item}
+			}); err != nil {
 				blockTransactionWithContractTransfersChannel <- BlockTransactionWithContractTransfersResult{Err: err}
 				res.Body.Close()
 				hasNext = false
@@ -848,10 +922,6 @@ func (s *balanceServiceImpl) GetErc20TransfersForWalletAddress(chainName chains.
 				return
 			}
 
-			for _, item := range data.Data.Items {
-				blockTransactionWithContractTransfersChannel <- BlockTransactionWithContractTransfersResult{BlockTransactionWithContractTransfers: item, Err: err}
-			}
-
 			hasNext = *data.Data.Pagination.HasMore
 			page++
 		}
@@ -861,7 +931,7 @@ func (s *balanceServiceImpl) GetErc20TransfersForWalletAddress(chainName chains.
 }
 
 func (s *balanceServiceImpl) GetErc20TransfersForWalletAddressByPage(chainName chains.Chain, walletAddress string, queryParamOpts ...GetErc20TransfersForWalletAddressQueryParamOpts) (*utils.Response[Erc20TransfersResponse], error) {
-	apiURL := fmt.Sprintf("https://api.covalenthq.com/v1/%v/address/%s/transfers_v2/", chainName, walletAddress)
+	apiURL := fmt.Sprintf("%s/v1/%v/address/%s/transfers_v2/", s.Settings.BaseURL, chainName, walletAddress)
 
 	if !s.IskeyValid {
 		errorCode := 401
@@ -908,10 +978,10 @@ func (s *balanceServiceImpl) GetErc20TransfersForWalletAddressByPage(chainName c
 	parsedURL.RawQuery = params.Encode()
 
 	// Create an HTTP client
-	client := &http.Client{}
+	client := s.Settings.HTTPClient
 
 	// Create a GET request
-	req, err := http.NewRequest("GET", parsedURL.String(), nil)
+	req, err := http.NewRequestWithContext(s.Settings.Context, "GET", parsedURL.String(), nil)
 
 	if err != nil {
 		errorCode := 500
@@ -919,7 +989,14 @@ func (s *balanceServiceImpl) GetErc20TransfersForWalletAddressByPage(chainName c
 		return &utils.Response[Erc20TransfersResponse]{Data: nil, Error: true, ErrorCode: &errorCode, ErrorMessage: &errorMessage}, err
 	}
 
-	req.Header.Set("Authorization", `Bearer `+s.APIKey)
+	apiKey, err := s.Settings.APIKey()
+	if err != nil {
+		errorCode := 401
+		errorMessage := err.Error()
+		return &utils.Response[Erc20TransfersResponse]{Data: nil, Error: true, ErrorCode: &errorCode, ErrorMessage: &errorMessage}, err
+	}
+
+	req.Header.Set("Authorization", `Bearer `+apiKey)
 	req.Header.Set("X-Requested-With", utils.UserAgent)
 
 	var startTime time.Time // Declares startTime, initially set to zero value of time.Time
@@ -929,6 +1006,7 @@ func (s *balanceServiceImpl) GetErc20TransfersForWalletAddressByPage(chainName c
 	}
 
 	// Perform the request
+	requestStart := time.Now()
 	resp, err := client.Do(req)
 	if err != nil {
 		// create a &Response that returns data: nil, error: true, errorCode: "Unknown Error Code", errorMessage: "Unknown Error"
@@ -937,41 +1015,44 @@ func (s *balanceServiceImpl) GetErc20TransfersForWalletAddressByPage(chainName c
 
 	defer resp.Body.Close()
 
+	s.Settings.Report(apiKey, resp.StatusCode)
+	meta := s.Settings.NewResponseMeta(resp, requestStart)
 	utils.DebugOutput(resp.Request.URL.String(), resp.StatusCode, startTime)
-	backoff := utils.NewExponentialBackoff(s.APIKey, s.Debug, 0, utils.UserAgent)
+	backoff := utils.NewExponentialBackoffWithSettings(s.Settings, s.Debug, 0, utils.UserAgent)
 
 	// // Read the response body
 	var data utils.Response[Erc20TransfersResponse]
 
-	if resp.StatusCode == 429 {
+	if resp.StatusCode == 429 && backoff.Enabled() {
 		res, err := backoff.BackOff(resp.Request.URL.String())
 		if err != nil {
 			errorCode := resp.StatusCode
 			errorMessage := err.Error()
-			return &utils.Response[Erc20TransfersResponse]{Data: nil, Error: true, ErrorCode: &errorCode, ErrorMessage: &errorMessage}, err
+			return &utils.Response[Erc20TransfersResponse]{Data: nil, Error: true, ErrorCode: &errorCode, ErrorMessage: &errorMessage, Meta: meta.Finish()}, err
 		}
+		meta.Retried(res, 1+backoff.RetryCount)
 
-		if err := json.NewDecoder(res.Body).Decode(&data); err != nil {
+		if err := s.Settings.Decode(res.Body, &data, "GetErc20TransfersForWalletAddressByPage"); err != nil {
 			res.Body.Close()
 			errorCode := 500
 			errorMessage := err.Error()
-			return &utils.Response[Erc20TransfersResponse]{Data: nil, Error: true, ErrorCode: &errorCode, ErrorMessage: &errorMessage}, err
+			return &utils.Response[Erc20TransfersResponse]{Data: nil, Error: true, ErrorCode: &errorCode, ErrorMessage: &errorMessage, Meta: meta.Finish()}, err
 		}
 		res.Body.Close()
 	} else {
-		err = json.NewDecoder(resp.Body).Decode(&data)
+		err = s.Settings.Decode(resp.Body, &data, "GetErc20TransfersForWalletAddressByPage")
 		if err != nil {
 			errorCode := 500
 			errorMessage := err.Error()
-			return &utils.Response[Erc20TransfersResponse]{Data: nil, Error: true, ErrorCode: &errorCode, ErrorMessage: &errorMessage}, err
+			return &utils.Response[Erc20TransfersResponse]{Data: nil, Error: true, ErrorCode: &errorCode, ErrorMessage: &errorMessage, Meta: meta.Finish()}, err
 		}
 	}
 
 	if data.Error {
-		return &utils.Response[Erc20TransfersResponse]{Data: data.Data, Error: data.Error, ErrorCode: data.ErrorCode, ErrorMessage: data.ErrorMessage}, fmt.Errorf(*data.ErrorMessage)
+		return &utils.Response[Erc20TransfersResponse]{Data: data.Data, Error: data.Error, ErrorCode: data.ErrorCode, ErrorMessage: data.ErrorMessage, Meta: meta.Finish()}, fmt.Errorf(*data.ErrorMessage)
 	}
 
-	return &utils.Response[Erc20TransfersResponse]{Data: data.Data, Error: data.Error, ErrorCode: data.ErrorCode, ErrorMessage: data.ErrorMessage}, nil
+	return &utils.Response[Erc20TransfersResponse]{Data: data.Data, Error: data.Error, ErrorCode: data.ErrorCode, ErrorMessage: data.ErrorMessage, Meta: meta.Finish()}, nil
 }
 
 func (s *balanceServiceImpl) GetTokenHoldersV2ForTokenAddress(chainName chains.Chain, tokenAddress string, queryParamOpts ...GetTokenHoldersV2ForTokenAddressQueryParamOpts) <-chan TokenHolderResult {
@@ -987,7 +1068,7 @@ func (s *balanceServiceImpl) GetTokenHoldersV2ForTokenAddress(chainName chains.C
 			return
 		}
 
-		apiURL := fmt.Sprintf("https://api.covalenthq.com/v1/%v/tokens/%s/token_holders_v2/", chainName, tokenAddress)
+		apiURL := fmt.Sprintf("%s/v1/%v/tokens/%s/token_holders_v2/", s.Settings.BaseURL, chainName, tokenAddress)
 
 		// Parse the formatted URL
 		parsedURL, err := url.Parse(apiURL)
@@ -1035,13 +1116,15 @@ func (s *balanceServiceImpl) GetTokenHoldersV2ForTokenAddress(chainName chains.C
 		var data utils.Response[TokenHoldersResponse]
 		for hasNext {
 
-			res, err := utils.PaginateEndpoint(apiURL, s.APIKey, params, page, s.Debug, s.ThreadCount, utils.UserAgent)
+			res, err := utils.PaginateEndpointWithSettings(s.Settings, apiURL, params, page, s.Debug, s.ThreadCount, utils.UserAgent)
 			if err != nil {
 				tokenHolderChannel <- TokenHolderResult{Err: err}
 				hasNext = false
 				return
 			}
-			if err := json.NewDecoder(res.Body).Decode(&data); err != nil {
+			if err := utils.DecodeItems(s.Settings, res.Body, &data, "GetTokenHoldersV2ForTokenAddress", func(item TokenHolder) {
+				tokenHolderChannel <- TokenHolderResult{TokenHolder: item}
+			}); err != nil {
 				tokenHolderChannel <- TokenHolderResult{Err: err}
 				res.Body.Close()
 				hasNext = false
@@ -1060,10 +1143,6 @@ func (s *balanceServiceImpl) GetTokenHoldersV2ForTokenAddress(chainName chains.C
 				return
 			}
 
-			for _, item := range data.Data.Items {
-				tokenHolderChannel <- TokenHolderResult{TokenHolder: item, Err: err}
-			}
-
 			hasNext = *data.Data.Pagination.HasMore
 			page++
 		}
@@ -1073,7 +1152,7 @@ func (s *balanceServiceImpl) GetTokenHoldersV2ForTokenAddress(chainName chains.C
 }
 
 func (s *balanceServiceImpl) GetTokenHoldersV2ForTokenAddressByPage(chainName chains.Chain, tokenAddress string, queryParamOpts ...GetTokenHoldersV2ForTokenAddressQueryParamOpts) (*utils.Response[TokenHoldersResponse], error) {
-	apiURL := fmt.Sprintf("https://api.covalenthq.com/v1/%v/tokens/%s/token_holders_v2/", chainName, tokenAddress)
+	apiURL := fmt.Sprintf("%s/v1/%v/tokens/%s/token_holders_v2/", s.Settings.BaseURL, chainName, tokenAddress)
 
 	if !s.IskeyValid {
 		errorCode := 401
@@ -1112,10 +1191,10 @@ func (s *balanceServiceImpl) GetTokenHoldersV2ForTokenAddressByPage(chainName ch
 	parsedURL.RawQuery = params.Encode()
 
 	// Create an HTTP client
-	client := &http.Client{}
+	client := s.Settings.HTTPClient
 
 	// Create a GET request
-	req, err := http.NewRequest("GET", parsedURL.String(), nil)
+	req, err := http.NewRequestWithContext(s.Settings.Context, "GET", parsedURL.String(), nil)
 
 	if err != nil {
 		errorCode := 500
@@ -1123,7 +1202,14 @@ func (s *balanceServiceImpl) GetTokenHoldersV2ForTokenAddressByPage(chainName ch
 		return &utils.Response[TokenHoldersResponse]{Data: nil, Error: true, ErrorCode: &errorCode, ErrorMessage: &errorMessage}, err
 	}
 
-	req.Header.Set("Authorization", `Bearer `+s.APIKey)
+	apiKey, err := s.Settings.APIKey()
+	if err != nil {
+		errorCode := 401
+		errorMessage := err.Error()
+		return &utils.Response[TokenHoldersResponse]{Data: nil, Error: true, ErrorCode: &errorCode, ErrorMessage: &errorMessage}, err
+	}
+
+	req.Header.Set("Authorization", `Bearer `+apiKey)
 	req.Header.Set("X-Requested-With", utils.UserAgent)
 
 	var startTime time.Time // Declares startTime, initially set to zero value of time.Time
@@ -1133,6 +1219,7 @@ func (s *balanceServiceImpl) GetTokenHoldersV2ForTokenAddressByPage(chainName ch
 	}
 
 	// Perform the request
+	requestStart := time.Now()
 	resp, err := client.Do(req)
 	if err != nil {
 		// create a &Response that returns data: nil, error: true, errorCode: "Unknown Error Code", errorMessage: "Unknown Error"
@@ -1141,46 +1228,49 @@ func (s *balanceServiceImpl) GetTokenHoldersV2ForTokenAddressByPage(chainName ch
 
 	defer resp.Body.Close()
 
+	s.Settings.Report(apiKey, resp.StatusCode)
+	meta := s.Settings.NewResponseMeta(resp, requestStart)
 	utils.DebugOutput(resp.Request.URL.String(), resp.StatusCode, startTime)
-	backoff := utils.NewExponentialBackoff(s.APIKey, s.Debug, 0, utils.UserAgent)
+	backoff := utils.NewExponentialBackoffWithSettings(s.Settings, s.Debug, 0, utils.UserAgent)
 
 	// // Read the response body
 	var data utils.Response[TokenHoldersResponse]
 
-	if resp.StatusCode == 429 {
+	if resp.StatusCode == 429 && backoff.Enabled() {
 		res, err := backoff.BackOff(resp.Request.URL.String())
 		if err != nil {
 			errorCode := resp.StatusCode
 			errorMessage := err.Error()
-			return &utils.Response[TokenHoldersResponse]{Data: nil, Error: true, ErrorCode: &errorCode, ErrorMessage: &errorMessage}, err
+			return &utils.Response[TokenHoldersResponse]{Data: nil, Error: true, ErrorCode: &errorCode, ErrorMessage: &errorMessage, Meta: meta.Finish()}, err
 		}
+		meta.Retried(res, 1+backoff.RetryCount)
 
-		if err := json.NewDecoder(res.Body).Decode(&data); err != nil {
+		if err := s.Settings.Decode(res.Body, &data, "GetTokenHoldersV2ForTokenAddressByPage"); err != nil {
 			res.Body.Close()
 			errorCode := 500
 			errorMessage := err.Error()
-			return &utils.Response[TokenHoldersResponse]{Data: nil, Error: true, ErrorCode: &errorCode, ErrorMessage: &errorMessage}, err
+			return &utils.Response[TokenHoldersResponse]{Data: nil, Error: true, ErrorCode: &errorCode, ErrorMessage: &errorMessage, Meta: meta.Finish()}, err
 		}
 		res.Body.Close()
 	} else {
-		err = json.NewDecoder(resp.Body).Decode(&data)
+		err = s.Settings.Decode(resp.Body, &data, "GetTokenHoldersV2ForTokenAddressByPage")
 		if err != nil {
 			errorCode := 500
 			errorMessage := err.Error()
-			return &utils.Response[TokenHoldersResponse]{Data: nil, Error: true, ErrorCode: &errorCode, ErrorMessage: &errorMessage}, err
+			return &utils.Response[TokenHoldersResponse]{Data: nil, Error: true, ErrorCode: &errorCode, ErrorMessage: &errorMessage, Meta: meta.Finish()}, err
 		}
 	}
 
 	if data.Error {
-		return &utils.Response[TokenHoldersResponse]{Data: data.Data, Error: data.Error, ErrorCode: data.ErrorCode, ErrorMessage: data.ErrorMessage}, fmt.Errorf(*data.ErrorMessage)
+		return &utils.Response[TokenHoldersResponse]{Data: data.Data, Error: data.Error, ErrorCode: data.ErrorCode, ErrorMessage: data.ErrorMessage, Meta: meta.Finish()}, fmt.Errorf(*data.ErrorMessage)
 	}
 
-	return &utils.Response[TokenHoldersResponse]{Data: data.Data, Error: data.Error, ErrorCode: data.ErrorCode, ErrorMessage: data.ErrorMessage}, nil
+	return &utils.Response[TokenHoldersResponse]{Data: data.Data, Error: data.Error, ErrorCode: data.ErrorCode, ErrorMessage: data.ErrorMessage, Meta: meta.Finish()}, nil
 }
 
 func (s *balanceServiceImpl) GetHistoricalTokenBalancesForWalletAddress(chainName chains.Chain, walletAddress string, queryParamOpts ...GetHistoricalTokenBalancesForWalletAddressQueryParamOpts) (*utils.Response[HistoricalBalancesResponse], error) {
 
-	apiURL := fmt.Sprintf("https://api.covalenthq.com/v1/%v/address/%s/historical_balances/", chainName, walletAddress)
+	apiURL := fmt.Sprintf("%s/v1/%v/address/%s/historical_balances/", s.Settings.BaseURL, chainName, walletAddress)
 
 	if !s.IskeyValid {
 		errorCode := 401
@@ -1231,10 +1321,10 @@ func (s *balanceServiceImpl) GetHistoricalTokenBalancesForWalletAddress(chainNam
 	parsedURL.RawQuery = params.Encode()
 
 	// Create an HTTP client
-	client := &http.Client{}
+	client := s.Settings.HTTPClient
 
 	// Create a GET request
-	req, err := http.NewRequest("GET", parsedURL.String(), nil)
+	req, err := http.NewRequestWithContext(s.Settings.Context, "GET", parsedURL.String(), nil)
 
 	if err != nil {
 		errorCode := 500
@@ -1242,7 +1332,14 @@ func (s *balanceServiceImpl) GetHistoricalTokenBalancesForWalletAddress(chainNam
 		return &utils.Response[HistoricalBalancesResponse]{Data: nil, Error: true, ErrorCode: &errorCode, ErrorMessage: &errorMessage}, err
 	}
 
-	req.Header.Set("Authorization", `Bearer `+s.APIKey)
+	apiKey, err := s.Settings.APIKey()
+	if err != nil {
+		errorCode := 401
+		errorMessage := err.Error()
+		return &utils.Response[HistoricalBalancesResponse]{Data: nil, Error: true, ErrorCode: &errorCode, ErrorMessage: &errorMessage}, err
+	}
+
+	req.Header.Set("Authorization", `Bearer `+apiKey)
 	req.Header.Set("X-Requested-With", utils.UserAgent)
 
 	var startTime time.Time // Declares startTime, initially set to zero value of time.Time
@@ -1252,6 +1349,7 @@ func (s *balanceServiceImpl) GetHistoricalTokenBalancesForWalletAddress(chainNam
 	}
 
 	// Perform the request
+	requestStart := time.Now()
 	resp, err := client.Do(req)
 	if err != nil {
 		// create a &Response that returns data: nil, error: true, errorCode: "Unknown Error Code", errorMessage: "Unknown Error"
@@ -1260,46 +1358,49 @@ func (s *balanceServiceImpl) GetHistoricalTokenBalancesForWalletAddress(chainNam
 
 	defer resp.Body.Close()
 
+	s.Settings.Report(apiKey, resp.StatusCode)
+	meta := s.Settings.NewResponseMeta(resp, requestStart)
 	utils.DebugOutput(resp.Request.URL.String(), resp.StatusCode, startTime)
-	backoff := utils.NewExponentialBackoff(s.APIKey, s.Debug, 0, utils.UserAgent)
+	backoff := utils.NewExponentialBackoffWithSettings(s.Settings, s.Debug, 0, utils.UserAgent)
 
 	// // Read the response body
 	var data utils.Response[HistoricalBalancesResponse]
 
-	if resp.StatusCode == 429 {
+	if resp.StatusCode == 429 && backoff.Enabled() {
 		res, err := backoff.BackOff(resp.Request.URL.String())
 		if err != nil {
 			errorCode := resp.StatusCode
 			errorMessage := err.Error()
-			return &utils.Response[HistoricalBalancesResponse]{Data: nil, Error: true, ErrorCode: &errorCode, ErrorMessage: &errorMessage}, err
+			return &utils.Response[HistoricalBalancesResponse]{Data: nil, Error: true, ErrorCode: &errorCode, ErrorMessage: &errorMessage, Meta: meta.Finish()}, err
 		}
+		meta.Retried(res, 1+backoff.RetryCount)
 
-		if err := json.NewDecoder(res.Body).Decode(&data); err != nil {
+		if err := s.Settings.Decode(res.Body, &data, "GetHistoricalTokenBalancesForWalletAddress"); err != nil {
 			res.Body.Close()
 			errorCode := 500
 			errorMessage := err.Error()
-			return &utils.Response[HistoricalBalancesResponse]{Data: nil, Error: true, ErrorCode: &errorCode, ErrorMessage: &errorMessage}, err
+			return &utils.Response[HistoricalBalancesResponse]{Data: nil, Error: true, ErrorCode: &errorCode, ErrorMessage: &errorMessage, Meta: meta.Finish()}, err
 		}
 		res.Body.Close()
 	} else {
-		err = json.NewDecoder(resp.Body).Decode(&data)
+		err = s.Settings.Decode(resp.Body, &data, "GetHistoricalTokenBalancesForWalletAddress")
 		if err != nil {
 			errorCode := 500
 			errorMessage := err.Error()
-			return &utils.Response[HistoricalBalancesResponse]{Data: nil, Error: true, ErrorCode: &errorCode, ErrorMessage: &errorMessage}, err
+			return &utils.Response[HistoricalBalancesResponse]{Data: nil, Error: true, ErrorCode: &errorCode, ErrorMessage: &errorMessage, Meta: meta.Finish()}, err
 		}
 	}
 
 	if data.Error {
-		return &utils.Response[HistoricalBalancesResponse]{Data: data.Data, Error: data.Error, ErrorCode: data.ErrorCode, ErrorMessage: data.ErrorMessage}, fmt.Errorf(*data.ErrorMessage)
+		return &utils.Response[HistoricalBalancesResponse]{Data: data.Data, Error: data.Error, ErrorCode: data.ErrorCode, ErrorMessage: data.ErrorMessage, Meta: meta.Finish()}, fmt.Errorf(*data.ErrorMessage)
 	}
 
-	return &utils.Response[HistoricalBalancesResponse]{Data: data.Data, Error: data.Error, ErrorCode: data.ErrorCode, ErrorMessage: data.ErrorMessage}, nil
+	return &utils.Response[HistoricalBalancesResponse]{Data: data.Data, Error: data.Error, ErrorCode: data.ErrorCode, ErrorMessage: data.ErrorMessage, Meta: meta.Finish()}, nil
 }
 
 func (s *balanceServiceImpl) GetNativeTokenBalance(chainName chains.Chain, walletAddress string, queryParamOpts ...GetNativeTokenBalanceQueryParamOpts) (*utils.Response[TokenBalanceNativeResponse], error) {
 
-	apiURL := fmt.Sprintf("https://api.covalenthq.com/v1/%v/address/%s/balances_native/", chainName, walletAddress)
+	apiURL := fmt.Sprintf("%s/v1/%v/address/%s/balances_native/", s.Settings.BaseURL, chainName, walletAddress)
 
 	if !s.IskeyValid {
 		errorCode := 401
@@ -1330,10 +1431,10 @@ func (s *balanceServiceImpl) GetNativeTokenBalance(chainName chains.Chain, walle
 	parsedURL.RawQuery = params.Encode()
 
 	// Create an HTTP client
-	client := &http.Client{}
+	client := s.Settings.HTTPClient
 
 	// Create a GET request
-	req, err := http.NewRequest("GET", parsedURL.String(), nil)
+	req, err := http.NewRequestWithContext(s.Settings.Context, "GET", parsedURL.String(), nil)
 
 	if err != nil {
 		errorCode := 500
@@ -1341,7 +1442,14 @@ func (s *balanceServiceImpl) GetNativeTokenBalance(chainName chains.Chain, walle
 		return &utils.Response[TokenBalanceNativeResponse]{Data: nil, Error: true, ErrorCode: &errorCode, ErrorMessage: &errorMessage}, err
 	}
 
-	req.Header.Set("Authorization", `Bearer `+s.APIKey)
+	apiKey, err := s.Settings.APIKey()
+	if err != nil {
+		errorCode := 401
+		errorMessage := err.Error()
+		return &utils.Response[TokenBalanceNativeResponse]{Data: nil, Error: true, ErrorCode: &errorCode, ErrorMessage: &errorMessage}, err
+	}
+
+	req.Header.Set("Authorization", `Bearer `+apiKey)
 	req.Header.Set("X-Requested-With", utils.UserAgent)
 
 	var startTime time.Time // Declares startTime, initially set to zero value of time.Time
@@ -1351,6 +1459,7 @@ func (s *balanceServiceImpl) GetNativeTokenBalance(chainName chains.Chain, walle
 	}
 
 	// Perform the request
+	requestStart := time.Now()
 	resp, err := client.Do(req)
 	if err != nil {
 		// create a &Response that returns data: nil, error: true, errorCode: "Unknown Error Code", errorMessage: "Unknown Error"
@@ -1359,39 +1468,187 @@ func (s *balanceServiceImpl) GetNativeTokenBalance(chainName chains.Chain, walle
 
 	defer resp.Body.Close()
 
+	s.Settings.Report(apiKey, resp.StatusCode)
+	meta := s.Settings.NewResponseMeta(resp, requestStart)
 	utils.DebugOutput(resp.Request.URL.String(), resp.StatusCode, startTime)
-	backoff := utils.NewExponentialBackoff(s.APIKey, s.Debug, 0, utils.UserAgent)
+	backoff := utils.NewExponentialBackoffWithSettings(s.Settings, s.Debug, 0, utils.UserAgent)
 
 	// // Read the response body
 	var data utils.Response[TokenBalanceNativeResponse]
 
-	if resp.StatusCode == 429 {
+	if resp.StatusCode == 429 && backoff.Enabled() {
 		res, err := backoff.BackOff(resp.Request.URL.String())
 		if err != nil {
 			errorCode := resp.StatusCode
 			errorMessage := err.Error()
-			return &utils.Response[TokenBalanceNativeResponse]{Data: nil, Error: true, ErrorCode: &errorCode, ErrorMessage: &errorMessage}, err
+			return &utils.Response[TokenBalanceNativeResponse]{Data: nil, Error: true, ErrorCode: &errorCode, ErrorMessage: &errorMessage, Meta: meta.Finish()}, err
 		}
+		meta.Retried(res, 1+backoff.RetryCount)
 
-		if err := json.NewDecoder(res.Body).Decode(&data); err != nil {
+		if err := s.Settings.Decode(res.Body, &data, "GetNativeTokenBalance"); err != nil {
 			res.Body.Close()
 			errorCode := 500
 			errorMessage := err.Error()
-			return &utils.Response[TokenBalanceNativeResponse]{Data: nil, Error: true, ErrorCode: &errorCode, ErrorMessage: &errorMessage}, err
+			return &utils.Response[TokenBalanceNativeResponse]{Data: nil, Error: true, ErrorCode: &errorCode, ErrorMessage: &errorMessage, Meta: meta.Finish()}, err
 		}
 		res.Body.Close()
 	} else {
-		err = json.NewDecoder(resp.Body).Decode(&data)
+		err = s.Settings.Decode(resp.Body, &data, "GetNativeTokenBalance")
 		if err != nil {
 			errorCode := 500
 			errorMessage := err.Error()
-			return &utils.Response[TokenBalanceNativeResponse]{Data: nil, Error: true, ErrorCode: &errorCode, ErrorMessage: &errorMessage}, err
+			return &utils.Response[TokenBalanceNativeResponse]{Data: nil, Error: true, ErrorCode: &errorCode, ErrorMessage: &errorMessage, Meta: meta.Finish()}, err
 		}
 	}
 
 	if data.Error {
-		return &utils.Response[TokenBalanceNativeResponse]{Data: data.Data, Error: data.Error, ErrorCode: data.ErrorCode, ErrorMessage: data.ErrorMessage}, fmt.Errorf(*data.ErrorMessage)
+		return &utils.Response[TokenBalanceNativeResponse]{Data: data.Data, Error: data.Error, ErrorCode: data.ErrorCode, ErrorMessage: data.ErrorMessage, Meta: meta.Finish()}, fmt.Errorf(*data.ErrorMessage)
+	}
+
+	return &utils.Response[TokenBalanceNativeResponse]{Data: data.Data, Error: data.Error, ErrorCode: data.ErrorCode, ErrorMessage: data.ErrorMessage, Meta: meta.Finish()}, nil
+}
+
+func (s *balanceServiceImpl) GetTokenBalancesForWalletAddresses(requests []WalletBalanceRequest, batchOpts WalletBalanceBatchOpts, queryParamOpts ...GetTokenBalancesForWalletAddressQueryParamOpts) <-chan WalletBalanceBatchResult[BalancesResponse] {
+	batch := s.withBatchContext(&batchOpts)
+	return runWalletBalanceBatch(requests, batchOpts, s.ThreadCount, func(request WalletBalanceRequest) (*utils.Response[BalancesResponse], error) {
+		return batch.GetTokenBalancesForWalletAddress(request.ChainName, request.WalletAddress, queryParamOpts...)
+	})
+}
+
+func (s *balanceServiceImpl) GetNativeTokenBalances(requests []WalletBalanceRequest, batchOpts WalletBalanceBatchOpts, queryParamOpts ...GetNativeTokenBalanceQueryParamOpts) <-chan WalletBalanceBatchResult[TokenBalanceNativeResponse] {
+	batch := s.withBatchContext(&batchOpts)
+	return runWalletBalanceBatch(requests, batchOpts, s.ThreadCount, func(request WalletBalanceRequest) (*utils.Response[TokenBalanceNativeResponse], error) {
+		return batch.GetNativeTokenBalance(request.ChainName, request.WalletAddress, queryParamOpts...)
+	})
+}
+
+// withBatchContext fills in the context of batchOpts and returns a copy of the service making its requests with it.
+func (s *balanceServiceImpl) withBatchContext(batchOpts *WalletBalanceBatchOpts) *balanceServiceImpl {
+	if batchOpts.Context == nil {
+		batchOpts.Context = s.Settings.Context
+	}
+	batch := *s
+	batch.Settings.Context = batchOpts.Context
+	return &batch
+}
+
+// FailedWalletBalanceRequests returns the requests of the results that ended in an error, so they can be submitted again as a new batch.
+func FailedWalletBalanceRequests[T any](results []WalletBalanceBatchResult[T]) []WalletBalanceRequest {
+	var failed []WalletBalanceRequest
+	for _, result := range results {
+		if result.Err != nil {
+			failed = append(failed, result.Request)
+		}
 	}
+	return failed
+}
+
+func runWalletBalanceBatch[T any](requests []WalletBalanceRequest, batchOpts WalletBalanceBatchOpts, threadCount int, call func(request WalletBalanceRequest) (*utils.Response[T], error)) <-chan WalletBalanceBatchResult[T] {
+	resultChannel := make(chan WalletBalanceBatchResult[T])
 
-	return &utils.Response[TokenBalanceNativeResponse]{Data: data.Data, Error: data.Error, ErrorCode: data.ErrorCode, ErrorMessage: data.ErrorMessage}, nil
+	concurrency := threadCount
+	if batchOpts.Concurrency != nil && *batchOpts.Concurrency < concurrency {
+		concurrency = *batchOpts.Concurrency
+	}
+	if concurrency < 1 {
+		concurrency = 1
+	}
+
+	maxRetries := 0
+	if batchOpts.MaxRetries != nil {
+		maxRetries = *batchOpts.MaxRetries
+	}
+
+	retryDelay := DefaultBatchRetryDelay
+	if batchOpts.RetryDelay != nil {
+		retryDelay = batchOpts.RetryDelay
+	}
+
+	ctx := batchOpts.Context
+	if ctx == nil {
+		ctx = context.Background()
+	}
+
+	go func() {
+		defer close(resultChannel)
+
+		var mu sync.Mutex
+		var wg sync.WaitGroup
+		completed := 0
+		sem := make(chan struct{}, concurrency)
+
+	requests:
+		for _, request := range requests {
+			select {
+			case sem <- struct{}{}:
+			case <-ctx.Done():
+				break requests
+			}
+			wg.Add(1)
+			go func(request WalletBalanceRequest) {
+				defer wg.Done()
+				defer func() { <-sem }()
+
+				var resp *utils.Response[T]
+				var err error
+				attempts := 0
+				for {
+					attempts++
+					resp, err = call(request)
+					if err == nil || attempts > maxRetries || !isRetryableBatchError(resp) {
+						break
+					}
+					if !sleepContext(ctx, retryDelay(attempts)) {
+						break
+					}
+				}
+				if err != nil {
+					resp = nil
+				}
+
+				mu.Lock()
+				completed++
+				result := WalletBalanceBatchResult[T]{Request: request, Response: resp, Attempts: attempts, Completed: completed, Total: len(requests), Err: err}
+				if batchOpts.OnProgress != nil {
+					batchOpts.OnProgress(result.Completed, result.Total)
+				}
+				mu.Unlock()
+
+				select {
+				case resultChannel <- result:
+				case <-ctx.Done():
+				}
+			}(request)
+		}
+		wg.Wait()
+	}()
+	return resultChannel
+}
+
+// DefaultBatchRetryDelay is the exponential backoff of batch retries: utils.BaseDelayMs before the first retry, doubling
+// for each one after it.
+func DefaultBatchRetryDelay(retry int) time.Duration {
+	backoff := utils.ExponentialBackoff{RetryCount: retry}
+	return backoff.Delay()
+}
+
+// sleepContext waits for delay and reports whether it did, or returns false as soon as ctx is done.
+func sleepContext(ctx context.Context, delay time.Duration) bool {
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+	select {
+	case <-timer.C:
+		return true
+	case <-ctx.Done():
+		return false
+	}
+}
+
+// isRetryableBatchError reports whether a failed batch request may succeed when retried.
+// Requests rejected by the API for client errors, such as an invalid address, are not retried.
+func isRetryableBatchError[T any](resp *utils.Response[T]) bool {
+	if resp == nil || resp.ErrorCode == nil {
+		return true
+	}
+	return *resp.ErrorCode == 429 || *resp.ErrorCode >= 500
 }
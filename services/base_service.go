@@ -1,7 +1,6 @@
 package services
 
 import (
-	"encoding/json"
 	"errors"
 	"fmt"
 	"net/http"
@@ -9,6 +8,7 @@ import (
 	"strconv"
 	"time"
 
+	"github.com/covalenthq/covalent-api-sdk-go/abi"
 	"github.com/covalenthq/covalent-api-sdk-go/chains"
 	"github.com/covalenthq/covalent-api-sdk-go/genericmodels"
 	"github.com/covalenthq/covalent-api-sdk-go/quotes"
@@ -314,7 +314,7 @@ type GetLogsQueryParamOpts struct {
 	EndingBlock *string `json:"endingBlock,omitempty"`
 	// The address of the log events sender contract.
 	Address *string `json:"address,omitempty"`
-	// The topic hash(es) to retrieve logs with.
+	// The topic hash(es) to retrieve logs with. Event signatures, eg: `Transfer(address,address,uint256)`, are replaced with their topic hash.
 	Topics *string `json:"topics,omitempty"`
 	// The block hash to retrieve logs for.
 	BlockHash *string `json:"blockHash,omitempty"`
@@ -352,9 +352,9 @@ type GetGasPricesQueryParamOpts struct {
 	QuoteCurrency *quotes.Quote `json:"quoteCurrency,omitempty"`
 }
 
-func NewBaseServiceImpl(apiKey string, debug bool, threadCount int, isValidKey bool) BaseService {
+func NewBaseServiceImpl(apiKey string, debug bool, threadCount int, isValidKey bool, settings ...utils.RequestSettings) BaseService {
 
-	return &baseServiceImpl{APIKey: apiKey, Debug: debug, ThreadCount: threadCount, IskeyValid: isValidKey}
+	return &baseServiceImpl{APIKey: apiKey, Debug: debug, ThreadCount: threadCount, IskeyValid: isValidKey, Settings: utils.NewRequestSettings(apiKey, settings...)}
 }
 
 type BaseService interface {
@@ -405,13 +405,13 @@ type BaseService interface {
 	// Commonly used to get all event logs of the same topic hash across all contracts within a particular chain. Useful for cross-sectional analysis of event logs that are emitted on-chain.
 	//   Parameters:
 	// chainName: The chain name eg: `eth-mainnet`.. Type: chains.Chain
-	// topicHash: The endpoint will return event logs that contain this topic hash.. Type: string
+	// topicHash: The endpoint will return event logs that contain this topic hash, or the topic hash of this event signature, eg: `Transfer(address,address,uint256)`.. Type: string
 	GetLogEventsByTopicHash(chainName chains.Chain, topicHash string, queryParamOpts ...GetLogEventsByTopicHashQueryParamOpts) <-chan LogEventResult
 
 	// Commonly used to get all event logs of the same topic hash across all contracts within a particular chain. Useful for cross-sectional analysis of event logs that are emitted on-chain.
 	//   Parameters:
 	// chainName: The chain name eg: `eth-mainnet`.. Type: chains.Chain
-	// topicHash: The endpoint will return event logs that contain this topic hash.. Type: string
+	// topicHash: The endpoint will return event logs that contain this topic hash, or the topic hash of this event signature, eg: `Transfer(address,address,uint256)`.. Type: string
 	GetLogEventsByTopicHashByPage(chainName chains.Chain, topicHash string, queryParamOpts ...GetLogEventsByTopicHashQueryParamOpts) (*utils.Response[LogEventsByTopicHashResponse], error)
 
 	// Commonly used to build internal dashboards for all supported chains on Covalent.
@@ -441,11 +441,12 @@ type baseServiceImpl struct {
 	Debug       bool
 	ThreadCount int
 	IskeyValid  bool
+	Settings    utils.RequestSettings
 }
 
 func (s *baseServiceImpl) GetBlock(chainName chains.Chain, blockHeight string) (*utils.Response[BlockResponse], error) {
 
-	apiURL := fmt.Sprintf("https://api.covalenthq.com/v1/%v/block_v2/%s/", chainName, blockHeight)
+	apiURL := fmt.Sprintf("%s/v1/%v/block_v2/%s/", s.Settings.BaseURL, chainName, blockHeight)
 
 	if !s.IskeyValid {
 		errorCode := 401
@@ -464,10 +465,10 @@ func (s *baseServiceImpl) GetBlock(chainName chains.Chain, blockHeight string) (
 	parsedURL.RawQuery = params.Encode()
 
 	// Create an HTTP client
-	client := &http.Client{}
+	client := s.Settings.HTTPClient
 
 	// Create a GET request
-	req, err := http.NewRequest("GET", parsedURL.String(), nil)
+	req, err := http.NewRequestWithContext(s.Settings.Context, "GET", parsedURL.String(), nil)
 
 	if err != nil {
 		errorCode := 500
@@ -475,7 +476,14 @@ func (s *baseServiceImpl) GetBlock(chainName chains.Chain, blockHeight string) (
 		return &utils.Response[BlockResponse]{Data: nil, Error: true, ErrorCode: &errorCode, ErrorMessage: &errorMessage}, err
 	}
 
-	req.Header.Set("Authorization", `Bearer `+s.APIKey)
+	apiKey, err := s.Settings.APIKey()
+	if err != nil {
+		errorCode := 401
+		errorMessage := err.Error()
+		return &utils.Response[BlockResponse]{Data: nil, Error: true, ErrorCode: &errorCode, ErrorMessage: &errorMessage}, err
+	}
+
+	req.Header.Set("Authorization", `Bearer `+apiKey)
 	req.Header.Set("X-Requested-With", utils.UserAgent)
 
 	var startTime time.Time // Declares startTime, initially set to zero value of time.Time
@@ -485,6 +493,7 @@ func (s *baseServiceImpl) GetBlock(chainName chains.Chain, blockHeight string) (
 	}
 
 	// Perform the request
+	requestStart := time.Now()
 	resp, err := client.Do(req)
 	if err != nil {
 		// create a &Response that returns data: nil, error: true, errorCode: "Unknown Error Code", errorMessage: "Unknown Error"
@@ -493,46 +502,49 @@ func (s *baseServiceImpl) GetBlock(chainName chains.Chain, blockHeight string) (
 
 	defer resp.Body.Close()
 
+	s.Settings.Report(apiKey, resp.StatusCode)
+	meta := s.Settings.NewResponseMeta(resp, requestStart)
 	utils.DebugOutput(resp.Request.URL.String(), resp.StatusCode, startTime)
-	backoff := utils.NewExponentialBackoff(s.APIKey, s.Debug, 0, utils.UserAgent)
+	backoff := utils.NewExponentialBackoffWithSettings(s.Settings, s.Debug, 0, utils.UserAgent)
 
 	// // Read the response body
 	var data utils.Response[BlockResponse]
 
-	if resp.StatusCode == 429 {
+	if resp.StatusCode == 429 && backoff.Enabled() {
 		res, err := backoff.BackOff(resp.Request.URL.String())
 		if err != nil {
 			errorCode := resp.StatusCode
 			errorMessage := err.Error()
-			return &utils.Response[BlockResponse]{Data: nil, Error: true, ErrorCode: &errorCode, ErrorMessage: &errorMessage}, err
+			return &utils.Response[BlockResponse]{Data: nil, Error: true, ErrorCode: &errorCode, ErrorMessage: &errorMessage, Meta: meta.Finish()}, err
 		}
+		meta.Retried(res, 1+backoff.RetryCount)
 
-		if err := json.NewDecoder(res.Body).Decode(&data); err != nil {
+		if err := s.Settings.Decode(res.Body, &data, "GetBlock"); err != nil {
 			res.Body.Close()
 			errorCode := 500
 			errorMessage := err.Error()
-			return &utils.Response[BlockResponse]{Data: nil, Error: true, ErrorCode: &errorCode, ErrorMessage: &errorMessage}, err
+			return &utils.Response[BlockResponse]{Data: nil, Error: true, ErrorCode: &errorCode, ErrorMessage: &errorMessage, Meta: meta.Finish()}, err
 		}
 		res.Body.Close()
 	} else {
-		err = json.NewDecoder(resp.Body).Decode(&data)
+		err = s.Settings.Decode(resp.Body, &data, "GetBlock")
 		if err != nil {
 			errorCode := 500
 			errorMessage := err.Error()
-			return &utils.Response[BlockResponse]{Data: nil, Error: true, ErrorCode: &errorCode, ErrorMessage: &errorMessage}, err
+			return &utils.Response[BlockResponse]{Data: nil, Error: true, ErrorCode: &errorCode, ErrorMessage: &errorMessage, Meta: meta.Finish()}, err
 		}
 	}
 
 	if data.Error {
-		return &utils.Response[BlockResponse]{Data: data.Data, Error: data.Error, ErrorCode: data.ErrorCode, ErrorMessage: data.ErrorMessage}, fmt.Errorf(*data.ErrorMessage)
+		return &utils.Response[BlockResponse]{Data: data.Data, Error: data.Error, ErrorCode: data.ErrorCode, ErrorMessage: data.ErrorMessage, Meta: meta.Finish()}, fmt.Errorf(*data.ErrorMessage)
 	}
 
-	return &utils.Response[BlockResponse]{Data: data.Data, Error: data.Error, ErrorCode: data.ErrorCode, ErrorMessage: data.ErrorMessage}, nil
+	return &utils.Response[BlockResponse]{Data: data.Data, Error: data.Error, ErrorCode: data.ErrorCode, ErrorMessage: data.ErrorMessage, Meta: meta.Finish()}, nil
 }
 
 func (s *baseServiceImpl) GetResolvedAddress(chainName chains.Chain, walletAddress string) (*utils.Response[ResolvedAddress], error) {
 
-	apiURL := fmt.Sprintf("https://api.covalenthq.com/v1/%v/address/%s/resolve_address/", chainName, walletAddress)
+	apiURL := fmt.Sprintf("%s/v1/%v/address/%s/resolve_address/", s.Settings.BaseURL, chainName, walletAddress)
 
 	if !s.IskeyValid {
 		errorCode := 401
@@ -551,10 +563,10 @@ func (s *baseServiceImpl) GetResolvedAddress(chainName chains.Chain, walletAddre
 	parsedURL.RawQuery = params.Encode()
 
 	// Create an HTTP client
-	client := &http.Client{}
+	client := s.Settings.HTTPClient
 
 	// Create a GET request
-	req, err := http.NewRequest("GET", parsedURL.String(), nil)
+	req, err := http.NewRequestWithContext(s.Settings.Context, "GET", parsedURL.String(), nil)
 
 	if err != nil {
 		errorCode := 500
@@ -562,7 +574,14 @@ func (s *baseServiceImpl) GetResolvedAddress(chainName chains.Chain, walletAddre
 		return &utils.Response[ResolvedAddress]{Data: nil, Error: true, ErrorCode: &errorCode, ErrorMessage: &errorMessage}, err
 	}
 
-	req.Header.Set("Authorization", `Bearer `+s.APIKey)
+	apiKey, err := s.Settings.APIKey()
+	if err != nil {
+		errorCode := 401
+		errorMessage := err.Error()
+		return &utils.Response[ResolvedAddress]{Data: nil, Error: true, ErrorCode: &errorCode, ErrorMessage: &errorMessage}, err
+	}
+
+	req.Header.Set("Authorization", `Bearer `+apiKey)
 	req.Header.Set("X-Requested-With", utils.UserAgent)
 
 	var startTime time.Time // Declares startTime, initially set to zero value of time.Time
@@ -572,6 +591,7 @@ func (s *baseServiceImpl) GetResolvedAddress(chainName chains.Chain, walletAddre
 	}
 
 	// Perform the request
+	requestStart := time.Now()
 	resp, err := client.Do(req)
 	if err != nil {
 		// create a &Response that returns data: nil, error: true, errorCode: "Unknown Error Code", errorMessage: "Unknown Error"
@@ -580,41 +600,44 @@ func (s *baseServiceImpl) GetResolvedAddress(chainName chains.Chain, walletAddre
 
 	defer resp.Body.Close()
 
+	s.Settings.Report(apiKey, resp.StatusCode)
+	meta := s.Settings.NewResponseMeta(resp, requestStart)
 	utils.DebugOutput(resp.Request.URL.String(), resp.StatusCode, startTime)
-	backoff := utils.NewExponentialBackoff(s.APIKey, s.Debug, 0, utils.UserAgent)
+	backoff := utils.NewExponentialBackoffWithSettings(s.Settings, s.Debug, 0, utils.UserAgent)
 
 	// // Read the response body
 	var data utils.Response[ResolvedAddress]
 
-	if resp.StatusCode == 429 {
+	if resp.StatusCode == 429 && backoff.Enabled() {
 		res, err := backoff.BackOff(resp.Request.URL.String())
 		if err != nil {
 			errorCode := resp.StatusCode
 			errorMessage := err.Error()
-			return &utils.Response[ResolvedAddress]{Data: nil, Error: true, ErrorCode: &errorCode, ErrorMessage: &errorMessage}, err
+			return &utils.Response[ResolvedAddress]{Data: nil, Error: true, ErrorCode: &errorCode, ErrorMessage: &errorMessage, Meta: meta.Finish()}, err
 		}
+		meta.Retried(res, 1+backoff.RetryCount)
 
-		if err := json.NewDecoder(res.Body).Decode(&data); err != nil {
+		if err := s.Settings.Decode(res.Body, &data, "GetResolvedAddress"); err != nil {
 			res.Body.Close()
 			errorCode := 500
 			errorMessage := err.Error()
-			return &utils.Response[ResolvedAddress]{Data: nil, Error: true, ErrorCode: &errorCode, ErrorMessage: &errorMessage}, err
+			return &utils.Response[ResolvedAddress]{Data: nil, Error: true, ErrorCode: &errorCode, ErrorMessage: &errorMessage, Meta: meta.Finish()}, err
 		}
 		res.Body.Close()
 	} else {
-		err = json.NewDecoder(resp.Body).Decode(&data)
+		err = s.Settings.Decode(resp.Body, &data, "GetResolvedAddress")
 		if err != nil {
 			errorCode := 500
 			errorMessage := err.Error()
-			return &utils.Response[ResolvedAddress]{Data: nil, Error: true, ErrorCode: &errorCode, ErrorMessage: &errorMessage}, err
+			return &utils.Response[ResolvedAddress]{Data: nil, Error: true, ErrorCode: &errorCode, ErrorMessage: &errorMessage, Meta: meta.Finish()}, err
 		}
 	}
 
 	if data.Error {
-		return &utils.Response[ResolvedAddress]{Data: data.Data, Error: data.Error, ErrorCode: data.ErrorCode, ErrorMessage: data.ErrorMessage}, fmt.Errorf(*data.ErrorMessage)
+		return &utils.Response[ResolvedAddress]{Data: data.Data, Error: data.Error, ErrorCode: data.ErrorCode, ErrorMessage: data.ErrorMessage, Meta: meta.Finish()}, fmt.Errorf(*data.ErrorMessage)
 	}
 
-	return &utils.Response[ResolvedAddress]{Data: data.Data, Error: data.Error, ErrorCode: data.ErrorCode, ErrorMessage: data.ErrorMessage}, nil
+	return &utils.Response[ResolvedAddress]{Data: data.Data, Error: data.Error, ErrorCode: data.ErrorCode, ErrorMessage: data.ErrorMessage, Meta: meta.Finish()}, nil
 }
 
 func (s *baseServiceImpl) GetBlockHeights(chainName chains.Chain, startDate string, endDate string, queryParamOpts ...GetBlockHeightsQueryParamOpts) <-chan BlockHeightsResult {
@@ -630,7 +653,7 @@ func (s *baseServiceImpl) GetBlockHeights(chainName chains.Chain, startDate stri
 			return
 		}
 
-		apiURL := fmt.Sprintf("https://api.covalenthq.com/v1/%v/block_v2/%s/%s/", chainName, startDate, endDate)
+		apiURL := fmt.Sprintf("%s/v1/%v/block_v2/%s/%s/", s.Settings.BaseURL, chainName, startDate, endDate)
 
 		// Parse the formatted URL
 		parsedURL, err := url.Parse(apiURL)
@@ -670,13 +693,15 @@ func (s *baseServiceImpl) GetBlockHeights(chainName chains.Chain, startDate stri
 		var data utils.Response[BlockHeightsResponse]
 		for hasNext {
 
-			res, err := utils.PaginateEndpoint(apiURL, s.APIKey, params, page, s.Debug, s.ThreadCount, utils.UserAgent)
+			res, err := utils.PaginateEndpointWithSettings(s.Settings, apiURL, params, page, s.Debug, s.ThreadCount, utils.UserAgent)
 			if err != nil {
 				blockHeightsChannel <- BlockHeightsResult{Err: err}
 				hasNext = false
 				return
 			}
-			if err := json.NewDecoder(res.Body).Decode(&data); err != nil {
+			if err := utils.DecodeItems(s.Settings, res.Body, &data, "GetBlockHeights", func(item BlockHeights) {
+				blockHeightsChannel <- BlockHeightsResult{BlockHeights: item}
+			}); err != nil {
 				blockHeightsChannel <- BlockHeightsResult{Err: err}
 				res.Body.Close()
 				hasNext = false
@@ -695,10 +720,6 @@ func (s *baseServiceImpl) GetBlockHeights(chainName chains.Chain, startDate stri
 				return
 			}
 
-			for _, item := range data.Data.Items {
-				blockHeightsChannel <- BlockHeightsResult{BlockHeights: item, Err: err}
-			}
-
 			hasNext = *data.Data.Pagination.HasMore
 			page++
 		}
@@ -708,7 +729,7 @@ func (s *baseServiceImpl) GetBlockHeights(chainName chains.Chain, startDate stri
 }
 
 func (s *baseServiceImpl) GetBlockHeightsByPage(chainName chains.Chain, startDate string, endDate string, queryParamOpts ...GetBlockHeightsQueryParamOpts) (*utils.Response[BlockHeightsResponse], error) {
-	apiURL := fmt.Sprintf("https://api.covalenthq.com/v1/%v/block_v2/%s/%s/", chainName, startDate, endDate)
+	apiURL := fmt.Sprintf("%s/v1/%v/block_v2/%s/%s/", s.Settings.BaseURL, chainName, startDate, endDate)
 
 	if !s.IskeyValid {
 		errorCode := 401
@@ -739,10 +760,10 @@ func (s *baseServiceImpl) GetBlockHeightsByPage(chainName chains.Chain, startDat
 	parsedURL.RawQuery = params.Encode()
 
 	// Create an HTTP client
-	client := &http.Client{}
+	client := s.Settings.HTTPClient
 
 	// Create a GET request
-	req, err := http.NewRequest("GET", parsedURL.String(), nil)
+	req, err := http.NewRequestWithContext(s.Settings.Context, "GET", parsedURL.String(), nil)
 
 	if err != nil {
 		errorCode := 500
@@ -750,7 +771,14 @@ func (s *baseServiceImpl) GetBlockHeightsByPage(chainName chains.Chain, startDat
 		return &utils.Response[BlockHeightsResponse]{Data: nil, Error: true, ErrorCode: &errorCode, ErrorMessage: &errorMessage}, err
 	}
 
-	req.Header.Set("Authorization", `Bearer `+s.APIKey)
+	apiKey, err := s.Settings.APIKey()
+	if err != nil {
+		errorCode := 401
+		errorMessage := err.Error()
+		return &utils.Response[BlockHeightsResponse]{Data: nil, Error: true, ErrorCode: &errorCode, ErrorMessage: &errorMessage}, err
+	}
+
+	req.Header.Set("Authorization", `Bearer `+apiKey)
 	req.Header.Set("X-Requested-With", utils.UserAgent)
 
 	var startTime time.Time // Declares startTime, initially set to zero value of time.Time
@@ -760,6 +788,7 @@ func (s *baseServiceImpl) GetBlockHeightsByPage(chainName chains.Chain, startDat
 	}
 
 	// Perform the request
+	requestStart := time.Now()
 	resp, err := client.Do(req)
 	if err != nil {
 		// create a &Response that returns data: nil, error: true, errorCode: "Unknown Error Code", errorMessage: "Unknown Error"
@@ -768,46 +797,49 @@ func (s *baseServiceImpl) GetBlockHeightsByPage(chainName chains.Chain, startDat
 
 	defer resp.Body.Close()
 
+	s.Settings.Report(apiKey, resp.StatusCode)
+	meta := s.Settings.NewResponseMeta(resp, requestStart)
 	utils.DebugOutput(resp.Request.URL.String(), resp.StatusCode, startTime)
-	backoff := utils.NewExponentialBackoff(s.APIKey, s.Debug, 0, utils.UserAgent)
+	backoff := utils.NewExponentialBackoffWithSettings(s.Settings, s.Debug, 0, utils.UserAgent)
 
 	// // Read the response body
 	var data utils.Response[BlockHeightsResponse]
 
-	if resp.StatusCode == 429 {
+	if resp.StatusCode == 429 && backoff.Enabled() {
 		res, err := backoff.BackOff(resp.Request.URL.String())
 		if err != nil {
 			errorCode := resp.StatusCode
 			errorMessage := err.Error()
-			return &utils.Response[BlockHeightsResponse]{Data: nil, Error: true, ErrorCode: &errorCode, ErrorMessage: &errorMessage}, err
+			return &utils.Response[BlockHeightsResponse]{Data: nil, Error: true, ErrorCode: &errorCode, ErrorMessage: &errorMessage, Meta: meta.Finish()}, err
 		}
+		meta.Retried(res, 1+backoff.RetryCount)
 
-		if err := json.NewDecoder(res.Body).Decode(&data); err != nil {
+		if err := s.Settings.Decode(res.Body, &data, "GetBlockHeightsByPage"); err != nil {
 			res.Body.Close()
 			errorCode := 500
 			errorMessage := err.Error()
-			return &utils.Response[BlockHeightsResponse]{Data: nil, Error: true, ErrorCode: &errorCode, ErrorMessage: &errorMessage}, err
+			return &utils.Response[BlockHeightsResponse]{Data: nil, Error: true, ErrorCode: &errorCode, ErrorMessage: &errorMessage, Meta: meta.Finish()}, err
 		}
 		res.Body.Close()
 	} else {
-		err = json.NewDecoder(resp.Body).Decode(&data)
+		err = s.Settings.Decode(resp.Body, &data, "GetBlockHeightsByPage")
 		if err != nil {
 			errorCode := 500
 			errorMessage := err.Error()
-			return &utils.Response[BlockHeightsResponse]{Data: nil, Error: true, ErrorCode: &errorCode, ErrorMessage: &errorMessage}, err
+			return &utils.Response[BlockHeightsResponse]{Data: nil, Error: true, ErrorCode: &errorCode, ErrorMessage: &errorMessage, Meta: meta.Finish()}, err
 		}
 	}
 
 	if data.Error {
-		return &utils.Response[BlockHeightsResponse]{Data: data.Data, Error: data.Error, ErrorCode: data.ErrorCode, ErrorMessage: data.ErrorMessage}, fmt.Errorf(*data.ErrorMessage)
+		return &utils.Response[BlockHeightsResponse]{Data: data.Data, Error: data.Error, ErrorCode: data.ErrorCode, ErrorMessage: data.ErrorMessage, Meta: meta.Finish()}, fmt.Errorf(*data.ErrorMessage)
 	}
 
-	return &utils.Response[BlockHeightsResponse]{Data: data.Data, Error: data.Error, ErrorCode: data.ErrorCode, ErrorMessage: data.ErrorMessage}, nil
+	return &utils.Response[BlockHeightsResponse]{Data: data.Data, Error: data.Error, ErrorCode: data.ErrorCode, ErrorMessage: data.ErrorMessage, Meta: meta.Finish()}, nil
 }
 
 func (s *baseServiceImpl) GetLogs(chainName chains.Chain, queryParamOpts ...GetLogsQueryParamOpts) (*utils.Response[GetLogsResponse], error) {
 
-	apiURL := fmt.Sprintf("https://api.covalenthq.com/v1/%v/events/", chainName)
+	apiURL := fmt.Sprintf("%s/v1/%v/events/", s.Settings.BaseURL, chainName)
 
 	if !s.IskeyValid {
 		errorCode := 401
@@ -837,7 +869,13 @@ func (s *baseServiceImpl) GetLogs(chainName chains.Chain, queryParamOpts ...GetL
 		}
 
 		if opts.Topics != nil {
-			params.Add("topics", fmt.Sprintf("%v", *opts.Topics))
+			topics, err := abi.ResolveTopics(*opts.Topics)
+			if err != nil {
+				errorCode := 400
+				errorMessage := err.Error()
+				return &utils.Response[GetLogsResponse]{Data: nil, Error: true, ErrorCode: &errorCode, ErrorMessage: &errorMessage}, err
+			}
+			params.Add("topics", topics)
 		}
 
 		if opts.BlockHash != nil {
@@ -854,10 +892,10 @@ func (s *baseServiceImpl) GetLogs(chainName chains.Chain, queryParamOpts ...GetL
 	parsedURL.RawQuery = params.Encode()
 
 	// Create an HTTP client
-	client := &http.Client{}
+	client := s.Settings.HTTPClient
 
 	// Create a GET request
-	req, err := http.NewRequest("GET", parsedURL.String(), nil)
+	req, err := http.NewRequestWithContext(s.Settings.Context, "GET", parsedURL.String(), nil)
 
 	if err != nil {
 		errorCode := 500
@@ -865,7 +903,14 @@ func (s *baseServiceImpl) GetLogs(chainName chains.Chain, queryParamOpts ...GetL
 		return &utils.Response[GetLogsResponse]{Data: nil, Error: true, ErrorCode: &errorCode, ErrorMessage: &errorMessage}, err
 	}
 
-	req.Header.Set("Authorization", `Bearer `+s.APIKey)
+	apiKey, err := s.Settings.APIKey()
+	if err != nil {
+		errorCode := 401
+		errorMessage := err.Error()
+		return &utils.Response[GetLogsResponse]{Data: nil, Error: true, ErrorCode: &errorCode, ErrorMessage: &errorMessage}, err
+	}
+
+	req.Header.Set("Authorization", `Bearer `+apiKey)
 	req.Header.Set("X-Requested-With", utils.UserAgent)
 
 	var startTime time.Time // Declares startTime, initially set to zero value of time.Time
@@ -875,6 +920,7 @@ func (s *baseServiceImpl) GetLogs(chainName chains.Chain, queryParamOpts ...GetL
 	}
 
 	// Perform the request
+	requestStart := time.Now()
 	resp, err := client.Do(req)
 	if err != nil {
 		// create a &Response that returns data: nil, error: true, errorCode: "Unknown Error Code", errorMessage: "Unknown Error"
@@ -883,41 +929,44 @@ func (s *baseServiceImpl) GetLogs(chainName chains.Chain, queryParamOpts ...GetL
 
 	defer resp.Body.Close()
 
+	s.Settings.Report(apiKey, resp.StatusCode)
+	meta := s.Settings.NewResponseMeta(resp, requestStart)
 	utils.DebugOutput(resp.Request.URL.String(), resp.StatusCode, startTime)
-	backoff := utils.NewExponentialBackoff(s.APIKey, s.Debug, 0, utils.UserAgent)
+	backoff := utils.NewExponentialBackoffWithSettings(s.Settings, s.Debug, 0, utils.UserAgent)
 
 	// // Read the response body
 	var data utils.Response[GetLogsResponse]
 
-	if resp.StatusCode == 429 {
+	if resp.StatusCode == 429 && backoff.Enabled() {
 		res, err := backoff.BackOff(resp.Request.URL.String())
 		if err != nil {
 			errorCode := resp.StatusCode
 			errorMessage := err.Error()
-			return &utils.Response[GetLogsResponse]{Data: nil, Error: true, ErrorCode: &errorCode, ErrorMessage: &errorMessage}, err
+			return &utils.Response[GetLogsResponse]{Data: nil, Error: true, ErrorCode: &errorCode, ErrorMessage: &errorMessage, Meta: meta.Finish()}, err
 		}
+		meta.Retried(res, 1+backoff.RetryCount)
 
-		if err := json.NewDecoder(res.Body).Decode(&data); err != nil {
+		if err := s.Settings.Decode(res.Body, &data, "GetLogs"); err != nil {
 			res.Body.Close()
 			errorCode := 500
 			errorMessage := err.Error()
-			return &utils.Response[GetLogsResponse]{Data: nil, Error: true, ErrorCode: &errorCode, ErrorMessage: &errorMessage}, err
+			return &utils.Response[GetLogsResponse]{Data: nil, Error: true, ErrorCode: &errorCode, ErrorMessage: &errorMessage, Meta: meta.Finish()}, err
 		}
 		res.Body.Close()
 	} else {
-		err = json.NewDecoder(resp.Body).Decode(&data)
+		err = s.Settings.Decode(resp.Body, &data, "GetLogs")
 		if err != nil {
 			errorCode := 500
 			errorMessage := err.Error()
-			return &utils.Response[GetLogsResponse]{Data: nil, Error: true, ErrorCode: &errorCode, ErrorMessage: &errorMessage}, err
+			return &utils.Response[GetLogsResponse]{Data: nil, Error: true, ErrorCode: &errorCode, ErrorMessage: &errorMessage, Meta: meta.Finish()}, err
 		}
 	}
 
 	if data.Error {
-		return &utils.Response[GetLogsResponse]{Data: data.Data, Error: data.Error, ErrorCode: data.ErrorCode, ErrorMessage: data.ErrorMessage}, fmt.Errorf(*data.ErrorMessage)
+		return &utils.Response[GetLogsResponse]{Data: data.Data, Error: data.Error, ErrorCode: data.ErrorCode, ErrorMessage: data.ErrorMessage, Meta: meta.Finish()}, fmt.Errorf(*data.ErrorMessage)
 	}
 
-	return &utils.Response[GetLogsResponse]{Data: data.Data, Error: data.Error, ErrorCode: data.ErrorCode, ErrorMessage: data.ErrorMessage}, nil
+	return &utils.Response[GetLogsResponse]{Data: data.Data, Error: data.Error, ErrorCode: data.ErrorCode, ErrorMessage: data.ErrorMessage, Meta: meta.Finish()}, nil
 }
 
 func (s *baseServiceImpl) GetLogEventsByAddress(chainName chains.Chain, contractAddress string, queryParamOpts ...GetLogEventsByAddressQueryParamOpts) <-chan LogEventResult {
@@ -933,7 +982,7 @@ func (s *baseServiceImpl) GetLogEventsByAddress(chainName chains.Chain, contract
 			return
 		}
 
-		apiURL := fmt.Sprintf("https://api.covalenthq.com/v1/%v/events/address/%s/", chainName, contractAddress)
+		apiURL := fmt.Sprintf("%s/v1/%v/events/address/%s/", s.Settings.BaseURL, chainName, contractAddress)
 
 		// Parse the formatted URL
 		parsedURL, err := url.Parse(apiURL)
@@ -981,13 +1030,15 @@ func (s *baseServiceImpl) GetLogEventsByAddress(chainName chains.Chain, contract
 		var data utils.Response[LogEventsByAddressResponse]
 		for hasNext {
 
-			res, err := utils.PaginateEndpoint(apiURL, s.APIKey, params, page, s.Debug, s.ThreadCount, utils.UserAgent)
+			res, err := utils.PaginateEndpointWithSettings(s.Settings, apiURL, params, page, s.Debug, s.ThreadCount, utils.UserAgent)
 			if err != nil {
 				logEventChannel <- LogEventResult{Err: err}
 				hasNext = false
 				return
 			}
-			if err := json.NewDecoder(res.Body).Decode(&data); err != nil {
+			if err := utils.DecodeItems(s.Settings, res.Body, &data, "GetLogEventsByAddress", func(item genericmodels.LogEvent) {
+				logEventChannel <- LogEventResult{LogEvent: item}
+			}); err != nil {
 				logEventChannel <- LogEventResult{Err: err}
 				res.Body.Close()
 				hasNext = false
@@ -1006,10 +1057,6 @@ func (s *baseServiceImpl) GetLogEventsByAddress(chainName chains.Chain, contract
 				return
 			}
 
-			for _, item := range data.Data.Items {
-				logEventChannel <- LogEventResult{LogEvent: item, Err: err}
-			}
-
 			hasNext = *data.Data.Pagination.HasMore
 			page++
 		}
@@ -1019,7 +1066,7 @@ func (s *baseServiceImpl) GetLogEventsByAddress(chainName chains.Chain, contract
 }
 
 func (s *baseServiceImpl) GetLogEventsByAddressByPage(chainName chains.Chain, contractAddress string, queryParamOpts ...GetLogEventsByAddressQueryParamOpts) (*utils.Response[LogEventsByAddressResponse], error) {
-	apiURL := fmt.Sprintf("https://api.covalenthq.com/v1/%v/events/address/%s/", chainName, contractAddress)
+	apiURL := fmt.Sprintf("%s/v1/%v/events/address/%s/", s.Settings.BaseURL, chainName, contractAddress)
 
 	if !s.IskeyValid {
 		errorCode := 401
@@ -1058,10 +1105,10 @@ func (s *baseServiceImpl) GetLogEventsByAddressByPage(chainName chains.Chain, co
 	parsedURL.RawQuery = params.Encode()
 
 	// Create an HTTP client
-	client := &http.Client{}
+	client := s.Settings.HTTPClient
 
 	// Create a GET request
-	req, err := http.NewRequest("GET", parsedURL.String(), nil)
+	req, err := http.NewRequestWithContext(s.Settings.Context, "GET", parsedURL.String(), nil)
 
 	if err != nil {
 		errorCode := 500
@@ -1069,7 +1116,14 @@ func (s *baseServiceImpl) GetLogEventsByAddressByPage(chainName chains.Chain, co
 		return &utils.Response[LogEventsByAddressResponse]{Data: nil, Error: true, ErrorCode: &errorCode, ErrorMessage: &errorMessage}, err
 	}
 
-	req.Header.Set("Authorization", `Bearer `+s.APIKey)
+	apiKey, err := s.Settings.APIKey()
+	if err != nil {
+		errorCode := 401
+		errorMessage := err.Error()
+		return &utils.Response[LogEventsByAddressResponse]{Data: nil, Error: true, ErrorCode: &errorCode, ErrorMessage: &errorMessage}, err
+	}
+
+	req.Header.Set("Authorization", `Bearer `+apiKey)
 	req.Header.Set("X-Requested-With", utils.UserAgent)
 
 	var startTime time.Time // Declares startTime, initially set to zero value of time.Time
@@ -1079,6 +1133,7 @@ func (s *baseServiceImpl) GetLogEventsByAddressByPage(chainName chains.Chain, co
 	}
 
 	// Perform the request
+	requestStart := time.Now()
 	resp, err := client.Do(req)
 	if err != nil {
 		// create a &Response that returns data: nil, error: true, errorCode: "Unknown Error Code", errorMessage: "Unknown Error"
@@ -1087,41 +1142,44 @@ func (s *baseServiceImpl) GetLogEventsByAddressByPage(chainName chains.Chain, co
 
 	defer resp.Body.Close()
 
+	s.Settings.Report(apiKey, resp.StatusCode)
+	meta := s.Settings.NewResponseMeta(resp, requestStart)
 	utils.DebugOutput(resp.Request.URL.String(), resp.StatusCode, startTime)
-	backoff := utils.NewExponentialBackoff(s.APIKey, s.Debug, 0, utils.UserAgent)
+	backoff := utils.NewExponentialBackoffWithSettings(s.Settings, s.Debug, 0, utils.UserAgent)
 
 	// // Read the response body
 	var data utils.Response[LogEventsByAddressResponse]
 
-	if resp.StatusCode == 429 {
+	if resp.StatusCode == 429 && backoff.Enabled() {
 		res, err := backoff.BackOff(resp.Request.URL.String())
 		if err != nil {
 			errorCode := resp.StatusCode
 			errorMessage := err.Error()
-			return &utils.Response[LogEventsByAddressResponse]{Data: nil, Error: true, ErrorCode: &errorCode, ErrorMessage: &errorMessage}, err
+			return &utils.Response[LogEventsByAddressResponse]{Data: nil, Error: true, ErrorCode: &errorCode, ErrorMessage: &errorMessage, Meta: meta.Finish()}, err
 		}
+		meta.Retried(res, 1+backoff.RetryCount)
 
-		if err := json.NewDecoder(res.Body).Decode(&data); err != nil {
+		if err := s.Settings.Decode(res.Body, &data, "GetLogEventsByAddressByPage"); err != nil {
 			res.Body.Close()
 			errorCode := 500
 			errorMessage := err.Error()
-			return &utils.Response[LogEventsByAddressResponse]{Data: nil, Error: true, ErrorCode: &errorCode, ErrorMessage: &errorMessage}, err
+			return &utils.Response[LogEventsByAddressResponse]{Data: nil, Error: true, ErrorCode: &errorCode, ErrorMessage: &errorMessage, Meta: meta.Finish()}, err
 		}
 		res.Body.Close()
 	} else {
-		err = json.NewDecoder(resp.Body).Decode(&data)
+		err = s.Settings.Decode(resp.Body, &data, "GetLogEventsByAddressByPage")
 		if err != nil {
 			errorCode := 500
 			errorMessage := err.Error()
-			return &utils.Response[LogEventsByAddressResponse]{Data: nil, Error: true, ErrorCode: &errorCode, ErrorMessage: &errorMessage}, err
+			return &utils.Response[LogEventsByAddressResponse]{Data: nil, Error: true, ErrorCode: &errorCode, ErrorMessage: &errorMessage, Meta: meta.Finish()}, err
 		}
 	}
 
 	if data.Error {
-		return &utils.Response[LogEventsByAddressResponse]{Data: data.Data, Error: data.Error, ErrorCode: data.ErrorCode, ErrorMessage: data.ErrorMessage}, fmt.Errorf(*data.ErrorMessage)
+		return &utils.Response[LogEventsByAddressResponse]{Data: data.Data, Error: data.Error, ErrorCode: data.ErrorCode, ErrorMessage: data.ErrorMessage, Meta: meta.Finish()}, fmt.Errorf(*data.ErrorMessage)
 	}
 
-	return &utils.Response[LogEventsByAddressResponse]{Data: data.Data, Error: data.Error, ErrorCode: data.ErrorCode, ErrorMessage: data.ErrorMessage}, nil
+	return &utils.Response[LogEventsByAddressResponse]{Data: data.Data, Error: data.Error, ErrorCode: data.ErrorCode, ErrorMessage: data.ErrorMessage, Meta: meta.Finish()}, nil
 }
 
 func (s *baseServiceImpl) GetLogEventsByTopicHash(chainName chains.Chain, topicHash string, queryParamOpts ...GetLogEventsByTopicHashQueryParamOpts) <-chan LogEventResult {
@@ -1137,7 +1195,13 @@ func (s *baseServiceImpl) GetLogEventsByTopicHash(chainName chains.Chain, topicH
 			return
 		}
 
-		apiURL := fmt.Sprintf("https://api.covalenthq.com/v1/%v/events/topics/%s/", chainName, topicHash)
+		topicHash, err := abi.ResolveTopic(topicHash)
+		if err != nil {
+			logEventChannel <- LogEventResult{Err: err}
+			return
+		}
+
+		apiURL := fmt.Sprintf("%s/v1/%v/events/topics/%s/", s.Settings.BaseURL, chainName, topicHash)
 
 		// Parse the formatted URL
 		parsedURL, err := url.Parse(apiURL)
@@ -1189,13 +1253,15 @@ func (s *baseServiceImpl) GetLogEventsByTopicHash(chainName chains.Chain, topicH
 		var data utils.Response[LogEventsByTopicHashResponse]
 		for hasNext {
 
-			res, err := utils.PaginateEndpoint(apiURL, s.APIKey, params, page, s.Debug, s.ThreadCount, utils.UserAgent)
+			res, err := utils.PaginateEndpointWithSettings(s.Settings, apiURL, params, page, s.Debug, s.ThreadCount, utils.UserAgent)
 			if err != nil {
 				logEventChannel <- LogEventResult{Err: err}
 				hasNext = false
 				return
 			}
-			if err := json.NewDecoder(res.Body).Decode(&data); err != nil {
+			if err := utils.DecodeItems(s.Settings, res.Body, &data, "GetLogEventsByTopicHash", func(item genericmodels.LogEvent) {
+				logEventChannel <- LogEventResult{LogEvent: item}
+			}); err != nil {
 				logEventChannel <- LogEventResult{Err: err}
 				res.Body.Close()
 				hasNext = false
@@ -1214,10 +1280,6 @@ func (s *baseServiceImpl) GetLogEventsByTopicHash(chainName chains.Chain, topicH
 				return
 			}
 
-			for _, item := range data.Data.Items {
-				logEventChannel <- LogEventResult{LogEvent: item, Err: err}
-			}
-
 			hasNext = *data.Data.Pagination.HasMore
 			page++
 		}
@@ -1227,14 +1289,20 @@ func (s *baseServiceImpl) GetLogEventsByTopicHash(chainName chains.Chain, topicH
 }
 
 func (s *baseServiceImpl) GetLogEventsByTopicHashByPage(chainName chains.Chain, topicHash string, queryParamOpts ...GetLogEventsByTopicHashQueryParamOpts) (*utils.Response[LogEventsByTopicHashResponse], error) {
-	apiURL := fmt.Sprintf("https://api.covalenthq.com/v1/%v/events/topics/%s/", chainName, topicHash)
-
 	if !s.IskeyValid {
 		errorCode := 401
 		errorMessage := utils.InvalidAPIKeyMessage
 		return &utils.Response[LogEventsByTopicHashResponse]{Data: nil, Error: true, ErrorCode: &errorCode, ErrorMessage: &errorMessage}, fmt.Errorf(utils.InvalidAPIKeyMessage)
 	}
 
+	topicHash, err := abi.ResolveTopic(topicHash)
+	if err != nil {
+		errorCode := 400
+		errorMessage := err.Error()
+		return &utils.Response[LogEventsByTopicHashResponse]{Data: nil, Error: true, ErrorCode: &errorCode, ErrorMessage: &errorMessage}, err
+	}
+	apiURL := fmt.Sprintf("%s/v1/%v/events/topics/%s/", s.Settings.BaseURL, chainName, topicHash)
+
 	parsedURL, err := url.Parse(apiURL)
 	if err != nil {
 		return nil, err
@@ -1270,10 +1338,10 @@ func (s *baseServiceImpl) GetLogEventsByTopicHashByPage(chainName chains.Chain,
 	parsedURL.RawQuery = params.Encode()
 
 	// Create an HTTP client
-	client := &http.Client{}
+	client := s.Settings.HTTPClient
 
 	// Create a GET request
-	req, err := http.NewRequest("GET", parsedURL.String(), nil)
+	req, err := http.NewRequestWithContext(s.Settings.Context, "GET", parsedURL.String(), nil)
 
 	if err != nil {
 		errorCode := 500
@@ -1281,7 +1349,14 @@ func (s *baseServiceImpl) GetLogEventsByTopicHashByPage(chainName chains.Chain,
 		return &utils.Response[LogEventsByTopicHashResponse]{Data: nil, Error: true, ErrorCode: &errorCode, ErrorMessage: &errorMessage}, err
 	}
 
-	req.Header.Set("Authorization", `Bearer `+s.APIKey)
+	apiKey, err := s.Settings.APIKey()
+	if err != nil {
+		errorCode := 401
+		errorMessage := err.Error()
+		return &utils.Response[LogEventsByTopicHashResponse]{Data: nil, Error: true, ErrorCode: &errorCode, ErrorMessage: &errorMessage}, err
+	}
+
+	req.Header.Set("Authorization", `Bearer `+apiKey)
 	req.Header.Set("X-Requested-With", utils.UserAgent)
 
 	var startTime time.Time // Declares startTime, initially set to zero value of time.Time
@@ -1291,6 +1366,7 @@ func (s *baseServiceImpl) GetLogEventsByTopicHashByPage(chainName chains.Chain,
 	}
 
 	// Perform the request
+	requestStart := time.Now()
 	resp, err := client.Do(req)
 	if err != nil {
 		// create a &Response that returns data: nil, error: true, errorCode: "Unknown Error Code", errorMessage: "Unknown Error"
@@ -1299,46 +1375,49 @@ func (s *baseServiceImpl) GetLogEventsByTopicHashByPage(chainName chains.Chain,
 
 	defer resp.Body.Close()
 
+	s.Settings.Report(apiKey, resp.StatusCode)
+	meta := s.Settings.NewResponseMeta(resp, requestStart)
 	utils.DebugOutput(resp.Request.URL.String(), resp.StatusCode, startTime)
-	backoff := utils.NewExponentialBackoff(s.APIKey, s.Debug, 0, utils.UserAgent)
+	backoff := utils.NewExponentialBackoffWithSettings(s.Settings, s.Debug, 0, utils.UserAgent)
 
 	// // Read the response body
 	var data utils.Response[LogEventsByTopicHashResponse]
 
-	if resp.StatusCode == 429 {
+	if resp.StatusCode == 429 && backoff.Enabled() {
 		res, err := backoff.BackOff(resp.Request.URL.String())
 		if err != nil {
 			errorCode := resp.StatusCode
 			errorMessage := err.Error()
-			return &utils.Response[LogEventsByTopicHashResponse]{Data: nil, Error: true, ErrorCode: &errorCode, ErrorMessage: &errorMessage}, err
+			return &utils.Response[LogEventsByTopicHashResponse]{Data: nil, Error: true, ErrorCode: &errorCode, ErrorMessage: &errorMessage, Meta: meta.Finish()}, err
 		}
+		meta.Retried(res, 1+backoff.RetryCount)
 
-		if err := json.NewDecoder(res.Body).Decode(&data); err != nil {
+		if err := s.Settings.Decode(res.Body, &data, "GetLogEventsByTopicHashByPage"); err != nil {
 			res.Body.Close()
 			errorCode := 500
 			errorMessage := err.Error()
-			return &utils.Response[LogEventsByTopicHashResponse]{Data: nil, Error: true, ErrorCode: &errorCode, ErrorMessage: &errorMessage}, err
+			return &utils.Response[LogEventsByTopicHashResponse]{Data: nil, Error: true, ErrorCode: &errorCode, ErrorMessage: &errorMessage, Meta: meta.Finish()}, err
 		}
 		res.Body.Close()
 	} else {
-		err = json.NewDecoder(resp.Body).Decode(&data)
+		err = s.Settings.Decode(resp.Body, &data, "GetLogEventsByTopicHashByPage")
 		if err != nil {
 			errorCode := 500
 			errorMessage := err.Error()
-			return &utils.Response[LogEventsByTopicHashResponse]{Data: nil, Error: true, ErrorCode: &errorCode, ErrorMessage: &errorMessage}, err
+			return &utils.Response[LogEventsByTopicHashResponse]{Data: nil, Error: true, ErrorCode: &errorCode, ErrorMessage: &errorMessage, Meta: meta.Finish()}, err
 		}
 	}
 
 	if data.Error {
-		return &utils.Response[LogEventsByTopicHashResponse]{Data: data.Data, Error: data.Error, ErrorCode: data.ErrorCode, ErrorMessage: data.ErrorMessage}, fmt.Errorf(*data.ErrorMessage)
+		return &utils.Response[LogEventsByTopicHashResponse]{Data: data.Data, Error: data.Error, ErrorCode: data.ErrorCode, ErrorMessage: data.ErrorMessage, Meta: meta.Finish()}, fmt.Errorf(*data.ErrorMessage)
 	}
 
-	return &utils.Response[LogEventsByTopicHashResponse]{Data: data.Data, Error: data.Error, ErrorCode: data.ErrorCode, ErrorMessage: data.ErrorMessage}, nil
+	return &utils.Response[LogEventsByTopicHashResponse]{Data: data.Data, Error: data.Error, ErrorCode: data.ErrorCode, ErrorMessage: data.ErrorMessage, Meta: meta.Finish()}, nil
 }
 
 func (s *baseServiceImpl) GetAllChains() (*utils.Response[AllChainsResponse], error) {
 
-	apiURL := fmt.Sprintf("https://api.covalenthq.com/v1/chains/")
+	apiURL := fmt.Sprintf("%s/v1/chains/", s.Settings.BaseURL)
 
 	if !s.IskeyValid {
 		errorCode := 401
@@ -1357,10 +1436,10 @@ func (s *baseServiceImpl) GetAllChains() (*utils.Response[AllChainsResponse], er
 	parsedURL.RawQuery = params.Encode()
 
 	// Create an HTTP client
-	client := &http.Client{}
+	client := s.Settings.HTTPClient
 
 	// Create a GET request
-	req, err := http.NewRequest("GET", parsedURL.String(), nil)
+	req, err := http.NewRequestWithContext(s.Settings.Context, "GET", parsedURL.String(), nil)
 
 	if err != nil {
 		errorCode := 500
@@ -1368,7 +1447,14 @@ func (s *baseServiceImpl) GetAllChains() (*utils.Response[AllChainsResponse], er
 		return &utils.Response[AllChainsResponse]{Data: nil, Error: true, ErrorCode: &errorCode, ErrorMessage: &errorMessage}, err
 	}
 
-	req.Header.Set("Authorization", `Bearer `+s.APIKey)
+	apiKey, err := s.Settings.APIKey()
+	if err != nil {
+		errorCode := 401
+		errorMessage := err.Error()
+		return &utils.Response[AllChainsResponse]{Data: nil, Error: true, ErrorCode: &errorCode, ErrorMessage: &errorMessage}, err
+	}
+
+	req.Header.Set("Authorization", `Bearer `+apiKey)
 	req.Header.Set("X-Requested-With", utils.UserAgent)
 
 	var startTime time.Time // Declares startTime, initially set to zero value of time.Time
@@ -1378,6 +1464,7 @@ func (s *baseServiceImpl) GetAllChains() (*utils.Response[AllChainsResponse], er
 	}
 
 	// Perform the request
+	requestStart := time.Now()
 	resp, err := client.Do(req)
 	if err != nil {
 		// create a &Response that returns data: nil, error: true, errorCode: "Unknown Error Code", errorMessage: "Unknown Error"
@@ -1386,46 +1473,49 @@ func (s *baseServiceImpl) GetAllChains() (*utils.Response[AllChainsResponse], er
 
 	defer resp.Body.Close()
 
+	s.Settings.Report(apiKey, resp.StatusCode)
+	meta := s.Settings.NewResponseMeta(resp, requestStart)
 	utils.DebugOutput(resp.Request.URL.String(), resp.StatusCode, startTime)
-	backoff := utils.NewExponentialBackoff(s.APIKey, s.Debug, 0, utils.UserAgent)
+	backoff := utils.NewExponentialBackoffWithSettings(s.Settings, s.Debug, 0, utils.UserAgent)
 
 	// // Read the response body
 	var data utils.Response[AllChainsResponse]
 
-	if resp.StatusCode == 429 {
+	if resp.StatusCode == 429 && backoff.Enabled() {
 		res, err := backoff.BackOff(resp.Request.URL.String())
 		if err != nil {
 			errorCode := resp.StatusCode
 			errorMessage := err.Error()
-			return &utils.Response[AllChainsResponse]{Data: nil, Error: true, ErrorCode: &errorCode, ErrorMessage: &errorMessage}, err
+			return &utils.Response[AllChainsResponse]{Data: nil, Error: true, ErrorCode: &errorCode, ErrorMessage: &errorMessage, Meta: meta.Finish()}, err
 		}
+		meta.Retried(res, 1+backoff.RetryCount)
 
-		if err := json.NewDecoder(res.Body).Decode(&data); err != nil {
+		if err := s.Settings.Decode(res.Body, &data, "GetAllChains"); err != nil {
 			res.Body.Close()
 			errorCode := 500
 			errorMessage := err.Error()
-			return &utils.Response[AllChainsResponse]{Data: nil, Error: true, ErrorCode: &errorCode, ErrorMessage: &errorMessage}, err
+			return &utils.Response[AllChainsResponse]{Data: nil, Error: true, ErrorCode: &errorCode, ErrorMessage: &errorMessage, Meta: meta.Finish()}, err
 		}
 		res.Body.Close()
 	} else {
-		err = json.NewDecoder(resp.Body).Decode(&data)
+		err = s.Settings.Decode(resp.Body, &data, "GetAllChains")
 		if err != nil {
 			errorCode := 500
 			errorMessage := err.Error()
-			return &utils.Response[AllChainsResponse]{Data: nil, Error: true, ErrorCode: &errorCode, ErrorMessage: &errorMessage}, err
+			return &utils.Response[AllChainsResponse]{Data: nil, Error: true, ErrorCode: &errorCode, ErrorMessage: &errorMessage, Meta: meta.Finish()}, err
 		}
 	}
 
 	if data.Error {
-		return &utils.Response[AllChainsResponse]{Data: data.Data, Error: data.Error, ErrorCode: data.ErrorCode, ErrorMessage: data.ErrorMessage}, fmt.Errorf(*data.ErrorMessage)
+		return &utils.Response[AllChainsResponse]{Data: data.Data, Error: data.Error, ErrorCode: data.ErrorCode, ErrorMessage: data.ErrorMessage, Meta: meta.Finish()}, fmt.Errorf(*data.ErrorMessage)
 	}
 
-	return &utils.Response[AllChainsResponse]{Data: data.Data, Error: data.Error, ErrorCode: data.ErrorCode, ErrorMessage: data.ErrorMessage}, nil
+	return &utils.Response[AllChainsResponse]{Data: data.Data, Error: data.Error, ErrorCode: data.ErrorCode, ErrorMessage: data.ErrorMessage, Meta: meta.Finish()}, nil
 }
 
 func (s *baseServiceImpl) GetAllChainStatus() (*utils.Response[AllChainsStatusResponse], error) {
 
-	apiURL := fmt.Sprintf("https://api.covalenthq.com/v1/chains/status/")
+	apiURL := fmt.Sprintf("%s/v1/chains/status/", s.Settings.BaseURL)
 
 	if !s.IskeyValid {
 		errorCode := 401
@@ -1444,10 +1534,10 @@ func (s *baseServiceImpl) GetAllChainStatus() (*utils.Response[AllChainsStatusRe
 	parsedURL.RawQuery = params.Encode()
 
 	// Create an HTTP client
-	client := &http.Client{}
+	client := s.Settings.HTTPClient
 
 	// Create a GET request
-	req, err := http.NewRequest("GET", parsedURL.String(), nil)
+	req, err := http.NewRequestWithContext(s.Settings.Context, "GET", parsedURL.String(), nil)
 
 	if err != nil {
 		errorCode := 500
@@ -1455,7 +1545,14 @@ func (s *baseServiceImpl) GetAllChainStatus() (*utils.Response[AllChainsStatusRe
 		return &utils.Response[AllChainsStatusResponse]{Data: nil, Error: true, ErrorCode: &errorCode, ErrorMessage: &errorMessage}, err
 	}
 
-	req.Header.Set("Authorization", `Bearer `+s.APIKey)
+	apiKey, err := s.Settings.APIKey()
+	if err != nil {
+		errorCode := 401
+		errorMessage := err.Error()
+		return &utils.Response[AllChainsStatusResponse]{Data: nil, Error: true, ErrorCode: &errorCode, ErrorMessage: &errorMessage}, err
+	}
+
+	req.Header.Set("Authorization", `Bearer `+apiKey)
 	req.Header.Set("X-Requested-With", utils.UserAgent)
 
 	var startTime time.Time // Declares startTime, initially set to zero value of time.Time
@@ -1465,6 +1562,7 @@ func (s *baseServiceImpl) GetAllChainStatus() (*utils.Response[AllChainsStatusRe
 	}
 
 	// Perform the request
+	requestStart := time.Now()
 	resp, err := client.Do(req)
 	if err != nil {
 		// create a &Response that returns data: nil, error: true, errorCode: "Unknown Error Code", errorMessage: "Unknown Error"
@@ -1473,46 +1571,49 @@ func (s *baseServiceImpl) GetAllChainStatus() (*utils.Response[AllChainsStatusRe
 
 	defer resp.Body.Close()
 
+	s.Settings.Report(apiKey, resp.StatusCode)
+	meta := s.Settings.NewResponseMeta(resp, requestStart)
 	utils.DebugOutput(resp.Request.URL.String(), resp.StatusCode, startTime)
-	backoff := utils.NewExponentialBackoff(s.APIKey, s.Debug, 0, utils.UserAgent)
+	backoff := utils.NewExponentialBackoffWithSettings(s.Settings, s.Debug, 0, utils.UserAgent)
 
 	// // Read the response body
 	var data utils.Response[AllChainsStatusResponse]
 
-	if resp.StatusCode == 429 {
+	if resp.StatusCode == 429 && backoff.Enabled() {
 		res, err := backoff.BackOff(resp.Request.URL.String())
 		if err != nil {
 			errorCode := resp.StatusCode
 			errorMessage := err.Error()
-			return &utils.Response[AllChainsStatusResponse]{Data: nil, Error: true, ErrorCode: &errorCode, ErrorMessage: &errorMessage}, err
+			return &utils.Response[AllChainsStatusResponse]{Data: nil, Error: true, ErrorCode: &errorCode, ErrorMessage: &errorMessage, Meta: meta.Finish()}, err
 		}
+		meta.Retried(res, 1+backoff.RetryCount)
 
-		if err := json.NewDecoder(res.Body).Decode(&data); err != nil {
+		if err := s.Settings.Decode(res.Body, &data, "GetAllChainStatus"); err != nil {
 			res.Body.Close()
 			errorCode := 500
 			errorMessage := err.Error()
-			return &utils.Response[AllChainsStatusResponse]{Data: nil, Error: true, ErrorCode: &errorCode, ErrorMessage: &errorMessage}, err
+			return &utils.Response[AllChainsStatusResponse]{Data: nil, Error: true, ErrorCode: &errorCode, ErrorMessage: &errorMessage, Meta: meta.Finish()}, err
 		}
 		res.Body.Close()
 	} else {
-		err = json.NewDecoder(resp.Body).Decode(&data)
+		err = s.Settings.Decode(resp.Body, &data, "GetAllChainStatus")
 		if err != nil {
 			errorCode := 500
 			errorMessage := err.Error()
-			return &utils.Response[AllChainsStatusResponse]{Data: nil, Error: true, ErrorCode: &errorCode, ErrorMessage: &errorMessage}, err
+			return &utils.Response[AllChainsStatusResponse]{Data: nil, Error: true, ErrorCode: &errorCode, ErrorMessage: &errorMessage, Meta: meta.Finish()}, err
 		}
 	}
 
 	if data.Error {
-		return &utils.Response[AllChainsStatusResponse]{Data: data.Data, Error: data.Error, ErrorCode: data.ErrorCode, ErrorMessage: data.ErrorMessage}, fmt.Errorf(*data.ErrorMessage)
+		return &utils.Response[AllChainsStatusResponse]{Data: data.Data, Error: data.Error, ErrorCode: data.ErrorCode, ErrorMessage: data.ErrorMessage, Meta: meta.Finish()}, fmt.Errorf(*data.ErrorMessage)
 	}
 
-	return &utils.Response[AllChainsStatusResponse]{Data: data.Data, Error: data.Error, ErrorCode: data.ErrorCode, ErrorMessage: data.ErrorMessage}, nil
+	return &utils.Response[AllChainsStatusResponse]{Data: data.Data, Error: data.Error, ErrorCode: data.ErrorCode, ErrorMessage: data.ErrorMessage, Meta: meta.Finish()}, nil
 }
 
 func (s *baseServiceImpl) GetAddressActivity(walletAddress string, queryParamOpts ...GetAddressActivityQueryParamOpts) (*utils.Response[ChainActivityResponse], error) {
 
-	apiURL := fmt.Sprintf("https://api.covalenthq.com/v1/address/%s/activity/", walletAddress)
+	apiURL := fmt.Sprintf("%s/v1/address/%s/activity/", s.Settings.BaseURL, walletAddress)
 
 	if !s.IskeyValid {
 		errorCode := 401
@@ -1539,10 +1640,10 @@ func (s *baseServiceImpl) GetAddressActivity(walletAddress string, queryParamOpt
 	parsedURL.RawQuery = params.Encode()
 
 	// Create an HTTP client
-	client := &http.Client{}
+	client := s.Settings.HTTPClient
 
 	// Create a GET request
-	req, err := http.NewRequest("GET", parsedURL.String(), nil)
+	req, err := http.NewRequestWithContext(s.Settings.Context, "GET", parsedURL.String(), nil)
 
 	if err != nil {
 		errorCode := 500
@@ -1550,7 +1651,14 @@ func (s *baseServiceImpl) GetAddressActivity(walletAddress string, queryParamOpt
 		return &utils.Response[ChainActivityResponse]{Data: nil, Error: true, ErrorCode: &errorCode, ErrorMessage: &errorMessage}, err
 	}
 
-	req.Header.Set("Authorization", `Bearer `+s.APIKey)
+	apiKey, err := s.Settings.APIKey()
+	if err != nil {
+		errorCode := 401
+		errorMessage := err.Error()
+		return &utils.Response[ChainActivityResponse]{Data: nil, Error: true, ErrorCode: &errorCode, ErrorMessage: &errorMessage}, err
+	}
+
+	req.Header.Set("Authorization", `Bearer `+apiKey)
 	req.Header.Set("X-Requested-With", utils.UserAgent)
 
 	var startTime time.Time // Declares startTime, initially set to zero value of time.Time
@@ -1560,6 +1668,7 @@ func (s *baseServiceImpl) GetAddressActivity(walletAddress string, queryParamOpt
 	}
 
 	// Perform the request
+	requestStart := time.Now()
 	resp, err := client.Do(req)
 	if err != nil {
 		// create a &Response that returns data: nil, error: true, errorCode: "Unknown Error Code", errorMessage: "Unknown Error"
@@ -1568,46 +1677,49 @@ func (s *baseServiceImpl) GetAddressActivity(walletAddress string, queryParamOpt
 
 	defer resp.Body.Close()
 
+	s.Settings.Report(apiKey, resp.StatusCode)
+	meta := s.Settings.NewResponseMeta(resp, requestStart)
 	utils.DebugOutput(resp.Request.URL.String(), resp.StatusCode, startTime)
-	backoff := utils.NewExponentialBackoff(s.APIKey, s.Debug, 0, utils.UserAgent)
+	backoff := utils.NewExponentialBackoffWithSettings(s.Settings, s.Debug, 0, utils.UserAgent)
 
 	// // Read the response body
 	var data utils.Response[ChainActivityResponse]
 
-	if resp.StatusCode == 429 {
+	if resp.StatusCode == 429 && backoff.Enabled() {
 		res, err := backoff.BackOff(resp.Request.URL.String())
 		if err != nil {
 			errorCode := resp.StatusCode
 			errorMessage := err.Error()
-			return &utils.Response[ChainActivityResponse]{Data: nil, Error: true, ErrorCode: &errorCode, ErrorMessage: &errorMessage}, err
+			return &utils.Response[ChainActivityResponse]{Data: nil, Error: true, ErrorCode: &errorCode, ErrorMessage: &errorMessage, Meta: meta.Finish()}, err
 		}
+		meta.Retried(res, 1+backoff.RetryCount)
 
-		if err := json.NewDecoder(res.Body).Decode(&data); err != nil {
+		if err := s.Settings.Decode(res.Body, &data, "GetAddressActivity"); err != nil {
 			res.Body.Close()
 			errorCode := 500
 			errorMessage := err.Error()
-			return &utils.Response[ChainActivityResponse]{Data: nil, Error: true, ErrorCode: &errorCode, ErrorMessage: &errorMessage}, err
+			return &utils.Response[ChainActivityResponse]{Data: nil, Error: true, ErrorCode: &errorCode, ErrorMessage: &errorMessage, Meta: meta.Finish()}, err
 		}
 		res.Body.Close()
 	} else {
-		err = json.NewDecoder(resp.Body).Decode(&data)
+		err = s.Settings.Decode(resp.Body, &data, "GetAddressActivity")
 		if err != nil {
 			errorCode := 500
 			errorMessage := err.Error()
-			return &utils.Response[ChainActivityResponse]{Data: nil, Error: true, ErrorCode: &errorCode, ErrorMessage: &errorMessage}, err
+			return &utils.Response[ChainActivityResponse]{Data: nil, Error: true, ErrorCode: &errorCode, ErrorMessage: &errorMessage, Meta: meta.Finish()}, err
 		}
 	}
 
 	if data.Error {
-		return &utils.Response[ChainActivityResponse]{Data: data.Data, Error: data.Error, ErrorCode: data.ErrorCode, ErrorMessage: data.ErrorMessage}, fmt.Errorf(*data.ErrorMessage)
+		return &utils.Response[ChainActivityResponse]{Data: data.Data, Error: data.Error, ErrorCode: data.ErrorCode, ErrorMessage: data.ErrorMessage, Meta: meta.Finish()}, fmt.Errorf(*data.ErrorMessage)
 	}
 
-	return &utils.Response[ChainActivityResponse]{Data: data.Data, Error: data.Error, ErrorCode: data.ErrorCode, ErrorMessage: data.ErrorMessage}, nil
+	return &utils.Response[ChainActivityResponse]{Data: data.Data, Error: data.Error, ErrorCode: data.ErrorCode, ErrorMessage: data.ErrorMessage, Meta: meta.Finish()}, nil
 }
 
 func (s *baseServiceImpl) GetGasPrices(chainName chains.Chain, eventType string, queryParamOpts ...GetGasPricesQueryParamOpts) (*utils.Response[GasPricesResponse], error) {
 
-	apiURL := fmt.Sprintf("https://api.covalenthq.com/v1/%v/event/%s/gas_prices/", chainName, eventType)
+	apiURL := fmt.Sprintf("%s/v1/%v/event/%s/gas_prices/", s.Settings.BaseURL, chainName, eventType)
 
 	if !s.IskeyValid {
 		errorCode := 401
@@ -1634,10 +1746,10 @@ func (s *baseServiceImpl) GetGasPrices(chainName chains.Chain, eventType string,
 	parsedURL.RawQuery = params.Encode()
 
 	// Create an HTTP client
-	client := &http.Client{}
+	client := s.Settings.HTTPClient
 
 	// Create a GET request
-	req, err := http.NewRequest("GET", parsedURL.String(), nil)
+	req, err := http.NewRequestWithContext(s.Settings.Context, "GET", parsedURL.String(), nil)
 
 	if err != nil {
 		errorCode := 500
@@ -1645,7 +1757,14 @@ func (s *baseServiceImpl) GetGasPrices(chainName chains.Chain, eventType string,
 		return &utils.Response[GasPricesResponse]{Data: nil, Error: true, ErrorCode: &errorCode, ErrorMessage: &errorMessage}, err
 	}
 
-	req.Header.Set("Authorization", `Bearer `+s.APIKey)
+	apiKey, err := s.Settings.APIKey()
+	if err != nil {
+		errorCode := 401
+		errorMessage := err.Error()
+		return &utils.Response[GasPricesResponse]{Data: nil, Error: true, ErrorCode: &errorCode, ErrorMessage: &errorMessage}, err
+	}
+
+	req.Header.Set("Authorization", `Bearer `+apiKey)
 	req.Header.Set("X-Requested-With", utils.UserAgent)
 
 	var startTime time.Time // Declares startTime, initially set to zero value of time.Time
@@ -1655,6 +1774,7 @@ func (s *baseServiceImpl) GetGasPrices(chainName chains.Chain, eventType string,
 	}
 
 	// Perform the request
+	requestStart := time.Now()
 	resp, err := client.Do(req)
 	if err != nil {
 		// create a &Response that returns data: nil, error: true, errorCode: "Unknown Error Code", errorMessage: "Unknown Error"
@@ -1663,39 +1783,42 @@ func (s *baseServiceImpl) GetGasPrices(chainName chains.Chain, eventType string,
 
 	defer resp.Body.Close()
 
+	s.Settings.Report(apiKey, resp.StatusCode)
+	meta := s.Settings.NewResponseMeta(resp, requestStart)
 	utils.DebugOutput(resp.Request.URL.String(), resp.StatusCode, startTime)
-	backoff := utils.NewExponentialBackoff(s.APIKey, s.Debug, 0, utils.UserAgent)
+	backoff := utils.NewExponentialBackoffWithSettings(s.Settings, s.Debug, 0, utils.UserAgent)
 
 	// // Read the response body
 	var data utils.Response[GasPricesResponse]
 
-	if resp.StatusCode == 429 {
+	if resp.StatusCode == 429 && backoff.Enabled() {
 		res, err := backoff.BackOff(resp.Request.URL.String())
 		if err != nil {
 			errorCode := resp.StatusCode
 			errorMessage := err.Error()
-			return &utils.Response[GasPricesResponse]{Data: nil, Error: true, ErrorCode: &errorCode, ErrorMessage: &errorMessage}, err
+			return &utils.Response[GasPricesResponse]{Data: nil, Error: true, ErrorCode: &errorCode, ErrorMessage: &errorMessage, Meta: meta.Finish()}, err
 		}
+		meta.Retried(res, 1+backoff.RetryCount)
 
-		if err := json.NewDecoder(res.Body).Decode(&data); err != nil {
+		if err := s.Settings.Decode(res.Body, &data, "GetGasPrices"); err != nil {
 			res.Body.Close()
 			errorCode := 500
 			errorMessage := err.Error()
-			return &utils.Response[GasPricesResponse]{Data: nil, Error: true, ErrorCode: &errorCode, ErrorMessage: &errorMessage}, err
+			return &utils.Response[GasPricesResponse]{Data: nil, Error: true, ErrorCode: &errorCode, ErrorMessage: &errorMessage, Meta: meta.Finish()}, err
 		}
 		res.Body.Close()
 	} else {
-		err = json.NewDecoder(resp.Body).Decode(&data)
+		err = s.Settings.Decode(resp.Body, &data, "GetGasPrices")
 		if err != nil {
 			errorCode := 500
 			errorMessage := err.Error()
-			return &utils.Response[GasPricesResponse]{Data: nil, Error: true, ErrorCode: &errorCode, ErrorMessage: &errorMessage}, err
+			return &utils.Response[GasPricesResponse]{Data: nil, Error: true, ErrorCode: &errorCode, ErrorMessage: &errorMessage, Meta: meta.Finish()}, err
 		}
 	}
 
 	if data.Error {
-		return &utils.Response[GasPricesResponse]{Data: data.Data, Error: data.Error, ErrorCode: data.ErrorCode, ErrorMessage: data.ErrorMessage}, fmt.Errorf(*data.ErrorMessage)
+		return &utils.Response[GasPricesResponse]{Data: data.Data, Error: data.Error, ErrorCode: data.ErrorCode, ErrorMessage: data.ErrorMessage, Meta: meta.Finish()}, fmt.Errorf(*data.ErrorMessage)
 	}
 
-	return &utils.Response[GasPricesResponse]{Data: data.Data, Error: data.Error, ErrorCode: data.ErrorCode, ErrorMessage: data.ErrorMessage}, nil
+	return &utils.Response[GasPricesResponse]{Data: data.Data, Error: data.Error, ErrorCode: data.ErrorCode, ErrorMessage: data.ErrorMessage, Meta: meta.Finish()}, nil
 }
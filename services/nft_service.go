@@ -1,7 +1,6 @@
 package services
 
 import (
-	"encoding/json"
 	"errors"
 	"fmt"
 	"net/http"
@@ -421,9 +420,9 @@ type GetNftMarketFloorPriceQueryParamOpts struct {
 	QuoteCurrency *quotes.Quote `json:"quoteCurrency,omitempty"`
 }
 
-func NewNftServiceImpl(apiKey string, debug bool, threadCount int, isValidKey bool) NftService {
+func NewNftServiceImpl(apiKey string, debug bool, threadCount int, isValidKey bool, settings ...utils.RequestSettings) NftService {
 
-	return &nftServiceImpl{APIKey: apiKey, Debug: debug, ThreadCount: threadCount, IskeyValid: isValidKey}
+	return &nftServiceImpl{APIKey: apiKey, Debug: debug, ThreadCount: threadCount, IskeyValid: isValidKey, Settings: utils.NewRequestSettings(apiKey, settings...)}
 }
 
 type NftService interface {
@@ -528,6 +527,7 @@ type nftServiceImpl struct {
 	Debug       bool
 	ThreadCount int
 	IskeyValid  bool
+	Settings    utils.RequestSettings
 }
 
 func (s *nftServiceImpl) GetChainCollections(chainName chains.Chain, queryParamOpts ...GetChainCollectionsQueryParamOpts) <-chan ChainCollectionItemResult {
@@ -543,7 +543,7 @@ func (s *nftServiceImpl) GetChainCollections(chainName chains.Chain, queryParamO
 			return
 		}
 
-		apiURL := fmt.Sprintf("https://api.covalenthq.com/v1/%v/nft/collections/", chainName)
+		apiURL := fmt.Sprintf("%s/v1/%v/nft/collections/", s.Settings.BaseURL, chainName)
 
 		// Parse the formatted URL
 		parsedURL, err := url.Parse(apiURL)
@@ -587,13 +587,15 @@ func (s *nftServiceImpl) GetChainCollections(chainName chains.Chain, queryParamO
 		var data utils.Response[ChainCollectionResponse]
 		for hasNext {
 
-			res, err := utils.PaginateEndpoint(apiURL, s.APIKey, params, page, s.Debug, s.ThreadCount, utils.UserAgent)
+			res, err := utils.PaginateEndpointWithSettings(s.Settings, apiURL, params, page, s.Debug, s.ThreadCount, utils.UserAgent)
 			if err != nil {
 				chainCollectionItemChannel <- ChainCollectionItemResult{Err: err}
 				hasNext = false
 				return
 			}
-			if err := json.NewDecoder(res.Body).Decode(&data); err != nil {
+			if err := utils.DecodeItems(s.Settings, res.Body, &data, "GetChainCollections", func(item ChainCollectionItem) {
+				chainCollectionItemChannel <- ChainCollectionItemResult{ChainCollectionItem: item}
+			}); err != nil {
 				chainCollectionItemChannel <- ChainCollectionItemResult{Err: err}
 				res.Body.Close()
 				hasNext = false
@@ -612,10 +614,6 @@ func (s *nftServiceImpl) GetChainCollections(chainName chains.Chain, queryParamO
 				return
 			}
 
-			for _, item := range data.Data.Items {
-				chainCollectionItemChannel <- ChainCollectionItemResult{ChainCollectionItem: item, Err: err}
-			}
-
 			hasNext = *data.Data.Pagination.HasMore
 			page++
 		}
@@ -625,7 +623,7 @@ func (s *nftServiceImpl) GetChainCollections(chainName chains.Chain, queryParamO
 }
 
 func (s *nftServiceImpl) GetChainCollectionsByPage(chainName chains.Chain, queryParamOpts ...GetChainCollectionsQueryParamOpts) (*utils.Response[ChainCollectionResponse], error) {
-	apiURL := fmt.Sprintf("https://api.covalenthq.com/v1/%v/nft/collections/", chainName)
+	apiURL := fmt.Sprintf("%s/v1/%v/nft/collections/", s.Settings.BaseURL, chainName)
 
 	if !s.IskeyValid {
 		errorCode := 401
@@ -660,10 +658,10 @@ func (s *nftServiceImpl) GetChainCollectionsByPage(chainName chains.Chain, query
 	parsedURL.RawQuery = params.Encode()
 
 	// Create an HTTP client
-	client := &http.Client{}
+	client := s.Settings.HTTPClient
 
 	// Create a GET request
-	req, err := http.NewRequest("GET", parsedURL.String(), nil)
+	req, err := http.NewRequestWithContext(s.Settings.Context, "GET", parsedURL.String(), nil)
 
 	if err != nil {
 		errorCode := 500
@@ -671,7 +669,14 @@ func (s *nftServiceImpl) GetChainCollectionsByPage(chainName chains.Chain, query
 		return &utils.Response[ChainCollectionResponse]{Data: nil, Error: true, ErrorCode: &errorCode, ErrorMessage: &errorMessage}, err
 	}
 
-	req.Header.Set("Authorization", `Bearer `+s.APIKey)
+	apiKey, err := s.Settings.APIKey()
+	if err != nil {
+		errorCode := 401
+		errorMessage := err.Error()
+		return &utils.Response[ChainCollectionResponse]{Data: nil, Error: true, ErrorCode: &errorCode, ErrorMessage: &errorMessage}, err
+	}
+
+	req.Header.Set("Authorization", `Bearer `+apiKey)
 	req.Header.Set("X-Requested-With", utils.UserAgent)
 
 	var startTime time.Time // Declares startTime, initially set to zero value of time.Time
@@ -681,6 +686,7 @@ func (s *nftServiceImpl) GetChainCollectionsByPage(chainName chains.Chain, query
 	}
 
 	// Perform the request
+	requestStart := time.Now()
 	resp, err := client.Do(req)
 	if err != nil {
 		// create a &Response that returns data: nil, error: true, errorCode: "Unknown Error Code", errorMessage: "Unknown Error"
@@ -689,46 +695,49 @@ func (s *nftServiceImpl) GetChainCollectionsByPage(chainName chains.Chain, query
 
 	defer resp.Body.Close()
 
+	s.Settings.Report(apiKey, resp.StatusCode)
+	meta := s.Settings.NewResponseMeta(resp, requestStart)
 	utils.DebugOutput(resp.Request.URL.String(), resp.StatusCode, startTime)
-	backoff := utils.NewExponentialBackoff(s.APIKey, s.Debug, 0, utils.UserAgent)
+	backoff := utils.NewExponentialBackoffWithSettings(s.Settings, s.Debug, 0, utils.UserAgent)
 
 	// // Read the response body
 	var data utils.Response[ChainCollectionResponse]
 
-	if resp.StatusCode == 429 {
+	if resp.StatusCode == 429 && backoff.Enabled() {
 		res, err := backoff.BackOff(resp.Request.URL.String())
 		if err != nil {
 			errorCode := resp.StatusCode
 			errorMessage := err.Error()
-			return &utils.Response[ChainCollectionResponse]{Data: nil, Error: true, ErrorCode: &errorCode, ErrorMessage: &errorMessage}, err
+			return &utils.Response[ChainCollectionResponse]{Data: nil, Error: true, ErrorCode: &errorCode, ErrorMessage: &errorMessage, Meta: meta.Finish()}, err
 		}
+		meta.Retried(res, 1+backoff.RetryCount)
 
-		if err := json.NewDecoder(res.Body).Decode(&data); err != nil {
+		if err := s.Settings.Decode(res.Body, &data, "GetChainCollectionsByPage"); err != nil {
 			res.Body.Close()
 			errorCode := 500
 			errorMessage := err.Error()
-			return &utils.Response[ChainCollectionResponse]{Data: nil, Error: true, ErrorCode: &errorCode, ErrorMessage: &errorMessage}, err
+			return &utils.Response[ChainCollectionResponse]{Data: nil, Error: true, ErrorCode: &errorCode, ErrorMessage: &errorMessage, Meta: meta.Finish()}, err
 		}
 		res.Body.Close()
 	} else {
-		err = json.NewDecoder(resp.Body).Decode(&data)
+		err = s.Settings.Decode(resp.Body, &data, "GetChainCollectionsByPage")
 		if err != nil {
 			errorCode := 500
 			errorMessage := err.Error()
-			return &utils.Response[ChainCollectionResponse]{Data: nil, Error: true, ErrorCode: &errorCode, ErrorMessage: &errorMessage}, err
+			return &utils.Response[ChainCollectionResponse]{Data: nil, Error: true, ErrorCode: &errorCode, ErrorMessage: &errorMessage, Meta: meta.Finish()}, err
 		}
 	}
 
 	if data.Error {
-		return &utils.Response[ChainCollectionResponse]{Data: data.Data, Error: data.Error, ErrorCode: data.ErrorCode, ErrorMessage: data.ErrorMessage}, fmt.Errorf(*data.ErrorMessage)
+		return &utils.Response[ChainCollectionResponse]{Data: data.Data, Error: data.Error, ErrorCode: data.ErrorCode, ErrorMessage: data.ErrorMessage, Meta: meta.Finish()}, fmt.Errorf(*data.ErrorMessage)
 	}
 
-	return &utils.Response[ChainCollectionResponse]{Data: data.Data, Error: data.Error, ErrorCode: data.ErrorCode, ErrorMessage: data.ErrorMessage}, nil
+	return &utils.Response[ChainCollectionResponse]{Data: data.Data, Error: data.Error, ErrorCode: data.ErrorCode, ErrorMessage: data.ErrorMessage, Meta: meta.Finish()}, nil
 }
 
 func (s *nftServiceImpl) GetNftsForAddress(chainName chains.Chain, walletAddress string, queryParamOpts ...GetNftsForAddressQueryParamOpts) (*utils.Response[NftAddressBalanceNftResponse], error) {
 
-	apiURL := fmt.Sprintf("https://api.covalenthq.com/v1/%v/address/%s/balances_nft/", chainName, walletAddress)
+	apiURL := fmt.Sprintf("%s/v1/%v/address/%s/balances_nft/", s.Settings.BaseURL, chainName, walletAddress)
 
 	if !s.IskeyValid {
 		errorCode := 401
@@ -763,10 +772,10 @@ func (s *nftServiceImpl) GetNftsForAddress(chainName chains.Chain, walletAddress
 	parsedURL.RawQuery = params.Encode()
 
 	// Create an HTTP client
-	client := &http.Client{}
+	client := s.Settings.HTTPClient
 
 	// Create a GET request
-	req, err := http.NewRequest("GET", parsedURL.String(), nil)
+	req, err := http.NewRequestWithContext(s.Settings.Context, "GET", parsedURL.String(), nil)
 
 	if err != nil {
 		errorCode := 500
@@ -774,7 +783,14 @@ func (s *nftServiceImpl) GetNftsForAddress(chainName chains.Chain, walletAddress
 		return &utils.Response[NftAddressBalanceNftResponse]{Data: nil, Error: true, ErrorCode: &errorCode, ErrorMessage: &errorMessage}, err
 	}
 
-	req.Header.Set("Authorization", `Bearer `+s.APIKey)
+	apiKey, err := s.Settings.APIKey()
+	if err != nil {
+		errorCode := 401
+		errorMessage := err.Error()
+		return &utils.Response[NftAddressBalanceNftResponse]{Data: nil, Error: true, ErrorCode: &errorCode, ErrorMessage: &errorMessage}, err
+	}
+
+	req.Header.Set("Authorization", `Bearer `+apiKey)
 	req.Header.Set("X-Requested-With", utils.UserAgent)
 
 	var startTime time.Time // Declares startTime, initially set to zero value of time.Time
@@ -784,6 +800,7 @@ func (s *nftServiceImpl) GetNftsForAddress(chainName chains.Chain, walletAddress
 	}
 
 	// Perform the request
+	requestStart := time.Now()
 	resp, err := client.Do(req)
 	if err != nil {
 		// create a &Response that returns data: nil, error: true, errorCode: "Unknown Error Code", errorMessage: "Unknown Error"
@@ -792,41 +809,44 @@ func (s *nftServiceImpl) GetNftsForAddress(chainName chains.Chain, walletAddress
 
 	defer resp.Body.Close()
 
+	s.Settings.Report(apiKey, resp.StatusCode)
+	meta := s.Settings.NewResponseMeta(resp, requestStart)
 	utils.DebugOutput(resp.Request.URL.String(), resp.StatusCode, startTime)
-	backoff := utils.NewExponentialBackoff(s.APIKey, s.Debug, 0, utils.UserAgent)
+	backoff := utils.NewExponentialBackoffWithSettings(s.Settings, s.Debug, 0, utils.UserAgent)
 
 	// // Read the response body
 	var data utils.Response[NftAddressBalanceNftResponse]
 
-	if resp.StatusCode == 429 {
+	if resp.StatusCode == 429 && backoff.Enabled() {
 		res, err := backoff.BackOff(resp.Request.URL.String())
 		if err != nil {
 			errorCode := resp.StatusCode
 			errorMessage := err.Error()
-			return &utils.Response[NftAddressBalanceNftResponse]{Data: nil, Error: true, ErrorCode: &errorCode, ErrorMessage: &errorMessage}, err
+			return &utils.Response[NftAddressBalanceNftResponse]{Data: nil, Error: true, ErrorCode: &errorCode, ErrorMessage: &errorMessage, Meta: meta.Finish()}, err
 		}
+		meta.Retried(res, 1+backoff.RetryCount)
 
-		if err := json.NewDecoder(res.Body).Decode(&data); err != nil {
+		if err := s.Settings.Decode(res.Body, &data, "GetNftsForAddress"); err != nil {
 			res.Body.Close()
 			errorCode := 500
 			errorMessage := err.Error()
-			return &utils.Response[NftAddressBalanceNftResponse]{Data: nil, Error: true, ErrorCode: &errorCode, ErrorMessage: &errorMessage}, err
+			return &utils.Response[NftAddressBalanceNftResponse]{Data: nil, Error: true, ErrorCode: &errorCode, ErrorMessage: &errorMessage, Meta: meta.Finish()}, err
 		}
 		res.Body.Close()
 	} else {
-		err = json.NewDecoder(resp.Body).Decode(&data)
+		err = s.Settings.Decode(resp.Body, &data, "GetNftsForAddress")
 		if err != nil {
 			errorCode := 500
 			errorMessage := err.Error()
-			return &utils.Response[NftAddressBalanceNftResponse]{Data: nil, Error: true, ErrorCode: &errorCode, ErrorMessage: &errorMessage}, err
+			return &utils.Response[NftAddressBalanceNftResponse]{Data: nil, Error: true, ErrorCode: &errorCode, ErrorMessage: &errorMessage, Meta: meta.Finish()}, err
 		}
 	}
 
 	if data.Error {
-		return &utils.Response[NftAddressBalanceNftResponse]{Data: data.Data, Error: data.Error, ErrorCode: data.ErrorCode, ErrorMessage: data.ErrorMessage}, fmt.Errorf(*data.ErrorMessage)
+		return &utils.Response[NftAddressBalanceNftResponse]{Data: data.Data, Error: data.Error, ErrorCode: data.ErrorCode, ErrorMessage: data.ErrorMessage, Meta: meta.Finish()}, fmt.Errorf(*data.ErrorMessage)
 	}
 
-	return &utils.Response[NftAddressBalanceNftResponse]{Data: data.Data, Error: data.Error, ErrorCode: data.ErrorCode, ErrorMessage: data.ErrorMessage}, nil
+	return &utils.Response[NftAddressBalanceNftResponse]{Data: data.Data, Error: data.Error, ErrorCode: data.ErrorCode, ErrorMessage: data.ErrorMessage, Meta: meta.Finish()}, nil
 }
 
 func (s *nftServiceImpl) GetTokenIdsForContractWithMetadata(chainName chains.Chain, contractAddress string, queryParamOpts ...GetTokenIdsForContractWithMetadataQueryParamOpts) <-chan NftTokenContractResult {
@@ -842,7 +862,7 @@ func (s *nftServiceImpl) GetTokenIdsForContractWithMetadata(chainName chains.Cha
 			return
 		}
 
-		apiURL := fmt.Sprintf("https://api.covalenthq.com/v1/%v/nft/%s/metadata/", chainName, contractAddress)
+		apiURL := fmt.Sprintf("%s/v1/%v/nft/%s/metadata/", s.Settings.BaseURL, chainName, contractAddress)
 
 		// Parse the formatted URL
 		parsedURL, err := url.Parse(apiURL)
@@ -898,13 +918,15 @@ func (s *nftServiceImpl) GetTokenIdsForContractWithMetadata(chainName chains.Cha
 		var data utils.Response[NftMetadataResponse]
 		for hasNext {
 
-			res, err := utils.PaginateEndpoint(apiURL, s.APIKey, params, page, s.Debug, s.ThreadCount, utils.UserAgent)
+			res, err := utils.PaginateEndpointWithSettings(s.Settings, apiURL, params, page, s.Debug, s.ThreadCount, utils.UserAgent)
 			if err != nil {
 				nftTokenContractChannel <- NftTokenContractResult{Err: err}
 				hasNext = false
 				return
 			}
-			if err := json.NewDecoder(res.Body).Decode(&data); err != nil {
+			if err := utils.DecodeItems(s.Settings, res.Body, &data, "GetTokenIdsForContractWithMetadata", func(item NftTokenContract) {
+				nftTokenContractChannel <- NftTokenContractResult{NftTokenContract: item}
+			}); err != nil {
 				nftTokenContractChannel <- NftTokenContractResult{Err: err}
 				res.Body.Close()
 				hasNext = false
@@ -923,10 +945,6 @@ func (s *nftServiceImpl) GetTokenIdsForContractWithMetadata(chainName chains.Cha
 				return
 			}
 
-			for _, item := range data.Data.Items {
-				nftTokenContractChannel <- NftTokenContractResult{NftTokenContract: item, Err: err}
-			}
-
 			hasNext = *data.Data.Pagination.HasMore
 			page++
 		}
@@ -936,7 +954,7 @@ func (s *nftServiceImpl) GetTokenIdsForContractWithMetadata(chainName chains.Cha
 }
 
 func (s *nftServiceImpl) GetTokenIdsForContractWithMetadataByPage(chainName chains.Chain, contractAddress string, queryParamOpts ...GetTokenIdsForContractWithMetadataQueryParamOpts) (*utils.Response[NftMetadataResponse], error) {
-	apiURL := fmt.Sprintf("https://api.covalenthq.com/v1/%v/nft/%s/metadata/", chainName, contractAddress)
+	apiURL := fmt.Sprintf("%s/v1/%v/nft/%s/metadata/", s.Settings.BaseURL, chainName, contractAddress)
 
 	if !s.IskeyValid {
 		errorCode := 401
@@ -983,10 +1001,10 @@ func (s *nftServiceImpl) GetTokenIdsForContractWithMetadataByPage(chainName chai
 	parsedURL.RawQuery = params.Encode()
 
 	// Create an HTTP client
-	client := &http.Client{}
+	client := s.Settings.HTTPClient
 
 	// Create a GET request
-	req, err := http.NewRequest("GET", parsedURL.String(), nil)
+	req, err := http.NewRequestWithContext(s.Settings.Context, "GET", parsedURL.String(), nil)
 
 	if err != nil {
 		errorCode := 500
@@ -994,7 +1012,14 @@ func (s *nftServiceImpl) GetTokenIdsForContractWithMetadataByPage(chainName chai
 		return &utils.Response[NftMetadataResponse]{Data: nil, Error: true, ErrorCode: &errorCode, ErrorMessage: &errorMessage}, err
 	}
 
-	req.Header.Set("Authorization", `Bearer `+s.APIKey)
+	apiKey, err := s.Settings.APIKey()
+	if err != nil {
+		errorCode := 401
+		errorMessage := err.Error()
+		return &utils.Response[NftMetadataResponse]{Data: nil, Error: true, ErrorCode: &errorCode, ErrorMessage: &errorMessage}, err
+	}
+
+	req.Header.Set("Authorization", `Bearer `+apiKey)
 	req.Header.Set("X-Requested-With", utils.UserAgent)
 
 	var startTime time.Time // Declares startTime, initially set to zero value of time.Time
@@ -1004,6 +1029,7 @@ func (s *nftServiceImpl) GetTokenIdsForContractWithMetadataByPage(chainName chai
 	}
 
 	// Perform the request
+	requestStart := time.Now()
 	resp, err := client.Do(req)
 	if err != nil {
 		// create a &Response that returns data: nil, error: true, errorCode: "Unknown Error Code", errorMessage: "Unknown Error"
@@ -1012,46 +1038,49 @@ func (s *nftServiceImpl) GetTokenIdsForContractWithMetadataByPage(chainName chai
 
 	defer resp.Body.Close()
 
+	s.Settings.Report(apiKey, resp.StatusCode)
+	meta := s.Settings.NewResponseMeta(resp, requestStart)
 	utils.DebugOutput(resp.Request.URL.String(), resp.StatusCode, startTime)
-	backoff := utils.NewExponentialBackoff(s.APIKey, s.Debug, 0, utils.UserAgent)
+	backoff := utils.NewExponentialBackoffWithSettings(s.Settings, s.Debug, 0, utils.UserAgent)
 
 	// // Read the response body
 	var data utils.Response[NftMetadataResponse]
 
-	if resp.StatusCode == 429 {
+	if resp.StatusCode == 429 && backoff.Enabled() {
 		res, err := backoff.BackOff(resp.Request.URL.String())
 		if err != nil {
 			errorCode := resp.StatusCode
 			errorMessage := err.Error()
-			return &utils.Response[NftMetadataResponse]{Data: nil, Error: true, ErrorCode: &errorCode, ErrorMessage: &errorMessage}, err
+			return &utils.Response[NftMetadataResponse]{Data: nil, Error: true, ErrorCode: &errorCode, ErrorMessage: &errorMessage, Meta: meta.Finish()}, err
 		}
+		meta.Retried(res, 1+backoff.RetryCount)
 
-		if err := json.NewDecoder(res.Body).Decode(&data); err != nil {
+		if err := s.Settings.Decode(res.Body, &data, "GetTokenIdsForContractWithMetadataByPage"); err != nil {
 			res.Body.Close()
 			errorCode := 500
 			errorMessage := err.Error()
-			return &utils.Response[NftMetadataResponse]{Data: nil, Error: true, ErrorCode: &errorCode, ErrorMessage: &errorMessage}, err
+			return &utils.Response[NftMetadataResponse]{Data: nil, Error: true, ErrorCode: &errorCode, ErrorMessage: &errorMessage, Meta: meta.Finish()}, err
 		}
 		res.Body.Close()
 	} else {
-		err = json.NewDecoder(resp.Body).Decode(&data)
+		err = s.Settings.Decode(resp.Body, &data, "GetTokenIdsForContractWithMetadataByPage")
 		if err != nil {
 			errorCode := 500
 			errorMessage := err.Error()
-			return &utils.Response[NftMetadataResponse]{Data: nil, Error: true, ErrorCode: &errorCode, ErrorMessage: &errorMessage}, err
+			return &utils.Response[NftMetadataResponse]{Data: nil, Error: true, ErrorCode: &errorCode, ErrorMessage: &errorMessage, Meta: meta.Finish()}, err
 		}
 	}
 
 	if data.Error {
-		return &utils.Response[NftMetadataResponse]{Data: data.Data, Error: data.Error, ErrorCode: data.ErrorCode, ErrorMessage: data.ErrorMessage}, fmt.Errorf(*data.ErrorMessage)
+		return &utils.Response[NftMetadataResponse]{Data: data.Data, Error: data.Error, ErrorCode: data.ErrorCode, ErrorMessage: data.ErrorMessage, Meta: meta.Finish()}, fmt.Errorf(*data.ErrorMessage)
 	}
 
-	return &utils.Response[NftMetadataResponse]{Data: data.Data, Error: data.Error, ErrorCode: data.ErrorCode, ErrorMessage: data.ErrorMessage}, nil
+	return &utils.Response[NftMetadataResponse]{Data: data.Data, Error: data.Error, ErrorCode: data.ErrorCode, ErrorMessage: data.ErrorMessage, Meta: meta.Finish()}, nil
 }
 
 func (s *nftServiceImpl) GetNftMetadataForGivenTokenIdForContract(chainName chains.Chain, contractAddress string, tokenId string, queryParamOpts ...GetNftMetadataForGivenTokenIdForContractQueryParamOpts) (*utils.Response[NftMetadataResponse], error) {
 
-	apiURL := fmt.Sprintf("https://api.covalenthq.com/v1/%v/nft/%s/metadata/%s/", chainName, contractAddress, tokenId)
+	apiURL := fmt.Sprintf("%s/v1/%v/nft/%s/metadata/%s/", s.Settings.BaseURL, chainName, contractAddress, tokenId)
 
 	if !s.IskeyValid {
 		errorCode := 401
@@ -1082,10 +1111,10 @@ func (s *nftServiceImpl) GetNftMetadataForGivenTokenIdForContract(chainName chai
 	parsedURL.RawQuery = params.Encode()
 
 	// Create an HTTP client
-	client := &http.Client{}
+	client := s.Settings.HTTPClient
 
 	// Create a GET request
-	req, err := http.NewRequest("GET", parsedURL.String(), nil)
+	req, err := http.NewRequestWithContext(s.Settings.Context, "GET", parsedURL.String(), nil)
 
 	if err != nil {
 		errorCode := 500
@@ -1093,7 +1122,14 @@ func (s *nftServiceImpl) GetNftMetadataForGivenTokenIdForContract(chainName chai
 		return &utils.Response[NftMetadataResponse]{Data: nil, Error: true, ErrorCode: &errorCode, ErrorMessage: &errorMessage}, err
 	}
 
-	req.Header.Set("Authorization", `Bearer `+s.APIKey)
+	apiKey, err := s.Settings.APIKey()
+	if err != nil {
+		errorCode := 401
+		errorMessage := err.Error()
+		return &utils.Response[NftMetadataResponse]{Data: nil, Error: true, ErrorCode: &errorCode, ErrorMessage: &errorMessage}, err
+	}
+
+	req.Header.Set("Authorization", `Bearer `+apiKey)
 	req.Header.Set("X-Requested-With", utils.UserAgent)
 
 	var startTime time.Time // Declares startTime, initially set to zero value of time.Time
@@ -1103,6 +1139,7 @@ func (s *nftServiceImpl) GetNftMetadataForGivenTokenIdForContract(chainName chai
 	}
 
 	// Perform the request
+	requestStart := time.Now()
 	resp, err := client.Do(req)
 	if err != nil {
 		// create a &Response that returns data: nil, error: true, errorCode: "Unknown Error Code", errorMessage: "Unknown Error"
@@ -1111,46 +1148,49 @@ func (s *nftServiceImpl) GetNftMetadataForGivenTokenIdForContract(chainName chai
 
 	defer resp.Body.Close()
 
+	s.Settings.Report(apiKey, resp.StatusCode)
+	meta := s.Settings.NewResponseMeta(resp, requestStart)
 	utils.DebugOutput(resp.Request.URL.String(), resp.StatusCode, startTime)
-	backoff := utils.NewExponentialBackoff(s.APIKey, s.Debug, 0, utils.UserAgent)
+	backoff := utils.NewExponentialBackoffWithSettings(s.Settings, s.Debug, 0, utils.UserAgent)
 
 	// // Read the response body
 	var data utils.Response[NftMetadataResponse]
 
-	if resp.StatusCode == 429 {
+	if resp.StatusCode == 429 && backoff.Enabled() {
 		res, err := backoff.BackOff(resp.Request.URL.String())
 		if err != nil {
 			errorCode := resp.StatusCode
 			errorMessage := err.Error()
-			return &utils.Response[NftMetadataResponse]{Data: nil, Error: true, ErrorCode: &errorCode, ErrorMessage: &errorMessage}, err
+			return &utils.Response[NftMetadataResponse]{Data: nil, Error: true, ErrorCode: &errorCode, ErrorMessage: &errorMessage, Meta: meta.Finish()}, err
 		}
+		meta.Retried(res, 1+backoff.RetryCount)
 
-		if err := json.NewDecoder(res.Body).Decode(&data); err != nil {
+		if err := s.Settings.Decode(res.Body, &data, "GetNftMetadataForGivenTokenIdForContract"); err != nil {
 			res.Body.Close()
 			errorCode := 500
 			errorMessage := err.Error()
-			return &utils.Response[NftMetadataResponse]{Data: nil, Error: true, ErrorCode: &errorCode, ErrorMessage: &errorMessage}, err
+			return &utils.Response[NftMetadataResponse]{Data: nil, Error: true, ErrorCode: &errorCode, ErrorMessage: &errorMessage, Meta: meta.Finish()}, err
 		}
 		res.Body.Close()
 	} else {
-		err = json.NewDecoder(resp.Body).Decode(&data)
+		err = s.Settings.Decode(resp.Body, &data, "GetNftMetadataForGivenTokenIdForContract")
 		if err != nil {
 			errorCode := 500
 			errorMessage := err.Error()
-			return &utils.Response[NftMetadataResponse]{Data: nil, Error: true, ErrorCode: &errorCode, ErrorMessage: &errorMessage}, err
+			return &utils.Response[NftMetadataResponse]{Data: nil, Error: true, ErrorCode: &errorCode, ErrorMessage: &errorMessage, Meta: meta.Finish()}, err
 		}
 	}
 
 	if data.Error {
-		return &utils.Response[NftMetadataResponse]{Data: data.Data, Error: data.Error, ErrorCode: data.ErrorCode, ErrorMessage: data.ErrorMessage}, fmt.Errorf(*data.ErrorMessage)
+		return &utils.Response[NftMetadataResponse]{Data: data.Data, Error: data.Error, ErrorCode: data.ErrorCode, ErrorMessage: data.ErrorMessage, Meta: meta.Finish()}, fmt.Errorf(*data.ErrorMessage)
 	}
 
-	return &utils.Response[NftMetadataResponse]{Data: data.Data, Error: data.Error, ErrorCode: data.ErrorCode, ErrorMessage: data.ErrorMessage}, nil
+	return &utils.Response[NftMetadataResponse]{Data: data.Data, Error: data.Error, ErrorCode: data.ErrorCode, ErrorMessage: data.ErrorMessage, Meta: meta.Finish()}, nil
 }
 
 func (s *nftServiceImpl) GetNftTransactionsForContractTokenId(chainName chains.Chain, contractAddress string, tokenId string, queryParamOpts ...GetNftTransactionsForContractTokenIdQueryParamOpts) (*utils.Response[NftTransactionsResponse], error) {
 
-	apiURL := fmt.Sprintf("https://api.covalenthq.com/v1/%v/tokens/%s/nft_transactions/%s/", chainName, contractAddress, tokenId)
+	apiURL := fmt.Sprintf("%s/v1/%v/tokens/%s/nft_transactions/%s/", s.Settings.BaseURL, chainName, contractAddress, tokenId)
 
 	if !s.IskeyValid {
 		errorCode := 401
@@ -1177,10 +1217,10 @@ func (s *nftServiceImpl) GetNftTransactionsForContractTokenId(chainName chains.C
 	parsedURL.RawQuery = params.Encode()
 
 	// Create an HTTP client
-	client := &http.Client{}
+	client := s.Settings.HTTPClient
 
 	// Create a GET request
-	req, err := http.NewRequest("GET", parsedURL.String(), nil)
+	req, err := http.NewRequestWithContext(s.Settings.Context, "GET", parsedURL.String(), nil)
 
 	if err != nil {
 		errorCode := 500
@@ -1188,7 +1228,14 @@ func (s *nftServiceImpl) GetNftTransactionsForContractTokenId(chainName chains.C
 		return &utils.Response[NftTransactionsResponse]{Data: nil, Error: true, ErrorCode: &errorCode, ErrorMessage: &errorMessage}, err
 	}
 
-	req.Header.Set("Authorization", `Bearer `+s.APIKey)
+	apiKey, err := s.Settings.APIKey()
+	if err != nil {
+		errorCode := 401
+		errorMessage := err.Error()
+		return &utils.Response[NftTransactionsResponse]{Data: nil, Error: true, ErrorCode: &errorCode, ErrorMessage: &errorMessage}, err
+	}
+
+	req.Header.Set("Authorization", `Bearer `+apiKey)
 	req.Header.Set("X-Requested-With", utils.UserAgent)
 
 	var startTime time.Time // Declares startTime, initially set to zero value of time.Time
@@ -1198,6 +1245,7 @@ func (s *nftServiceImpl) GetNftTransactionsForContractTokenId(chainName chains.C
 	}
 
 	// Perform the request
+	requestStart := time.Now()
 	resp, err := client.Do(req)
 	if err != nil {
 		// create a &Response that returns data: nil, error: true, errorCode: "Unknown Error Code", errorMessage: "Unknown Error"
@@ -1206,46 +1254,49 @@ func (s *nftServiceImpl) GetNftTransactionsForContractTokenId(chainName chains.C
 
 	defer resp.Body.Close()
 
+	s.Settings.Report(apiKey, resp.StatusCode)
+	meta := s.Settings.NewResponseMeta(resp, requestStart)
 	utils.DebugOutput(resp.Request.URL.String(), resp.StatusCode, startTime)
-	backoff := utils.NewExponentialBackoff(s.APIKey, s.Debug, 0, utils.UserAgent)
+	backoff := utils.NewExponentialBackoffWithSettings(s.Settings, s.Debug, 0, utils.UserAgent)
 
 	// // Read the response body
 	var data utils.Response[NftTransactionsResponse]
 
-	if resp.StatusCode == 429 {
+	if resp.StatusCode == 429 && backoff.Enabled() {
 		res, err := backoff.BackOff(resp.Request.URL.String())
 		if err != nil {
 			errorCode := resp.StatusCode
 			errorMessage := err.Error()
-			return &utils.Response[NftTransactionsResponse]{Data: nil, Error: true, ErrorCode: &errorCode, ErrorMessage: &errorMessage}, err
+			return &utils.Response[NftTransactionsResponse]{Data: nil, Error: true, ErrorCode: &errorCode, ErrorMessage: &errorMessage, Meta: meta.Finish()}, err
 		}
+		meta.Retried(res, 1+backoff.RetryCount)
 
-		if err := json.NewDecoder(res.Body).Decode(&data); err != nil {
+		if err := s.Settings.Decode(res.Body, &data, "GetNftTransactionsForContractTokenId"); err != nil {
 			res.Body.Close()
 			errorCode := 500
 			errorMessage := err.Error()
-			return &utils.Response[NftTransactionsResponse]{Data: nil, Error: true, ErrorCode: &errorCode, ErrorMessage: &errorMessage}, err
+			return &utils.Response[NftTransactionsResponse]{Data: nil, Error: true, ErrorCode: &errorCode, ErrorMessage: &errorMessage, Meta: meta.Finish()}, err
 		}
 		res.Body.Close()
 	} else {
-		err = json.NewDecoder(resp.Body).Decode(&data)
+		err = s.Settings.Decode(resp.Body, &data, "GetNftTransactionsForContractTokenId")
 		if err != nil {
 			errorCode := 500
 			errorMessage := err.Error()
-			return &utils.Response[NftTransactionsResponse]{Data: nil, Error: true, ErrorCode: &errorCode, ErrorMessage: &errorMessage}, err
+			return &utils.Response[NftTransactionsResponse]{Data: nil, Error: true, ErrorCode: &errorCode, ErrorMessage: &errorMessage, Meta: meta.Finish()}, err
 		}
 	}
 
 	if data.Error {
-		return &utils.Response[NftTransactionsResponse]{Data: data.Data, Error: data.Error, ErrorCode: data.ErrorCode, ErrorMessage: data.ErrorMessage}, fmt.Errorf(*data.ErrorMessage)
+		return &utils.Response[NftTransactionsResponse]{Data: data.Data, Error: data.Error, ErrorCode: data.ErrorCode, ErrorMessage: data.ErrorMessage, Meta: meta.Finish()}, fmt.Errorf(*data.ErrorMessage)
 	}
 
-	return &utils.Response[NftTransactionsResponse]{Data: data.Data, Error: data.Error, ErrorCode: data.ErrorCode, ErrorMessage: data.ErrorMessage}, nil
+	return &utils.Response[NftTransactionsResponse]{Data: data.Data, Error: data.Error, ErrorCode: data.ErrorCode, ErrorMessage: data.ErrorMessage, Meta: meta.Finish()}, nil
 }
 
 func (s *nftServiceImpl) GetTraitsForCollection(chainName chains.Chain, collectionContract string) (*utils.Response[NftCollectionTraitsResponse], error) {
 
-	apiURL := fmt.Sprintf("https://api.covalenthq.com/v1/%v/nft/%s/traits/", chainName, collectionContract)
+	apiURL := fmt.Sprintf("%s/v1/%v/nft/%s/traits/", s.Settings.BaseURL, chainName, collectionContract)
 
 	if !s.IskeyValid {
 		errorCode := 401
@@ -1264,10 +1315,10 @@ func (s *nftServiceImpl) GetTraitsForCollection(chainName chains.Chain, collecti
 	parsedURL.RawQuery = params.Encode()
 
 	// Create an HTTP client
-	client := &http.Client{}
+	client := s.Settings.HTTPClient
 
 	// Create a GET request
-	req, err := http.NewRequest("GET", parsedURL.String(), nil)
+	req, err := http.NewRequestWithContext(s.Settings.Context, "GET", parsedURL.String(), nil)
 
 	if err != nil {
 		errorCode := 500
@@ -1275,7 +1326,14 @@ func (s *nftServiceImpl) GetTraitsForCollection(chainName chains.Chain, collecti
 		return &utils.Response[NftCollectionTraitsResponse]{Data: nil, Error: true, ErrorCode: &errorCode, ErrorMessage: &errorMessage}, err
 	}
 
-	req.Header.Set("Authorization", `Bearer `+s.APIKey)
+	apiKey, err := s.Settings.APIKey()
+	if err != nil {
+		errorCode := 401
+		errorMessage := err.Error()
+		return &utils.Response[NftCollectionTraitsResponse]{Data: nil, Error: true, ErrorCode: &errorCode, ErrorMessage: &errorMessage}, err
+	}
+
+	req.Header.Set("Authorization", `Bearer `+apiKey)
 	req.Header.Set("X-Requested-With", utils.UserAgent)
 
 	var startTime time.Time // Declares startTime, initially set to zero value of time.Time
@@ -1285,6 +1343,7 @@ func (s *nftServiceImpl) GetTraitsForCollection(chainName chains.Chain, collecti
 	}
 
 	// Perform the request
+	requestStart := time.Now()
 	resp, err := client.Do(req)
 	if err != nil {
 		// create a &Response that returns data: nil, error: true, errorCode: "Unknown Error Code", errorMessage: "Unknown Error"
@@ -1293,46 +1352,49 @@ func (s *nftServiceImpl) GetTraitsForCollection(chainName chains.Chain, collecti
 
 	defer resp.Body.Close()
 
+	s.Settings.Report(apiKey, resp.StatusCode)
+	meta := s.Settings.NewResponseMeta(resp, requestStart)
 	utils.DebugOutput(resp.Request.URL.String(), resp.StatusCode, startTime)
-	backoff := utils.NewExponentialBackoff(s.APIKey, s.Debug, 0, utils.UserAgent)
+	backoff := utils.NewExponentialBackoffWithSettings(s.Settings, s.Debug, 0, utils.UserAgent)
 
 	// // Read the response body
 	var data utils.Response[NftCollectionTraitsResponse]
 
-	if resp.StatusCode == 429 {
+	if resp.StatusCode == 429 && backoff.Enabled() {
 		res, err := backoff.BackOff(resp.Request.URL.String())
 		if err != nil {
 			errorCode := resp.StatusCode
 			errorMessage := err.Error()
-			return &utils.Response[NftCollectionTraitsResponse]{Data: nil, Error: true, ErrorCode: &errorCode, ErrorMessage: &errorMessage}, err
+			return &utils.Response[NftCollectionTraitsResponse]{Data: nil, Error: true, ErrorCode: &errorCode, ErrorMessage: &errorMessage, Meta: meta.Finish()}, err
 		}
+		meta.Retried(res, 1+backoff.RetryCount)
 
-		if err := json.NewDecoder(res.Body).Decode(&data); err != nil {
+		if err := s.Settings.Decode(res.Body, &data, "GetTraitsForCollection"); err != nil {
 			res.Body.Close()
 			errorCode := 500
 			errorMessage := err.Error()
-			return &utils.Response[NftCollectionTraitsResponse]{Data: nil, Error: true, ErrorCode: &errorCode, ErrorMessage: &errorMessage}, err
+			return &utils.Response[NftCollectionTraitsResponse]{Data: nil, Error: true, ErrorCode: &errorCode, ErrorMessage: &errorMessage, Meta: meta.Finish()}, err
 		}
 		res.Body.Close()
 	} else {
-		err = json.NewDecoder(resp.Body).Decode(&data)
+		err = s.Settings.Decode(resp.Body, &data, "GetTraitsForCollection")
 		if err != nil {
 			errorCode := 500
 			errorMessage := err.Error()
-			return &utils.Response[NftCollectionTraitsResponse]{Data: nil, Error: true, ErrorCode: &errorCode, ErrorMessage: &errorMessage}, err
+			return &utils.Response[NftCollectionTraitsResponse]{Data: nil, Error: true, ErrorCode: &errorCode, ErrorMessage: &errorMessage, Meta: meta.Finish()}, err
 		}
 	}
 
 	if data.Error {
-		return &utils.Response[NftCollectionTraitsResponse]{Data: data.Data, Error: data.Error, ErrorCode: data.ErrorCode, ErrorMessage: data.ErrorMessage}, fmt.Errorf(*data.ErrorMessage)
+		return &utils.Response[NftCollectionTraitsResponse]{Data: data.Data, Error: data.Error, ErrorCode: data.ErrorCode, ErrorMessage: data.ErrorMessage, Meta: meta.Finish()}, fmt.Errorf(*data.ErrorMessage)
 	}
 
-	return &utils.Response[NftCollectionTraitsResponse]{Data: data.Data, Error: data.Error, ErrorCode: data.ErrorCode, ErrorMessage: data.ErrorMessage}, nil
+	return &utils.Response[NftCollectionTraitsResponse]{Data: data.Data, Error: data.Error, ErrorCode: data.ErrorCode, ErrorMessage: data.ErrorMessage, Meta: meta.Finish()}, nil
 }
 
 func (s *nftServiceImpl) GetAttributesForTraitInCollection(chainName chains.Chain, collectionContract string, trait string) (*utils.Response[NftCollectionAttributesForTraitResponse], error) {
 
-	apiURL := fmt.Sprintf("https://api.covalenthq.com/v1/%v/nft/%s/traits/%s/attributes/", chainName, collectionContract, trait)
+	apiURL := fmt.Sprintf("%s/v1/%v/nft/%s/traits/%s/attributes/", s.Settings.BaseURL, chainName, collectionContract, trait)
 
 	if !s.IskeyValid {
 		errorCode := 401
@@ -1351,10 +1413,10 @@ func (s *nftServiceImpl) GetAttributesForTraitInCollection(chainName chains.Chai
 	parsedURL.RawQuery = params.Encode()
 
 	// Create an HTTP client
-	client := &http.Client{}
+	client := s.Settings.HTTPClient
 
 	// Create a GET request
-	req, err := http.NewRequest("GET", parsedURL.String(), nil)
+	req, err := http.NewRequestWithContext(s.Settings.Context, "GET", parsedURL.String(), nil)
 
 	if err != nil {
 		errorCode := 500
@@ -1362,7 +1424,14 @@ func (s *nftServiceImpl) GetAttributesForTraitInCollection(chainName chains.Chai
 		return &utils.Response[NftCollectionAttributesForTraitResponse]{Data: nil, Error: true, ErrorCode: &errorCode, ErrorMessage: &errorMessage}, err
 	}
 
-	req.Header.Set("Authorization", `Bearer `+s.APIKey)
+	apiKey, err := s.Settings.APIKey()
+	if err != nil {
+		errorCode := 401
+		errorMessage := err.Error()
+		return &utils.Response[NftCollectionAttributesForTraitResponse]{Data: nil, Error: true, ErrorCode: &errorCode, ErrorMessage: &errorMessage}, err
+	}
+
+	req.Header.Set("Authorization", `Bearer `+apiKey)
 	req.Header.Set("X-Requested-With", utils.UserAgent)
 
 	var startTime time.Time // Declares startTime, initially set to zero value of time.Time
@@ -1372,6 +1441,7 @@ func (s *nftServiceImpl) GetAttributesForTraitInCollection(chainName chains.Chai
 	}
 
 	// Perform the request
+	requestStart := time.Now()
 	resp, err := client.Do(req)
 	if err != nil {
 		// create a &Response that returns data: nil, error: true, errorCode: "Unknown Error Code", errorMessage: "Unknown Error"
@@ -1380,46 +1450,49 @@ func (s *nftServiceImpl) GetAttributesForTraitInCollection(chainName chains.Chai
 
 	defer resp.Body.Close()
 
+	s.Settings.Report(apiKey, resp.StatusCode)
+	meta := s.Settings.NewResponseMeta(resp, requestStart)
 	utils.DebugOutput(resp.Request.URL.String(), resp.StatusCode, startTime)
-	backoff := utils.NewExponentialBackoff(s.APIKey, s.Debug, 0, utils.UserAgent)
+	backoff := utils.NewExponentialBackoffWithSettings(s.Settings, s.Debug, 0, utils.UserAgent)
 
 	// // Read the response body
 	var data utils.Response[NftCollectionAttributesForTraitResponse]
 
-	if resp.StatusCode == 429 {
+	if resp.StatusCode == 429 && backoff.Enabled() {
 		res, err := backoff.BackOff(resp.Request.URL.String())
 		if err != nil {
 			errorCode := resp.StatusCode
 			errorMessage := err.Error()
-			return &utils.Response[NftCollectionAttributesForTraitResponse]{Data: nil, Error: true, ErrorCode: &errorCode, ErrorMessage: &errorMessage}, err
+			return &utils.Response[NftCollectionAttributesForTraitResponse]{Data: nil, Error: true, ErrorCode: &errorCode, ErrorMessage: &errorMessage, Meta: meta.Finish()}, err
 		}
+		meta.Retried(res, 1+backoff.RetryCount)
 
-		if err := json.NewDecoder(res.Body).Decode(&data); err != nil {
+		if err := s.Settings.Decode(res.Body, &data, "GetAttributesForTraitInCollection"); err != nil {
 			res.Body.Close()
 			errorCode := 500
 			errorMessage := err.Error()
-			return &utils.Response[NftCollectionAttributesForTraitResponse]{Data: nil, Error: true, ErrorCode: &errorCode, ErrorMessage: &errorMessage}, err
+			return &utils.Response[NftCollectionAttributesForTraitResponse]{Data: nil, Error: true, ErrorCode: &errorCode, ErrorMessage: &errorMessage, Meta: meta.Finish()}, err
 		}
 		res.Body.Close()
 	} else {
-		err = json.NewDecoder(resp.Body).Decode(&data)
+		err = s.Settings.Decode(resp.Body, &data, "GetAttributesForTraitInCollection")
 		if err != nil {
 			errorCode := 500
 			errorMessage := err.Error()
-			return &utils.Response[NftCollectionAttributesForTraitResponse]{Data: nil, Error: true, ErrorCode: &errorCode, ErrorMessage: &errorMessage}, err
+			return &utils.Response[NftCollectionAttributesForTraitResponse]{Data: nil, Error: true, ErrorCode: &errorCode, ErrorMessage: &errorMessage, Meta: meta.Finish()}, err
 		}
 	}
 
 	if data.Error {
-		return &utils.Response[NftCollectionAttributesForTraitResponse]{Data: data.Data, Error: data.Error, ErrorCode: data.ErrorCode, ErrorMessage: data.ErrorMessage}, fmt.Errorf(*data.ErrorMessage)
+		return &utils.Response[NftCollectionAttributesForTraitResponse]{Data: data.Data, Error: data.Error, ErrorCode: data.ErrorCode, ErrorMessage: data.ErrorMessage, Meta: meta.Finish()}, fmt.Errorf(*data.ErrorMessage)
 	}
 
-	return &utils.Response[NftCollectionAttributesForTraitResponse]{Data: data.Data, Error: data.Error, ErrorCode: data.ErrorCode, ErrorMessage: data.ErrorMessage}, nil
+	return &utils.Response[NftCollectionAttributesForTraitResponse]{Data: data.Data, Error: data.Error, ErrorCode: data.ErrorCode, ErrorMessage: data.ErrorMessage, Meta: meta.Finish()}, nil
 }
 
 func (s *nftServiceImpl) GetCollectionTraitsSummary(chainName chains.Chain, collectionContract string) (*utils.Response[NftCollectionTraitSummaryResponse], error) {
 
-	apiURL := fmt.Sprintf("https://api.covalenthq.com/v1/%v/nft/%s/traits_summary/", chainName, collectionContract)
+	apiURL := fmt.Sprintf("%s/v1/%v/nft/%s/traits_summary/", s.Settings.BaseURL, chainName, collectionContract)
 
 	if !s.IskeyValid {
 		errorCode := 401
@@ -1438,10 +1511,10 @@ func (s *nftServiceImpl) GetCollectionTraitsSummary(chainName chains.Chain, coll
 	parsedURL.RawQuery = params.Encode()
 
 	// Create an HTTP client
-	client := &http.Client{}
+	client := s.Settings.HTTPClient
 
 	// Create a GET request
-	req, err := http.NewRequest("GET", parsedURL.String(), nil)
+	req, err := http.NewRequestWithContext(s.Settings.Context, "GET", parsedURL.String(), nil)
 
 	if err != nil {
 		errorCode := 500
@@ -1449,7 +1522,14 @@ func (s *nftServiceImpl) GetCollectionTraitsSummary(chainName chains.Chain, coll
 		return &utils.Response[NftCollectionTraitSummaryResponse]{Data: nil, Error: true, ErrorCode: &errorCode, ErrorMessage: &errorMessage}, err
 	}
 
-	req.Header.Set("Authorization", `Bearer `+s.APIKey)
+	apiKey, err := s.Settings.APIKey()
+	if err != nil {
+		errorCode := 401
+		errorMessage := err.Error()
+		return &utils.Response[NftCollectionTraitSummaryResponse]{Data: nil, Error: true, ErrorCode: &errorCode, ErrorMessage: &errorMessage}, err
+	}
+
+	req.Header.Set("Authorization", `Bearer `+apiKey)
 	req.Header.Set("X-Requested-With", utils.UserAgent)
 
 	var startTime time.Time // Declares startTime, initially set to zero value of time.Time
@@ -1459,6 +1539,7 @@ func (s *nftServiceImpl) GetCollectionTraitsSummary(chainName chains.Chain, coll
 	}
 
 	// Perform the request
+	requestStart := time.Now()
 	resp, err := client.Do(req)
 	if err != nil {
 		// create a &Response that returns data: nil, error: true, errorCode: "Unknown Error Code", errorMessage: "Unknown Error"
@@ -1467,46 +1548,49 @@ func (s *nftServiceImpl) GetCollectionTraitsSummary(chainName chains.Chain, coll
 
 	defer resp.Body.Close()
 
+	s.Settings.Report(apiKey, resp.StatusCode)
+	meta := s.Settings.NewResponseMeta(resp, requestStart)
 	utils.DebugOutput(resp.Request.URL.String(), resp.StatusCode, startTime)
-	backoff := utils.NewExponentialBackoff(s.APIKey, s.Debug, 0, utils.UserAgent)
+	backoff := utils.NewExponentialBackoffWithSettings(s.Settings, s.Debug, 0, utils.UserAgent)
 
 	// // Read the response body
 	var data utils.Response[NftCollectionTraitSummaryResponse]
 
-	if resp.StatusCode == 429 {
+	if resp.StatusCode == 429 && backoff.Enabled() {
 		res, err := backoff.BackOff(resp.Request.URL.String())
 		if err != nil {
 			errorCode := resp.StatusCode
 			errorMessage := err.Error()
-			return &utils.Response[NftCollectionTraitSummaryResponse]{Data: nil, Error: true, ErrorCode: &errorCode, ErrorMessage: &errorMessage}, err
+			return &utils.Response[NftCollectionTraitSummaryResponse]{Data: nil, Error: true, ErrorCode: &errorCode, ErrorMessage: &errorMessage, Meta: meta.Finish()}, err
 		}
+		meta.Retried(res, 1+backoff.RetryCount)
 
-		if err := json.NewDecoder(res.Body).Decode(&data); err != nil {
+		if err := s.Settings.Decode(res.Body, &data, "GetCollectionTraitsSummary"); err != nil {
 			res.Body.Close()
 			errorCode := 500
 			errorMessage := err.Error()
-			return &utils.Response[NftCollectionTraitSummaryResponse]{Data: nil, Error: true, ErrorCode: &errorCode, ErrorMessage: &errorMessage}, err
+			return &utils.Response[NftCollectionTraitSummaryResponse]{Data: nil, Error: true, ErrorCode: &errorCode, ErrorMessage: &errorMessage, Meta: meta.Finish()}, err
 		}
 		res.Body.Close()
 	} else {
-		err = json.NewDecoder(resp.Body).Decode(&data)
+		err = s.Settings.Decode(resp.Body, &data, "GetCollectionTraitsSummary")
 		if err != nil {
 			errorCode := 500
 			errorMessage := err.Error()
-			return &utils.Response[NftCollectionTraitSummaryResponse]{Data: nil, Error: true, ErrorCode: &errorCode, ErrorMessage: &errorMessage}, err
+			return &utils.Response[NftCollectionTraitSummaryResponse]{Data: nil, Error: true, ErrorCode: &errorCode, ErrorMessage: &errorMessage, Meta: meta.Finish()}, err
 		}
 	}
 
 	if data.Error {
-		return &utils.Response[NftCollectionTraitSummaryResponse]{Data: data.Data, Error: data.Error, ErrorCode: data.ErrorCode, ErrorMessage: data.ErrorMessage}, fmt.Errorf(*data.ErrorMessage)
+		return &utils.Response[NftCollectionTraitSummaryResponse]{Data: data.Data, Error: data.Error, ErrorCode: data.ErrorCode, ErrorMessage: data.ErrorMessage, Meta: meta.Finish()}, fmt.Errorf(*data.ErrorMessage)
 	}
 
-	return &utils.Response[NftCollectionTraitSummaryResponse]{Data: data.Data, Error: data.Error, ErrorCode: data.ErrorCode, ErrorMessage: data.ErrorMessage}, nil
+	return &utils.Response[NftCollectionTraitSummaryResponse]{Data: data.Data, Error: data.Error, ErrorCode: data.ErrorCode, ErrorMessage: data.ErrorMessage, Meta: meta.Finish()}, nil
 }
 
 func (s *nftServiceImpl) CheckOwnershipInNft(chainName chains.Chain, walletAddress string, collectionContract string, queryParamOpts ...CheckOwnershipInNftQueryParamOpts) (*utils.Response[NftOwnershipForCollectionResponse], error) {
 
-	apiURL := fmt.Sprintf("https://api.covalenthq.com/v1/%v/address/%s/collection/%s/", chainName, walletAddress, collectionContract)
+	apiURL := fmt.Sprintf("%s/v1/%v/address/%s/collection/%s/", s.Settings.BaseURL, chainName, walletAddress, collectionContract)
 
 	if !s.IskeyValid {
 		errorCode := 401
@@ -1537,10 +1621,10 @@ func (s *nftServiceImpl) CheckOwnershipInNft(chainName chains.Chain, walletAddre
 	parsedURL.RawQuery = params.Encode()
 
 	// Create an HTTP client
-	client := &http.Client{}
+	client := s.Settings.HTTPClient
 
 	// Create a GET request
-	req, err := http.NewRequest("GET", parsedURL.String(), nil)
+	req, err := http.NewRequestWithContext(s.Settings.Context, "GET", parsedURL.String(), nil)
 
 	if err != nil {
 		errorCode := 500
@@ -1548,7 +1632,14 @@ func (s *nftServiceImpl) CheckOwnershipInNft(chainName chains.Chain, walletAddre
 		return &utils.Response[NftOwnershipForCollectionResponse]{Data: nil, Error: true, ErrorCode: &errorCode, ErrorMessage: &errorMessage}, err
 	}
 
-	req.Header.Set("Authorization", `Bearer `+s.APIKey)
+	apiKey, err := s.Settings.APIKey()
+	if err != nil {
+		errorCode := 401
+		errorMessage := err.Error()
+		return &utils.Response[NftOwnershipForCollectionResponse]{Data: nil, Error: true, ErrorCode: &errorCode, ErrorMessage: &errorMessage}, err
+	}
+
+	req.Header.Set("Authorization", `Bearer `+apiKey)
 	req.Header.Set("X-Requested-With", utils.UserAgent)
 
 	var startTime time.Time // Declares startTime, initially set to zero value of time.Time
@@ -1558,6 +1649,7 @@ func (s *nftServiceImpl) CheckOwnershipInNft(chainName chains.Chain, walletAddre
 	}
 
 	// Perform the request
+	requestStart := time.Now()
 	resp, err := client.Do(req)
 	if err != nil {
 		// create a &Response that returns data: nil, error: true, errorCode: "Unknown Error Code", errorMessage: "Unknown Error"
@@ -1566,46 +1658,49 @@ func (s *nftServiceImpl) CheckOwnershipInNft(chainName chains.Chain, walletAddre
 
 	defer resp.Body.Close()
 
+	s.Settings.Report(apiKey, resp.StatusCode)
+	meta := s.Settings.NewResponseMeta(resp, requestStart)
 	utils.DebugOutput(resp.Request.URL.String(), resp.StatusCode, startTime)
-	backoff := utils.NewExponentialBackoff(s.APIKey, s.Debug, 0, utils.UserAgent)
+	backoff := utils.NewExponentialBackoffWithSettings(s.Settings, s.Debug, 0, utils.UserAgent)
 
 	// // Read the response body
 	var data utils.Response[NftOwnershipForCollectionResponse]
 
-	if resp.StatusCode == 429 {
+	if resp.StatusCode == 429 && backoff.Enabled() {
 		res, err := backoff.BackOff(resp.Request.URL.String())
 		if err != nil {
 			errorCode := resp.StatusCode
 			errorMessage := err.Error()
-			return &utils.Response[NftOwnershipForCollectionResponse]{Data: nil, Error: true, ErrorCode: &errorCode, ErrorMessage: &errorMessage}, err
+			return &utils.Response[NftOwnershipForCollectionResponse]{Data: nil, Error: true, ErrorCode: &errorCode, ErrorMessage: &errorMessage, Meta: meta.Finish()}, err
 		}
+		meta.Retried(res, 1+backoff.RetryCount)
 
-		if err := json.NewDecoder(res.Body).Decode(&data); err != nil {
+		if err := s.Settings.Decode(res.Body, &data, "CheckOwnershipInNft"); err != nil {
 			res.Body.Close()
 			errorCode := 500
 			errorMessage := err.Error()
-			return &utils.Response[NftOwnershipForCollectionResponse]{Data: nil, Error: true, ErrorCode: &errorCode, ErrorMessage: &errorMessage}, err
+			return &utils.Response[NftOwnershipForCollectionResponse]{Data: nil, Error: true, ErrorCode: &errorCode, ErrorMessage: &errorMessage, Meta: meta.Finish()}, err
 		}
 		res.Body.Close()
 	} else {
-		err = json.NewDecoder(resp.Body).Decode(&data)
+		err = s.Settings.Decode(resp.Body, &data, "CheckOwnershipInNft")
 		if err != nil {
 			errorCode := 500
 			errorMessage := err.Error()
-			return &utils.Response[NftOwnershipForCollectionResponse]{Data: nil, Error: true, ErrorCode: &errorCode, ErrorMessage: &errorMessage}, err
+			return &utils.Response[NftOwnershipForCollectionResponse]{Data: nil, Error: true, ErrorCode: &errorCode, ErrorMessage: &errorMessage, Meta: meta.Finish()}, err
 		}
 	}
 
 	if data.Error {
-		return &utils.Response[NftOwnershipForCollectionResponse]{Data: data.Data, Error: data.Error, ErrorCode: data.ErrorCode, ErrorMessage: data.ErrorMessage}, fmt.Errorf(*data.ErrorMessage)
+		return &utils.Response[NftOwnershipForCollectionResponse]{Data: data.Data, Error: data.Error, ErrorCode: data.ErrorCode, ErrorMessage: data.ErrorMessage, Meta: meta.Finish()}, fmt.Errorf(*data.ErrorMessage)
 	}
 
-	return &utils.Response[NftOwnershipForCollectionResponse]{Data: data.Data, Error: data.Error, ErrorCode: data.ErrorCode, ErrorMessage: data.ErrorMessage}, nil
+	return &utils.Response[NftOwnershipForCollectionResponse]{Data: data.Data, Error: data.Error, ErrorCode: data.ErrorCode, ErrorMessage: data.ErrorMessage, Meta: meta.Finish()}, nil
 }
 
 func (s *nftServiceImpl) CheckOwnershipInNftForSpecificTokenId(chainName chains.Chain, walletAddress string, collectionContract string, tokenId string) (*utils.Response[NftOwnershipForCollectionResponse], error) {
 
-	apiURL := fmt.Sprintf("https://api.covalenthq.com/v1/%v/address/%s/collection/%s/token/%s/", chainName, walletAddress, collectionContract, tokenId)
+	apiURL := fmt.Sprintf("%s/v1/%v/address/%s/collection/%s/token/%s/", s.Settings.BaseURL, chainName, walletAddress, collectionContract, tokenId)
 
 	if !s.IskeyValid {
 		errorCode := 401
@@ -1624,10 +1719,10 @@ func (s *nftServiceImpl) CheckOwnershipInNftForSpecificTokenId(chainName chains.
 	parsedURL.RawQuery = params.Encode()
 
 	// Create an HTTP client
-	client := &http.Client{}
+	client := s.Settings.HTTPClient
 
 	// Create a GET request
-	req, err := http.NewRequest("GET", parsedURL.String(), nil)
+	req, err := http.NewRequestWithContext(s.Settings.Context, "GET", parsedURL.String(), nil)
 
 	if err != nil {
 		errorCode := 500
@@ -1635,7 +1730,14 @@ func (s *nftServiceImpl) CheckOwnershipInNftForSpecificTokenId(chainName chains.
 		return &utils.Response[NftOwnershipForCollectionResponse]{Data: nil, Error: true, ErrorCode: &errorCode, ErrorMessage: &errorMessage}, err
 	}
 
-	req.Header.Set("Authorization", `Bearer `+s.APIKey)
+	apiKey, err := s.Settings.APIKey()
+	if err != nil {
+		errorCode := 401
+		errorMessage := err.Error()
+		return &utils.Response[NftOwnershipForCollectionResponse]{Data: nil, Error: true, ErrorCode: &errorCode, ErrorMessage: &errorMessage}, err
+	}
+
+	req.Header.Set("Authorization", `Bearer `+apiKey)
 	req.Header.Set("X-Requested-With", utils.UserAgent)
 
 	var startTime time.Time // Declares startTime, initially set to zero value of time.Time
@@ -1645,6 +1747,7 @@ func (s *nftServiceImpl) CheckOwnershipInNftForSpecificTokenId(chainName chains.
 	}
 
 	// Perform the request
+	requestStart := time.Now()
 	resp, err := client.Do(req)
 	if err != nil {
 		// create a &Response that returns data: nil, error: true, errorCode: "Unknown Error Code", errorMessage: "Unknown Error"
@@ -1653,46 +1756,49 @@ func (s *nftServiceImpl) CheckOwnershipInNftForSpecificTokenId(chainName chains.
 
 	defer resp.Body.Close()
 
+	s.Settings.Report(apiKey, resp.StatusCode)
+	meta := s.Settings.NewResponseMeta(resp, requestStart)
 	utils.DebugOutput(resp.Request.URL.String(), resp.StatusCode, startTime)
-	backoff := utils.NewExponentialBackoff(s.APIKey, s.Debug, 0, utils.UserAgent)
+	backoff := utils.NewExponentialBackoffWithSettings(s.Settings, s.Debug, 0, utils.UserAgent)
 
 	// // Read the response body
 	var data utils.Response[NftOwnershipForCollectionResponse]
 
-	if resp.StatusCode == 429 {
+	if resp.StatusCode == 429 && backoff.Enabled() {
 		res, err := backoff.BackOff(resp.Request.URL.String())
 		if err != nil {
 			errorCode := resp.StatusCode
 			errorMessage := err.Error()
-			return &utils.Response[NftOwnershipForCollectionResponse]{Data: nil, Error: true, ErrorCode: &errorCode, ErrorMessage: &errorMessage}, err
+			return &utils.Response[NftOwnershipForCollectionResponse]{Data: nil, Error: true, ErrorCode: &errorCode, ErrorMessage: &errorMessage, Meta: meta.Finish()}, err
 		}
+		meta.Retried(res, 1+backoff.RetryCount)
 
-		if err := json.NewDecoder(res.Body).Decode(&data); err != nil {
+		if err := s.Settings.Decode(res.Body, &data, "CheckOwnershipInNftForSpecificTokenId"); err != nil {
 			res.Body.Close()
 			errorCode := 500
 			errorMessage := err.Error()
-			return &utils.Response[NftOwnershipForCollectionResponse]{Data: nil, Error: true, ErrorCode: &errorCode, ErrorMessage: &errorMessage}, err
+			return &utils.Response[NftOwnershipForCollectionResponse]{Data: nil, Error: true, ErrorCode: &errorCode, ErrorMessage: &errorMessage, Meta: meta.Finish()}, err
 		}
 		res.Body.Close()
 	} else {
-		err = json.NewDecoder(resp.Body).Decode(&data)
+		err = s.Settings.Decode(resp.Body, &data, "CheckOwnershipInNftForSpecificTokenId")
 		if err != nil {
 			errorCode := 500
 			errorMessage := err.Error()
-			return &utils.Response[NftOwnershipForCollectionResponse]{Data: nil, Error: true, ErrorCode: &errorCode, ErrorMessage: &errorMessage}, err
+			return &utils.Response[NftOwnershipForCollectionResponse]{Data: nil, Error: true, ErrorCode: &errorCode, ErrorMessage: &errorMessage, Meta: meta.Finish()}, err
 		}
 	}
 
 	if data.Error {
-		return &utils.Response[NftOwnershipForCollectionResponse]{Data: data.Data, Error: data.Error, ErrorCode: data.ErrorCode, ErrorMessage: data.ErrorMessage}, fmt.Errorf(*data.ErrorMessage)
+		return &utils.Response[NftOwnershipForCollectionResponse]{Data: data.Data, Error: data.Error, ErrorCode: data.ErrorCode, ErrorMessage: data.ErrorMessage, Meta: meta.Finish()}, fmt.Errorf(*data.ErrorMessage)
 	}
 
-	return &utils.Response[NftOwnershipForCollectionResponse]{Data: data.Data, Error: data.Error, ErrorCode: data.ErrorCode, ErrorMessage: data.ErrorMessage}, nil
+	return &utils.Response[NftOwnershipForCollectionResponse]{Data: data.Data, Error: data.Error, ErrorCode: data.ErrorCode, ErrorMessage: data.ErrorMessage, Meta: meta.Finish()}, nil
 }
 
 func (s *nftServiceImpl) GetNftMarketSaleCount(chainName chains.Chain, contractAddress string, queryParamOpts ...GetNftMarketSaleCountQueryParamOpts) (*utils.Response[NftMarketSaleCountResponse], error) {
 
-	apiURL := fmt.Sprintf("https://api.covalenthq.com/v1/%v/nft_market/%s/sale_count/", chainName, contractAddress)
+	apiURL := fmt.Sprintf("%s/v1/%v/nft_market/%s/sale_count/", s.Settings.BaseURL, chainName, contractAddress)
 
 	if !s.IskeyValid {
 		errorCode := 401
@@ -1723,10 +1829,10 @@ func (s *nftServiceImpl) GetNftMarketSaleCount(chainName chains.Chain, contractA
 	parsedURL.RawQuery = params.Encode()
 
 	// Create an HTTP client
-	client := &http.Client{}
+	client := s.Settings.HTTPClient
 
 	// Create a GET request
-	req, err := http.NewRequest("GET", parsedURL.String(), nil)
+	req, err := http.NewRequestWithContext(s.Settings.Context, "GET", parsedURL.String(), nil)
 
 	if err != nil {
 		errorCode := 500
@@ -1734,7 +1840,14 @@ func (s *nftServiceImpl) GetNftMarketSaleCount(chainName chains.Chain, contractA
 		return &utils.Response[NftMarketSaleCountResponse]{Data: nil, Error: true, ErrorCode: &errorCode, ErrorMessage: &errorMessage}, err
 	}
 
-	req.Header.Set("Authorization", `Bearer `+s.APIKey)
+	apiKey, err := s.Settings.APIKey()
+	if err != nil {
+		errorCode := 401
+		errorMessage := err.Error()
+		return &utils.Response[NftMarketSaleCountResponse]{Data: nil, Error: true, ErrorCode: &errorCode, ErrorMessage: &errorMessage}, err
+	}
+
+	req.Header.Set("Authorization", `Bearer `+apiKey)
 	req.Header.Set("X-Requested-With", utils.UserAgent)
 
 	var startTime time.Time // Declares startTime, initially set to zero value of time.Time
@@ -1744,6 +1857,7 @@ func (s *nftServiceImpl) GetNftMarketSaleCount(chainName chains.Chain, contractA
 	}
 
 	// Perform the request
+	requestStart := time.Now()
 	resp, err := client.Do(req)
 	if err != nil {
 		// create a &Response that returns data: nil, error: true, errorCode: "Unknown Error Code", errorMessage: "Unknown Error"
@@ -1752,46 +1866,49 @@ func (s *nftServiceImpl) GetNftMarketSaleCount(chainName chains.Chain, contractA
 
 	defer resp.Body.Close()
 
+	s.Settings.Report(apiKey, resp.StatusCode)
+	meta := s.Settings.NewResponseMeta(resp, requestStart)
 	utils.DebugOutput(resp.Request.URL.String(), resp.StatusCode, startTime)
-	backoff := utils.NewExponentialBackoff(s.APIKey, s.Debug, 0, utils.UserAgent)
+	backoff := utils.NewExponentialBackoffWithSettings(s.Settings, s.Debug, 0, utils.UserAgent)
 
 	// // Read the response body
 	var data utils.Response[NftMarketSaleCountResponse]
 
-	if resp.StatusCode == 429 {
+	if resp.StatusCode == 429 && backoff.Enabled() {
 		res, err := backoff.BackOff(resp.Request.URL.String())
 		if err != nil {
 			errorCode := resp.StatusCode
 			errorMessage := err.Error()
-			return &utils.Response[NftMarketSaleCountResponse]{Data: nil, Error: true, ErrorCode: &errorCode, ErrorMessage: &errorMessage}, err
+			return &utils.Response[NftMarketSaleCountResponse]{Data: nil, Error: true, ErrorCode: &errorCode, ErrorMessage: &errorMessage, Meta: meta.Finish()}, err
 		}
+		meta.Retried(res, 1+backoff.RetryCount)
 
-		if err := json.NewDecoder(res.Body).Decode(&data); err != nil {
+		if err := s.Settings.Decode(res.Body, &data, "GetNftMarketSaleCount"); err != nil {
 			res.Body.Close()
 			errorCode := 500
 			errorMessage := err.Error()
-			return &utils.Response[NftMarketSaleCountResponse]{Data: nil, Error: true, ErrorCode: &errorCode, ErrorMessage: &errorMessage}, err
+			return &utils.Response[NftMarketSaleCountResponse]{Data: nil, Error: true, ErrorCode: &errorCode, ErrorMessage: &errorMessage, Meta: meta.Finish()}, err
 		}
 		res.Body.Close()
 	} else {
-		err = json.NewDecoder(resp.Body).Decode(&data)
+		err = s.Settings.Decode(resp.Body, &data, "GetNftMarketSaleCount")
 		if err != nil {
 			errorCode := 500
 			errorMessage := err.Error()
-			return &utils.Response[NftMarketSaleCountResponse]{Data: nil, Error: true, ErrorCode: &errorCode, ErrorMessage: &errorMessage}, err
+			return &utils.Response[NftMarketSaleCountResponse]{Data: nil, Error: true, ErrorCode: &errorCode, ErrorMessage: &errorMessage, Meta: meta.Finish()}, err
 		}
 	}
 
 	if data.Error {
-		return &utils.Response[NftMarketSaleCountResponse]{Data: data.Data, Error: data.Error, ErrorCode: data.ErrorCode, ErrorMessage: data.ErrorMessage}, fmt.Errorf(*data.ErrorMessage)
+		return &utils.Response[NftMarketSaleCountResponse]{Data: data.Data, Error: data.Error, ErrorCode: data.ErrorCode, ErrorMessage: data.ErrorMessage, Meta: meta.Finish()}, fmt.Errorf(*data.ErrorMessage)
 	}
 
-	return &utils.Response[NftMarketSaleCountResponse]{Data: data.Data, Error: data.Error, ErrorCode: data.ErrorCode, ErrorMessage: data.ErrorMessage}, nil
+	return &utils.Response[NftMarketSaleCountResponse]{Data: data.Data, Error: data.Error, ErrorCode: data.ErrorCode, ErrorMessage: data.ErrorMessage, Meta: meta.Finish()}, nil
 }
 
 func (s *nftServiceImpl) GetNftMarketVolume(chainName chains.Chain, contractAddress string, queryParamOpts ...GetNftMarketVolumeQueryParamOpts) (*utils.Response[NftMarketVolumeResponse], error) {
 
-	apiURL := fmt.Sprintf("https://api.covalenthq.com/v1/%v/nft_market/%s/volume/", chainName, contractAddress)
+	apiURL := fmt.Sprintf("%s/v1/%v/nft_market/%s/volume/", s.Settings.BaseURL, chainName, contractAddress)
 
 	if !s.IskeyValid {
 		errorCode := 401
@@ -1822,10 +1939,10 @@ func (s *nftServiceImpl) GetNftMarketVolume(chainName chains.Chain, contractAddr
 	parsedURL.RawQuery = params.Encode()
 
 	// Create an HTTP client
-	client := &http.Client{}
+	client := s.Settings.HTTPClient
 
 	// Create a GET request
-	req, err := http.NewRequest("GET", parsedURL.String(), nil)
+	req, err := http.NewRequestWithContext(s.Settings.Context, "GET", parsedURL.String(), nil)
 
 	if err != nil {
 		errorCode := 500
@@ -1833,7 +1950,14 @@ func (s *nftServiceImpl) GetNftMarketVolume(chainName chains.Chain, contractAddr
 		return &utils.Response[NftMarketVolumeResponse]{Data: nil, Error: true, ErrorCode: &errorCode, ErrorMessage: &errorMessage}, err
 	}
 
-	req.Header.Set("Authorization", `Bearer `+s.APIKey)
+	apiKey, err := s.Settings.APIKey()
+	if err != nil {
+		errorCode := 401
+		errorMessage := err.Error()
+		return &utils.Response[NftMarketVolumeResponse]{Data: nil, Error: true, ErrorCode: &errorCode, ErrorMessage: &errorMessage}, err
+	}
+
+	req.Header.Set("Authorization", `Bearer `+apiKey)
 	req.Header.Set("X-Requested-With", utils.UserAgent)
 
 	var startTime time.Time // Declares startTime, initially set to zero value of time.Time
@@ -1843,6 +1967,7 @@ func (s *nftServiceImpl) GetNftMarketVolume(chainName chains.Chain, contractAddr
 	}
 
 	// Perform the request
+	requestStart := time.Now()
 	resp, err := client.Do(req)
 	if err != nil {
 		// create a &Response that returns data: nil, error: true, errorCode: "Unknown Error Code", errorMessage: "Unknown Error"
@@ -1851,46 +1976,49 @@ func (s *nftServiceImpl) GetNftMarketVolume(chainName chains.Chain, contractAddr
 
 	defer resp.Body.Close()
 
+	s.Settings.Report(apiKey, resp.StatusCode)
+	meta := s.Settings.NewResponseMeta(resp, requestStart)
 	utils.DebugOutput(resp.Request.URL.String(), resp.StatusCode, startTime)
-	backoff := utils.NewExponentialBackoff(s.APIKey, s.Debug, 0, utils.UserAgent)
+	backoff := utils.NewExponentialBackoffWithSettings(s.Settings, s.Debug, 0, utils.UserAgent)
 
 	// // Read the response body
 	var data utils.Response[NftMarketVolumeResponse]
 
-	if resp.StatusCode == 429 {
+	if resp.StatusCode == 429 && backoff.Enabled() {
 		res, err := backoff.BackOff(resp.Request.URL.String())
 		if err != nil {
 			errorCode := resp.StatusCode
 			errorMessage := err.Error()
-			return &utils.Response[NftMarketVolumeResponse]{Data: nil, Error: true, ErrorCode: &errorCode, ErrorMessage: &errorMessage}, err
+			return &utils.Response[NftMarketVolumeResponse]{Data: nil, Error: true, ErrorCode: &errorCode, ErrorMessage: &errorMessage, Meta: meta.Finish()}, err
 		}
+		meta.Retried(res, 1+backoff.RetryCount)
 
-		if err := json.NewDecoder(res.Body).Decode(&data); err != nil {
+		if err := s.Settings.Decode(res.Body, &data, "GetNftMarketVolume"); err != nil {
 			res.Body.Close()
 			errorCode := 500
 			errorMessage := err.Error()
-			return &utils.Response[NftMarketVolumeResponse]{Data: nil, Error: true, ErrorCode: &errorCode, ErrorMessage: &errorMessage}, err
+			return &utils.Response[NftMarketVolumeResponse]{Data: nil, Error: true, ErrorCode: &errorCode, ErrorMessage: &errorMessage, Meta: meta.Finish()}, err
 		}
 		res.Body.Close()
 	} else {
-		err = json.NewDecoder(resp.Body).Decode(&data)
+		err = s.Settings.Decode(resp.Body, &data, "GetNftMarketVolume")
 		if err != nil {
 			errorCode := 500
 			errorMessage := err.Error()
-			return &utils.Response[NftMarketVolumeResponse]{Data: nil, Error: true, ErrorCode: &errorCode, ErrorMessage: &errorMessage}, err
+			return &utils.Response[NftMarketVolumeResponse]{Data: nil, Error: true, ErrorCode: &errorCode, ErrorMessage: &errorMessage, Meta: meta.Finish()}, err
 		}
 	}
 
 	if data.Error {
-		return &utils.Response[NftMarketVolumeResponse]{Data: data.Data, Error: data.Error, ErrorCode: data.ErrorCode, ErrorMessage: data.ErrorMessage}, fmt.Errorf(*data.ErrorMessage)
+		return &utils.Response[NftMarketVolumeResponse]{Data: data.Data, Error: data.Error, ErrorCode: data.ErrorCode, ErrorMessage: data.ErrorMessage, Meta: meta.Finish()}, fmt.Errorf(*data.ErrorMessage)
 	}
 
-	return &utils.Response[NftMarketVolumeResponse]{Data: data.Data, Error: data.Error, ErrorCode: data.ErrorCode, ErrorMessage: data.ErrorMessage}, nil
+	return &utils.Response[NftMarketVolumeResponse]{Data: data.Data, Error: data.Error, ErrorCode: data.ErrorCode, ErrorMessage: data.ErrorMessage, Meta: meta.Finish()}, nil
 }
 
 func (s *nftServiceImpl) GetNftMarketFloorPrice(chainName chains.Chain, contractAddress string, queryParamOpts ...GetNftMarketFloorPriceQueryParamOpts) (*utils.Response[NftMarketFloorPriceResponse], error) {
 
-	apiURL := fmt.Sprintf("https://api.covalenthq.com/v1/%v/nft_market/%s/floor_price/", chainName, contractAddress)
+	apiURL := fmt.Sprintf("%s/v1/%v/nft_market/%s/floor_price/", s.Settings.BaseURL, chainName, contractAddress)
 
 	if !s.IskeyValid {
 		errorCode := 401
@@ -1921,10 +2049,10 @@ func (s *nftServiceImpl) GetNftMarketFloorPrice(chainName chains.Chain, contract
 	parsedURL.RawQuery = params.Encode()
 
 	// Create an HTTP client
-	client := &http.Client{}
+	client := s.Settings.HTTPClient
 
 	// Create a GET request
-	req, err := http.NewRequest("GET", parsedURL.String(), nil)
+	req, err := http.NewRequestWithContext(s.Settings.Context, "GET", parsedURL.String(), nil)
 
 	if err != nil {
 		errorCode := 500
@@ -1932,7 +2060,14 @@ func (s *nftServiceImpl) GetNftMarketFloorPrice(chainName chains.Chain, contract
 		return &utils.Response[NftMarketFloorPriceResponse]{Data: nil, Error: true, ErrorCode: &errorCode, ErrorMessage: &errorMessage}, err
 	}
 
-	req.Header.Set("Authorization", `Bearer `+s.APIKey)
+	apiKey, err := s.Settings.APIKey()
+	if err != nil {
+		errorCode := 401
+		errorMessage := err.Error()
+		return &utils.Response[NftMarketFloorPriceResponse]{Data: nil, Error: true, ErrorCode: &errorCode, ErrorMessage: &errorMessage}, err
+	}
+
+	req.Header.Set("Authorization", `Bearer `+apiKey)
 	req.Header.Set("X-Requested-With", utils.UserAgent)
 
 	var startTime time.Time // Declares startTime, initially set to zero value of time.Time
@@ -1942,6 +2077,7 @@ func (s *nftServiceImpl) GetNftMarketFloorPrice(chainName chains.Chain, contract
 	}
 
 	// Perform the request
+	requestStart := time.Now()
 	resp, err := client.Do(req)
 	if err != nil {
 		// create a &Response that returns data: nil, error: true, errorCode: "Unknown Error Code", errorMessage: "Unknown Error"
@@ -1950,39 +2086,42 @@ func (s *nftServiceImpl) GetNftMarketFloorPrice(chainName chains.Chain, contract
 
 	defer resp.Body.Close()
 
+	s.Settings.Report(apiKey, resp.StatusCode)
+	meta := s.Settings.NewResponseMeta(resp, requestStart)
 	utils.DebugOutput(resp.Request.URL.String(), resp.StatusCode, startTime)
-	backoff := utils.NewExponentialBackoff(s.APIKey, s.Debug, 0, utils.UserAgent)
+	backoff := utils.NewExponentialBackoffWithSettings(s.Settings, s.Debug, 0, utils.UserAgent)
 
 	// // Read the response body
 	var data utils.Response[NftMarketFloorPriceResponse]
 
-	if resp.StatusCode == 429 {
+	if resp.StatusCode == 429 && backoff.Enabled() {
 		res, err := backoff.BackOff(resp.Request.URL.String())
 		if err != nil {
 			errorCode := resp.StatusCode
 			errorMessage := err.Error()
-			return &utils.Response[NftMarketFloorPriceResponse]{Data: nil, Error: true, ErrorCode: &errorCode, ErrorMessage: &errorMessage}, err
+			return &utils.Response[NftMarketFloorPriceResponse]{Data: nil, Error: true, ErrorCode: &errorCode, ErrorMessage: &errorMessage, Meta: meta.Finish()}, err
 		}
+		meta.Retried(res, 1+backoff.RetryCount)
 
-		if err := json.NewDecoder(res.Body).Decode(&data); err != nil {
+		if err := s.Settings.Decode(res.Body, &data, "GetNftMarketFloorPrice"); err != nil {
 			res.Body.Close()
 			errorCode := 500
 			errorMessage := err.Error()
-			return &utils.Response[NftMarketFloorPriceResponse]{Data: nil, Error: true, ErrorCode: &errorCode, ErrorMessage: &errorMessage}, err
+			return &utils.Response[NftMarketFloorPriceResponse]{Data: nil, Error: true, ErrorCode: &errorCode, ErrorMessage: &errorMessage, Meta: meta.Finish()}, err
 		}
 		res.Body.Close()
 	} else {
-		err = json.NewDecoder(resp.Body).Decode(&data)
+		err = s.Settings.Decode(resp.Body, &data, "GetNftMarketFloorPrice")
 		if err != nil {
 			errorCode := 500
 			errorMessage := err.Error()
-			return &utils.Response[NftMarketFloorPriceResponse]{Data: nil, Error: true, ErrorCode: &errorCode, ErrorMessage: &errorMessage}, err
+			return &utils.Response[NftMarketFloorPriceResponse]{Data: nil, Error: true, ErrorCode: &errorCode, ErrorMessage: &errorMessage, Meta: meta.Finish()}, err
 		}
 	}
 
 	if data.Error {
-		return &utils.Response[NftMarketFloorPriceResponse]{Data: data.Data, Error: data.Error, ErrorCode: data.ErrorCode, ErrorMessage: data.ErrorMessage}, fmt.Errorf(*data.ErrorMessage)
+		return &utils.Response[NftMarketFloorPriceResponse]{Data: data.Data, Error: data.Error, ErrorCode: data.ErrorCode, ErrorMessage: data.ErrorMessage, Meta: meta.Finish()}, fmt.Errorf(*data.ErrorMessage)
 	}
 
-	return &utils.Response[NftMarketFloorPriceResponse]{Data: data.Data, Error: data.Error, ErrorCode: data.ErrorCode, ErrorMessage: data.ErrorMessage}, nil
+	return &utils.Response[NftMarketFloorPriceResponse]{Data: data.Data, Error: data.Error, ErrorCode: data.ErrorCode, ErrorMessage: data.ErrorMessage, Meta: meta.Finish()}, nil
 }
@@ -0,0 +1,96 @@
+package services
+
+import (
+	"github.com/covalenthq/covalent-api-sdk-go/nfturi"
+)
+
+// ResolvedImage returns Image rewritten to gateway, eg: `ipfs://<cid>` to `https://ipfs.io/ipfs/<cid>`. Returns "" when it is missing.
+func (e *NftExternalData) ResolvedImage(gateway nfturi.Gateway) string {
+	return resolveURI(e.Image, gateway)
+}
+
+// ResolvedAnimationUrl returns AnimationUrl rewritten to gateway. Returns "" when it is missing.
+func (e *NftExternalData) ResolvedAnimationUrl(gateway nfturi.Gateway) string {
+	return resolveURI(e.AnimationUrl, gateway)
+}
+
+// ResolvedAssetUrl returns AssetUrl rewritten to gateway. Returns "" when it is missing.
+func (e *NftExternalData) ResolvedAssetUrl(gateway nfturi.Gateway) string {
+	return resolveURI(e.AssetUrl, gateway)
+}
+
+// MediaType returns AssetMimeType, or the media type detected from the asset, animation or image URI. Returns "" when it is unknown.
+func (e *NftExternalData) MediaType() string {
+	if e.AssetMimeType != nil && *e.AssetMimeType != "" {
+		return *e.AssetMimeType
+	}
+	return detectMediaType(e.AssetUrl, e.AnimationUrl, e.Image)
+}
+
+// ResolvedImage returns Image rewritten to gateway, eg: `ipfs://<cid>` to `https://ipfs.io/ipfs/<cid>`. Returns "" when it is missing.
+func (e *NftExternalDataV1) ResolvedImage(gateway nfturi.Gateway) string {
+	return resolveURI(e.Image, gateway)
+}
+
+// ResolvedAnimationUrl returns AnimationUrl rewritten to gateway. Returns "" when it is missing.
+func (e *NftExternalDataV1) ResolvedAnimationUrl(gateway nfturi.Gateway) string {
+	return resolveURI(e.AnimationUrl, gateway)
+}
+
+// MediaType returns the media type detected from the animation or image URI. Returns "" when it is unknown.
+func (e *NftExternalDataV1) MediaType() string {
+	return detectMediaType(e.AnimationUrl, e.Image)
+}
+
+// ResolvedTokenUrl returns TokenUrl rewritten to gateway. Returns "" when it is missing.
+func (n *NftData) ResolvedTokenUrl(gateway nfturi.Gateway) string {
+	return resolveURI(n.TokenUrl, gateway)
+}
+
+// InlineMetadata decodes the metadata of a TokenUrl that is a data: URI, as on-chain collections return. Returns nil with nfturi.ErrNotData when TokenUrl points elsewhere.
+func (n *NftData) InlineMetadata() (*nfturi.Metadata, error) {
+	return inlineMetadata(n.TokenUrl)
+}
+
+// ResolvedTokenUrl returns TokenUrl rewritten to gateway. Returns "" when it is missing.
+func (n *BalanceNftData) ResolvedTokenUrl(gateway nfturi.Gateway) string {
+	return resolveURI(n.TokenUrl, gateway)
+}
+
+// InlineMetadata decodes the metadata of a TokenUrl that is a data: URI, as on-chain collections return. Returns nil with nfturi.ErrNotData when TokenUrl points elsewhere.
+func (n *BalanceNftData) InlineMetadata() (*nfturi.Metadata, error) {
+	return inlineMetadata(n.TokenUrl)
+}
+
+func resolveURI(s *string, gateway nfturi.Gateway) string {
+	if s == nil {
+		return ""
+	}
+	return nfturi.Rewrite(*s, gateway)
+}
+
+func detectMediaType(uris ...*string) string {
+	for _, s := range uris {
+		if s == nil {
+			continue
+		}
+		if mediaType := nfturi.MediaType(*s); mediaType != "" {
+			return mediaType
+		}
+	}
+	return ""
+}
+
+func inlineMetadata(s *string) (*nfturi.Metadata, error) {
+	if s == nil {
+		return nil, nfturi.ErrNotData
+	}
+	uri, err := nfturi.Parse(*s)
+	if err != nil {
+		return nil, err
+	}
+	if uri.Kind != nfturi.Data {
+		return nil, nfturi.ErrNotData
+	}
+	return nfturi.DecodeMetadata(*s)
+}
@@ -1,7 +1,6 @@
 package services
 
 import (
-	"encoding/json"
 	"fmt"
 	"net/http"
 	"net/url"
@@ -48,7 +47,7 @@ type LogoUrls struct {
 type Price struct {
 	ContractMetadata *genericmodels.ContractMetadata `json:"contract_metadata,omitempty"`
 	// The date of the price capture.
-	Date *CustomTime `json:"date,omitempty"`
+	Date *utils.CustomTime `json:"date,omitempty"`
 	// The price in the requested `quote-currency`.
 	Price *float64 `json:"price,omitempty"`
 	// A prettier version of the price for rendering purposes.
@@ -64,36 +63,38 @@ type GetTokenPricesQueryParamOpts struct {
 	PricesAtAsc *bool `json:"pricesAtAsc,omitempty"`
 }
 
-type CustomTime struct {
-	*time.Time
-}
+// CustomTime is the time type of Price.Date.
+//
+// Deprecated: use utils.CustomTime.
+type CustomTime = utils.CustomTime
 
-func (ct *CustomTime) UnmarshalJSON(b []byte) error {
-	// Trim quotes since JSON numbers and booleans come in quotes
-	s := strings.Trim(string(b), "\"")
-	if s == "null" || s == "" {
-		return nil
-	}
-	// Parse the string to time.Time using the expected layout
-	// Adjust "2006-01-02" as needed to match your input format
-	t, err := time.Parse("2006-01-02", s)
-	if err != nil {
-		return err
+// TokenPricesList holds one TokenPricesResponse per contract address requested from GetTokenPrices.
+type TokenPricesList []TokenPricesResponse
+
+// ForContract returns the prices of contractAddress, compared case-insensitively, or nil when the list has none.
+func (l TokenPricesList) ForContract(contractAddress string) *TokenPricesResponse {
+	for i := range l {
+		if strings.EqualFold(l[i].ContractAddress, contractAddress) {
+			return &l[i]
+		}
 	}
-	ct.Time = &t
 	return nil
 }
 
-type Response[T any] struct {
-	Data         *[]T    `json:"data,omitempty"`
-	Error        bool    `json:"error"`
-	ErrorCode    *int    `json:"error_code"`
-	ErrorMessage *string `json:"error_message"`
+// ByContract returns the prices keyed by contract address. Entries whose address cannot be parsed are left out.
+func (l TokenPricesList) ByContract() map[utils.Address]TokenPricesResponse {
+	byContract := make(map[utils.Address]TokenPricesResponse, len(l))
+	for _, prices := range l {
+		if address, err := utils.ParseAddress(prices.ContractAddress); err == nil {
+			byContract[address] = prices
+		}
+	}
+	return byContract
 }
 
-func NewPricingServiceImpl(apiKey string, debug bool, threadCount int, isValidKey bool) PricingService {
+func NewPricingServiceImpl(apiKey string, debug bool, threadCount int, isValidKey bool, settings ...utils.RequestSettings) PricingService {
 
-	return &pricingServiceImpl{APIKey: apiKey, Debug: debug, ThreadCount: threadCount, IskeyValid: isValidKey}
+	return &pricingServiceImpl{APIKey: apiKey, Debug: debug, ThreadCount: threadCount, IskeyValid: isValidKey, Settings: utils.NewRequestSettings(apiKey, settings...)}
 }
 
 type PricingService interface {
@@ -103,7 +104,7 @@ type PricingService interface {
 	// chainName: The chain name eg: `eth-mainnet`.. Type: chains.Chain
 	// quoteCurrency: The currency to convert. Supports `USD`, `CAD`, `EUR`, `SGD`, `INR`, `JPY`, `VND`, `CNY`, `KRW`, `RUB`, `TRY`, `NGN`, `ARS`, `AUD`, `CHF`, and `GBP`.. Type: quotes.Quote
 	// contractAddress: Contract address for the token. Passing in an `ENS`, `RNS`, `Lens Handle`, or an `Unstoppable Domain` resolves automatically. Supports multiple contract addresses separated by commas.. Type: string
-	GetTokenPrices(chainName chains.Chain, quoteCurrency quotes.Quote, contractAddress string, queryParamOpts ...GetTokenPricesQueryParamOpts) (*Response[TokenPricesResponse], error)
+	GetTokenPrices(chainName chains.Chain, quoteCurrency quotes.Quote, contractAddress string, queryParamOpts ...GetTokenPricesQueryParamOpts) (*utils.Response[TokenPricesList], error)
 }
 
 type pricingServiceImpl struct {
@@ -111,16 +112,17 @@ type pricingServiceImpl struct {
 	Debug       bool
 	ThreadCount int
 	IskeyValid  bool
+	Settings    utils.RequestSettings
 }
 
-func (s *pricingServiceImpl) GetTokenPrices(chainName chains.Chain, quoteCurrency quotes.Quote, contractAddress string, queryParamOpts ...GetTokenPricesQueryParamOpts) (*Response[TokenPricesResponse], error) {
+func (s *pricingServiceImpl) GetTokenPrices(chainName chains.Chain, quoteCurrency quotes.Quote, contractAddress string, queryParamOpts ...GetTokenPricesQueryParamOpts) (*utils.Response[TokenPricesList], error) {
 
-	apiURL := fmt.Sprintf("https://api.covalenthq.com/v1/pricing/historical_by_addresses_v2/%v/%v/%s/", chainName, quoteCurrency, contractAddress)
+	apiURL := fmt.Sprintf("%s/v1/pricing/historical_by_addresses_v2/%v/%v/%s/", s.Settings.BaseURL, chainName, quoteCurrency, contractAddress)
 
 	if !s.IskeyValid {
 		errorCode := 401
 		errorMessage := utils.InvalidAPIKeyMessage
-		return &Response[TokenPricesResponse]{Data: nil, Error: true, ErrorCode: &errorCode, ErrorMessage: &errorMessage}, fmt.Errorf(utils.InvalidAPIKeyMessage)
+		return &utils.Response[TokenPricesList]{Data: nil, Error: true, ErrorCode: &errorCode, ErrorMessage: &errorMessage}, fmt.Errorf(utils.InvalidAPIKeyMessage)
 	}
 
 	parsedURL, err := url.Parse(apiURL)
@@ -150,18 +152,25 @@ func (s *pricingServiceImpl) GetTokenPrices(chainName chains.Chain, quoteCurrenc
 	parsedURL.RawQuery = params.Encode()
 
 	// Create an HTTP client
-	client := &http.Client{}
+	client := s.Settings.HTTPClient
 
 	// Create a GET request
-	req, err := http.NewRequest("GET", parsedURL.String(), nil)
+	req, err := http.NewRequestWithContext(s.Settings.Context, "GET", parsedURL.String(), nil)
 
 	if err != nil {
 		errorCode := 500
 		errorMessage := "Unknown Error"
-		return &Response[TokenPricesResponse]{Data: nil, Error: true, ErrorCode: &errorCode, ErrorMessage: &errorMessage}, err
+		return &utils.Response[TokenPricesList]{Data: nil, Error: true, ErrorCode: &errorCode, ErrorMessage: &errorMessage}, err
+	}
+
+	apiKey, err := s.Settings.APIKey()
+	if err != nil {
+		errorCode := 401
+		errorMessage := err.Error()
+		return &utils.Response[TokenPricesList]{Data: nil, Error: true, ErrorCode: &errorCode, ErrorMessage: &errorMessage}, err
 	}
 
-	req.Header.Set("Authorization", `Bearer `+s.APIKey)
+	req.Header.Set("Authorization", `Bearer `+apiKey)
 	req.Header.Set("X-Requested-With", utils.UserAgent)
 
 	var startTime time.Time // Declares startTime, initially set to zero value of time.Time
@@ -171,6 +180,7 @@ func (s *pricingServiceImpl) GetTokenPrices(chainName chains.Chain, quoteCurrenc
 	}
 
 	// Perform the request
+	requestStart := time.Now()
 	resp, err := client.Do(req)
 	if err != nil {
 		// create a &Response that returns data: nil, error: true, errorCode: "Unknown Error Code", errorMessage: "Unknown Error"
@@ -179,39 +189,42 @@ func (s *pricingServiceImpl) GetTokenPrices(chainName chains.Chain, quoteCurrenc
 
 	defer resp.Body.Close()
 
+	s.Settings.Report(apiKey, resp.StatusCode)
+	meta := s.Settings.NewResponseMeta(resp, requestStart)
 	utils.DebugOutput(resp.Request.URL.String(), resp.StatusCode, startTime)
-	backoff := utils.NewExponentialBackoff(s.APIKey, s.Debug, 0, utils.UserAgent)
+	backoff := utils.NewExponentialBackoffWithSettings(s.Settings, s.Debug, 0, utils.UserAgent)
 
 	// // Read the response body
-	var data Response[TokenPricesResponse]
+	var data utils.Response[TokenPricesList]
 
-	if resp.StatusCode == 429 {
+	if resp.StatusCode == 429 && backoff.Enabled() {
 		res, err := backoff.BackOff(resp.Request.URL.String())
 		if err != nil {
 			errorCode := resp.StatusCode
 			errorMessage := err.Error()
-			return &Response[TokenPricesResponse]{Data: nil, Error: true, ErrorCode: &errorCode, ErrorMessage: &errorMessage}, err
+			return &utils.Response[TokenPricesList]{Data: nil, Error: true, ErrorCode: &errorCode, ErrorMessage: &errorMessage, Meta: meta.Finish()}, err
 		}
+		meta.Retried(res, 1+backoff.RetryCount)
 
-		if err := json.NewDecoder(res.Body).Decode(&data); err != nil {
+		if err := s.Settings.Decode(res.Body, &data, "GetTokenPrices"); err != nil {
 			res.Body.Close()
 			errorCode := 500
 			errorMessage := err.Error()
-			return &Response[TokenPricesResponse]{Data: nil, Error: true, ErrorCode: &errorCode, ErrorMessage: &errorMessage}, err
+			return &utils.Response[TokenPricesList]{Data: nil, Error: true, ErrorCode: &errorCode, ErrorMessage: &errorMessage, Meta: meta.Finish()}, err
 		}
 		res.Body.Close()
 	} else {
-		err = json.NewDecoder(resp.Body).Decode(&data)
+		err = s.Settings.Decode(resp.Body, &data, "GetTokenPrices")
 		if err != nil {
 			errorCode := 500
 			errorMessage := err.Error()
-			return &Response[TokenPricesResponse]{Data: nil, Error: true, ErrorCode: &errorCode, ErrorMessage: &errorMessage}, err
+			return &utils.Response[TokenPricesList]{Data: nil, Error: true, ErrorCode: &errorCode, ErrorMessage: &errorMessage, Meta: meta.Finish()}, err
 		}
 	}
 
 	if data.Error {
-		return &Response[TokenPricesResponse]{Data: data.Data, Error: data.Error, ErrorCode: data.ErrorCode, ErrorMessage: data.ErrorMessage}, fmt.Errorf(*data.ErrorMessage)
+		return &utils.Response[TokenPricesList]{Data: data.Data, Error: data.Error, ErrorCode: data.ErrorCode, ErrorMessage: data.ErrorMessage, Meta: meta.Finish()}, fmt.Errorf(*data.ErrorMessage)
 	}
 
-	return &Response[TokenPricesResponse]{Data: data.Data, Error: data.Error, ErrorCode: data.ErrorCode, ErrorMessage: data.ErrorMessage}, nil
+	return &utils.Response[TokenPricesList]{Data: data.Data, Error: data.Error, ErrorCode: data.ErrorCode, ErrorMessage: data.ErrorMessage, Meta: meta.Finish()}, nil
 }
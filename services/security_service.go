@@ -1,7 +1,6 @@
 package services
 
 import (
-	"encoding/json"
 	"fmt"
 	"net/http"
 	"net/url"
@@ -133,9 +132,9 @@ type NftApprovalSpender struct {
 	Allowance *string `json:"allowance,omitempty"`
 }
 
-func NewSecurityServiceImpl(apiKey string, debug bool, threadCount int, isValidKey bool) SecurityService {
+func NewSecurityServiceImpl(apiKey string, debug bool, threadCount int, isValidKey bool, settings ...utils.RequestSettings) SecurityService {
 
-	return &securityServiceImpl{APIKey: apiKey, Debug: debug, ThreadCount: threadCount, IskeyValid: isValidKey}
+	return &securityServiceImpl{APIKey: apiKey, Debug: debug, ThreadCount: threadCount, IskeyValid: isValidKey, Settings: utils.NewRequestSettings(apiKey, settings...)}
 }
 
 type SecurityService interface {
@@ -158,11 +157,12 @@ type securityServiceImpl struct {
 	Debug       bool
 	ThreadCount int
 	IskeyValid  bool
+	Settings    utils.RequestSettings
 }
 
 func (s *securityServiceImpl) GetApprovals(chainName chains.Chain, walletAddress string) (*utils.Response[ApprovalsResponse], error) {
 
-	apiURL := fmt.Sprintf("https://api.covalenthq.com/v1/%v/approvals/%s/", chainName, walletAddress)
+	apiURL := fmt.Sprintf("%s/v1/%v/approvals/%s/", s.Settings.BaseURL, chainName, walletAddress)
 
 	if !s.IskeyValid {
 		errorCode := 401
@@ -181,10 +181,10 @@ func (s *securityServiceImpl) GetApprovals(chainName chains.Chain, walletAddress
 	parsedURL.RawQuery = params.Encode()
 
 	// Create an HTTP client
-	client := &http.Client{}
+	client := s.Settings.HTTPClient
 
 	// Create a GET request
-	req, err := http.NewRequest("GET", parsedURL.String(), nil)
+	req, err := http.NewRequestWithContext(s.Settings.Context, "GET", parsedURL.String(), nil)
 
 	if err != nil {
 		errorCode := 500
@@ -192,7 +192,14 @@ func (s *securityServiceImpl) GetApprovals(chainName chains.Chain, walletAddress
 		return &utils.Response[ApprovalsResponse]{Data: nil, Error: true, ErrorCode: &errorCode, ErrorMessage: &errorMessage}, err
 	}
 
-	req.Header.Set("Authorization", `Bearer `+s.APIKey)
+	apiKey, err := s.Settings.APIKey()
+	if err != nil {
+		errorCode := 401
+		errorMessage := err.Error()
+		return &utils.Response[ApprovalsResponse]{Data: nil, Error: true, ErrorCode: &errorCode, ErrorMessage: &errorMessage}, err
+	}
+
+	req.Header.Set("Authorization", `Bearer `+apiKey)
 	req.Header.Set("X-Requested-With", utils.UserAgent)
 
 	var startTime time.Time // Declares startTime, initially set to zero value of time.Time
@@ -202,6 +209,7 @@ func (s *securityServiceImpl) GetApprovals(chainName chains.Chain, walletAddress
 	}
 
 	// Perform the request
+	requestStart := time.Now()
 	resp, err := client.Do(req)
 	if err != nil {
 		// create a &Response that returns data: nil, error: true, errorCode: "Unknown Error Code", errorMessage: "Unknown Error"
@@ -210,46 +218,49 @@ func (s *securityServiceImpl) GetApprovals(chainName chains.Chain, walletAddress
 
 	defer resp.Body.Close()
 
+	s.Settings.Report(apiKey, resp.StatusCode)
+	meta := s.Settings.NewResponseMeta(resp, requestStart)
 	utils.DebugOutput(resp.Request.URL.String(), resp.StatusCode, startTime)
-	backoff := utils.NewExponentialBackoff(s.APIKey, s.Debug, 0, utils.UserAgent)
+	backoff := utils.NewExponentialBackoffWithSettings(s.Settings, s.Debug, 0, utils.UserAgent)
 
 	// // Read the response body
 	var data utils.Response[ApprovalsResponse]
 
-	if resp.StatusCode == 429 {
+	if resp.StatusCode == 429 && backoff.Enabled() {
 		res, err := backoff.BackOff(resp.Request.URL.String())
 		if err != nil {
 			errorCode := resp.StatusCode
 			errorMessage := err.Error()
-			return &utils.Response[ApprovalsResponse]{Data: nil, Error: true, ErrorCode: &errorCode, ErrorMessage: &errorMessage}, err
+			return &utils.Response[ApprovalsResponse]{Data: nil, Error: true, ErrorCode: &errorCode, ErrorMessage: &errorMessage, Meta: meta.Finish()}, err
 		}
+		meta.Retried(res, 1+backoff.RetryCount)
 
-		if err := json.NewDecoder(res.Body).Decode(&data); err != nil {
+		if err := s.Settings.Decode(res.Body, &data, "GetApprovals"); err != nil {
 			res.Body.Close()
 			errorCode := 500
 			errorMessage := err.Error()
-			return &utils.Response[ApprovalsResponse]{Data: nil, Error: true, ErrorCode: &errorCode, ErrorMessage: &errorMessage}, err
+			return &utils.Response[ApprovalsResponse]{Data: nil, Error: true, ErrorCode: &errorCode, ErrorMessage: &errorMessage, Meta: meta.Finish()}, err
 		}
 		res.Body.Close()
 	} else {
-		err = json.NewDecoder(resp.Body).Decode(&data)
+		err = s.Settings.Decode(resp.Body, &data, "GetApprovals")
 		if err != nil {
 			errorCode := 500
 			errorMessage := err.Error()
-			return &utils.Response[ApprovalsResponse]{Data: nil, Error: true, ErrorCode: &errorCode, ErrorMessage: &errorMessage}, err
+			return &utils.Response[ApprovalsResponse]{Data: nil, Error: true, ErrorCode: &errorCode, ErrorMessage: &errorMessage, Meta: meta.Finish()}, err
 		}
 	}
 
 	if data.Error {
-		return &utils.Response[ApprovalsResponse]{Data: data.Data, Error: data.Error, ErrorCode: data.ErrorCode, ErrorMessage: data.ErrorMessage}, fmt.Errorf(*data.ErrorMessage)
+		return &utils.Response[ApprovalsResponse]{Data: data.Data, Error: data.Error, ErrorCode: data.ErrorCode, ErrorMessage: data.ErrorMessage, Meta: meta.Finish()}, fmt.Errorf(*data.ErrorMessage)
 	}
 
-	return &utils.Response[ApprovalsResponse]{Data: data.Data, Error: data.Error, ErrorCode: data.ErrorCode, ErrorMessage: data.ErrorMessage}, nil
+	return &utils.Response[ApprovalsResponse]{Data: data.Data, Error: data.Error, ErrorCode: data.ErrorCode, ErrorMessage: data.ErrorMessage, Meta: meta.Finish()}, nil
 }
 
 func (s *securityServiceImpl) GetNftApprovals(chainName chains.Chain, walletAddress string) (*utils.Response[NftApprovalsResponse], error) {
 
-	apiURL := fmt.Sprintf("https://api.covalenthq.com/v1/%v/nft/approvals/%s/", chainName, walletAddress)
+	apiURL := fmt.Sprintf("%s/v1/%v/nft/approvals/%s/", s.Settings.BaseURL, chainName, walletAddress)
 
 	if !s.IskeyValid {
 		errorCode := 401
@@ -268,10 +279,10 @@ func (s *securityServiceImpl) GetNftApprovals(chainName chains.Chain, walletAddr
 	parsedURL.RawQuery = params.Encode()
 
 	// Create an HTTP client
-	client := &http.Client{}
+	client := s.Settings.HTTPClient
 
 	// Create a GET request
-	req, err := http.NewRequest("GET", parsedURL.String(), nil)
+	req, err := http.NewRequestWithContext(s.Settings.Context, "GET", parsedURL.String(), nil)
 
 	if err != nil {
 		errorCode := 500
@@ -279,7 +290,14 @@ func (s *securityServiceImpl) GetNftApprovals(chainName chains.Chain, walletAddr
 		return &utils.Response[NftApprovalsResponse]{Data: nil, Error: true, ErrorCode: &errorCode, ErrorMessage: &errorMessage}, err
 	}
 
-	req.Header.Set("Authorization", `Bearer `+s.APIKey)
+	apiKey, err := s.Settings.APIKey()
+	if err != nil {
+		errorCode := 401
+		errorMessage := err.Error()
+		return &utils.Response[NftApprovalsResponse]{Data: nil, Error: true, ErrorCode: &errorCode, ErrorMessage: &errorMessage}, err
+	}
+
+	req.Header.Set("Authorization", `Bearer `+apiKey)
 	req.Header.Set("X-Requested-With", utils.UserAgent)
 
 	var startTime time.Time // Declares startTime, initially set to zero value of time.Time
@@ -289,6 +307,7 @@ func (s *securityServiceImpl) GetNftApprovals(chainName chains.Chain, walletAddr
 	}
 
 	// Perform the request
+	requestStart := time.Now()
 	resp, err := client.Do(req)
 	if err != nil {
 		// create a &Response that returns data: nil, error: true, errorCode: "Unknown Error Code", errorMessage: "Unknown Error"
@@ -297,39 +316,42 @@ func (s *securityServiceImpl) GetNftApprovals(chainName chains.Chain, walletAddr
 
 	defer resp.Body.Close()
 
+	s.Settings.Report(apiKey, resp.StatusCode)
+	meta := s.Settings.NewResponseMeta(resp, requestStart)
 	utils.DebugOutput(resp.Request.URL.String(), resp.StatusCode, startTime)
-	backoff := utils.NewExponentialBackoff(s.APIKey, s.Debug, 0, utils.UserAgent)
+	backoff := utils.NewExponentialBackoffWithSettings(s.Settings, s.Debug, 0, utils.UserAgent)
 
 	// // Read the response body
 	var data utils.Response[NftApprovalsResponse]
 
-	if resp.StatusCode == 429 {
+	if resp.StatusCode == 429 && backoff.Enabled() {
 		res, err := backoff.BackOff(resp.Request.URL.String())
 		if err != nil {
 			errorCode := resp.StatusCode
 			errorMessage := err.Error()
-			return &utils.Response[NftApprovalsResponse]{Data: nil, Error: true, ErrorCode: &errorCode, ErrorMessage: &errorMessage}, err
+			return &utils.Response[NftApprovalsResponse]{Data: nil, Error: true, ErrorCode: &errorCode, ErrorMessage: &errorMessage, Meta: meta.Finish()}, err
 		}
+		meta.Retried(res, 1+backoff.RetryCount)
 
-		if err := json.NewDecoder(res.Body).Decode(&data); err != nil {
+		if err := s.Settings.Decode(res.Body, &data, "GetNftApprovals"); err != nil {
 			res.Body.Close()
 			errorCode := 500
 			errorMessage := err.Error()
-			return &utils.Response[NftApprovalsResponse]{Data: nil, Error: true, ErrorCode: &errorCode, ErrorMessage: &errorMessage}, err
+			return &utils.Response[NftApprovalsResponse]{Data: nil, Error: true, ErrorCode: &errorCode, ErrorMessage: &errorMessage, Meta: meta.Finish()}, err
 		}
 		res.Body.Close()
 	} else {
-		err = json.NewDecoder(resp.Body).Decode(&data)
+		err = s.Settings.Decode(resp.Body, &data, "GetNftApprovals")
 		if err != nil {
 			errorCode := 500
 			errorMessage := err.Error()
-			return &utils.Response[NftApprovalsResponse]{Data: nil, Error: true, ErrorCode: &errorCode, ErrorMessage: &errorMessage}, err
+			return &utils.Response[NftApprovalsResponse]{Data: nil, Error: true, ErrorCode: &errorCode, ErrorMessage: &errorMessage, Meta: meta.Finish()}, err
 		}
 	}
 
 	if data.Error {
-		return &utils.Response[NftApprovalsResponse]{Data: data.Data, Error: data.Error, ErrorCode: data.ErrorCode, ErrorMessage: data.ErrorMessage}, fmt.Errorf(*data.ErrorMessage)
+		return &utils.Response[NftApprovalsResponse]{Data: data.Data, Error: data.Error, ErrorCode: data.ErrorCode, ErrorMessage: data.ErrorMessage, Meta: meta.Finish()}, fmt.Errorf(*data.ErrorMessage)
 	}
 
-	return &utils.Response[NftApprovalsResponse]{Data: data.Data, Error: data.Error, ErrorCode: data.ErrorCode, ErrorMessage: data.ErrorMessage}, nil
+	return &utils.Response[NftApprovalsResponse]{Data: data.Data, Error: data.Error, ErrorCode: data.ErrorCode, ErrorMessage: data.ErrorMessage, Meta: meta.Finish()}, nil
 }
@@ -0,0 +1,108 @@
+package services
+
+import (
+	"github.com/covalenthq/covalent-api-sdk-go/genericmodels"
+	"github.com/covalenthq/covalent-api-sdk-go/utils"
+)
+
+// BalanceAmount returns Balance with ContractDecimals applied. Returns nil when either is missing.
+func (b *BalanceItem) BalanceAmount() *utils.TokenAmount {
+	return utils.TokenAmountOf(b.Balance, b.ContractDecimals)
+}
+
+// Balance24hAmount returns Balance24h with ContractDecimals applied. Returns nil when either is missing.
+func (b *BalanceItem) Balance24hAmount() *utils.TokenAmount {
+	return utils.TokenAmountOf(b.Balance24h, b.ContractDecimals)
+}
+
+// DeltaAmount returns Delta with ContractDecimals applied. Returns nil when either is missing.
+func (t *TokenTransferItem) DeltaAmount() *utils.TokenAmount {
+	return utils.TokenAmountOf(t.Delta, t.ContractDecimals)
+}
+
+// BalanceAmount returns Balance with ContractDecimals applied. Returns nil when either is missing.
+func (t *TokenTransferItem) BalanceAmount() *utils.TokenAmount {
+	return utils.TokenAmountOf(t.Balance, t.ContractDecimals)
+}
+
+// BalanceAmount returns Balance with ContractDecimals applied. Returns nil when either is missing.
+func (t *TokenHolder) BalanceAmount() *utils.TokenAmount {
+	return utils.TokenAmountOf(t.Balance, t.ContractDecimals)
+}
+
+// TotalSupplyAmount returns TotalSupply with ContractDecimals applied. Returns nil when either is missing.
+func (t *TokenHolder) TotalSupplyAmount() *utils.TokenAmount {
+	return utils.TokenAmountOf(t.TotalSupply, t.ContractDecimals)
+}
+
+// BalanceAmount returns Balance with ContractDecimals applied. Returns nil when either is missing.
+func (h *HistoricalBalanceItem) BalanceAmount() *utils.TokenAmount {
+	return utils.TokenAmountOf(h.Balance, h.ContractDecimals)
+}
+
+// BalanceAmount returns Balance with ContractDecimals applied. Returns nil when either is missing.
+func (n *NativeBalanceItem) BalanceAmount() *utils.TokenAmount {
+	return utils.TokenAmountOf(n.Balance, n.ContractDecimals)
+}
+
+// BalanceAmount returns Balance with ContractDecimals applied. Returns nil when either is missing.
+func (t *TokensApprovalItem) BalanceAmount() *utils.TokenAmount {
+	return utils.TokenAmountOf(t.Balance, t.ContractDecimals)
+}
+
+// BalanceAmount returns Balance with ContractDecimals applied. Returns nil when either is missing.
+func (u *UniswapLikeToken) BalanceAmount() *utils.TokenAmount {
+	return utils.TokenAmountOf(u.Balance, u.ContractDecimals)
+}
+
+// BalanceAmount returns Balance with ContractDecimals applied. Returns nil when either is missing.
+func (u *UniswapLikeTokenWithSupply) BalanceAmount() *utils.TokenAmount {
+	return utils.TokenAmountOf(u.Balance, u.ContractDecimals)
+}
+
+// TotalSupplyAmount returns TotalSupply with ContractDecimals applied. Returns nil when either is missing.
+func (u *UniswapLikeTokenWithSupply) TotalSupplyAmount() *utils.TokenAmount {
+	return utils.TokenAmountOf(u.TotalSupply, u.ContractDecimals)
+}
+
+// ValueAmount returns Value with the decimals of the gas token. Returns nil when either is missing.
+func (t *Transaction) ValueAmount() *utils.TokenAmount {
+	return utils.TokenAmountOf(t.Value, gasDecimals(t.GasMetadata))
+}
+
+// FeesPaidAmount returns FeesPaid with the decimals of the gas token. Returns nil when either is missing.
+func (t *Transaction) FeesPaidAmount() *utils.TokenAmount {
+	return utils.TokenAmountOf(t.FeesPaid, gasDecimals(t.GasMetadata))
+}
+
+// ValueAmount returns Value with the decimals of the gas token. Returns nil when either is missing.
+func (b *BlockTransactionWithContractTransfers) ValueAmount() *utils.TokenAmount {
+	return utils.TokenAmountOf(b.Value, gasDecimals(b.GasMetadata))
+}
+
+// FeesPaidAmount returns FeesPaid with the decimals of the gas token. Returns nil when either is missing.
+func (b *BlockTransactionWithContractTransfers) FeesPaidAmount() *utils.TokenAmount {
+	return utils.TokenAmountOf(b.FeesPaid, gasDecimals(b.GasMetadata))
+}
+
+// ValueAmount returns Value with the decimals of the gas token. Returns nil when either is missing.
+func (e *ExchangeTransaction) ValueAmount() *utils.TokenAmount {
+	return utils.TokenAmountOf(e.Value, gasDecimals(e.GasMetadata))
+}
+
+// FeesPaidAmount returns FeesPaid with the decimals of the gas token. Returns nil when either is missing.
+func (e *ExchangeTransaction) FeesPaidAmount() *utils.TokenAmount {
+	return utils.TokenAmountOf(e.FeesPaid, gasDecimals(e.GasMetadata))
+}
+
+// TotalFeesPaidAmount returns TotalFeesPaid with the decimals of the gas token. Returns nil when either is missing.
+func (g *GasSummary) TotalFeesPaidAmount() *utils.TokenAmount {
+	return utils.TokenAmountOf(g.TotalFeesPaid, gasDecimals(g.GasMetadata))
+}
+
+func gasDecimals(gasMetadata *genericmodels.ContractMetadata) *int {
+	if gasMetadata == nil {
+		return nil
+	}
+	return gasMetadata.ContractDecimals
+}
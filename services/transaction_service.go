@@ -1,7 +1,6 @@
 package services
 
 import (
-	"encoding/json"
 	"errors"
 	"fmt"
 	"net/http"
@@ -19,6 +18,7 @@ var clientKey string
 var debugOutput bool
 var workerCount int
 var isKeyValid bool
+var requestSettings utils.RequestSettings
 
 type TransactionResponse struct {
 	// The timestamp when the response was generated. Useful to show data staleness to users.
@@ -428,7 +428,7 @@ func (t *RecentTransactionsResponse) Prev() (*utils.Response[RecentTransactionsR
 	}
 
 	// Create an HTTP client
-	client := &http.Client{}
+	client := requestSettings.HTTPClient
 
 	parsedURL, err := url.Parse(*t.Links.Prev)
 	if err != nil {
@@ -436,14 +436,21 @@ func (t *RecentTransactionsResponse) Prev() (*utils.Response[RecentTransactionsR
 	}
 
 	// Create a GET request
-	req, err := http.NewRequest("GET", parsedURL.String(), nil)
+	req, err := http.NewRequestWithContext(requestSettings.Context, "GET", parsedURL.String(), nil)
 	if err != nil {
 		errorCode := 500
 		errorMessage := "Unknown Error"
 		return &utils.Response[RecentTransactionsResponse]{Data: nil, Error: true, ErrorCode: &errorCode, ErrorMessage: &errorMessage}, err
 	}
 
-	req.Header.Set("Authorization", `Bearer `+clientKey)
+	apiKey, err := requestSettings.APIKey()
+	if err != nil {
+		errorCode := 401
+		errorMessage := err.Error()
+		return &utils.Response[RecentTransactionsResponse]{Data: nil, Error: true, ErrorCode: &errorCode, ErrorMessage: &errorMessage}, err
+	}
+
+	req.Header.Set("Authorization", `Bearer `+apiKey)
 
 	var startTime time.Time // Declares startTime, initially set to zero value of time.Time
 
@@ -452,6 +459,7 @@ func (t *RecentTransactionsResponse) Prev() (*utils.Response[RecentTransactionsR
 	}
 
 	// Perform the request
+	requestStart := time.Now()
 	resp, err := client.Do(req)
 	if err != nil {
 		return nil, err
@@ -459,41 +467,44 @@ func (t *RecentTransactionsResponse) Prev() (*utils.Response[RecentTransactionsR
 
 	defer resp.Body.Close()
 
+	requestSettings.Report(apiKey, resp.StatusCode)
+	meta := requestSettings.NewResponseMeta(resp, requestStart)
 	utils.DebugOutput(resp.Request.URL.String(), resp.StatusCode, startTime)
-	backoff := utils.NewExponentialBackoff(clientKey, debugOutput, 0, utils.UserAgent)
+	backoff := utils.NewExponentialBackoffWithSettings(requestSettings, debugOutput, 0, utils.UserAgent)
 
 	// // Read the response body
 	var data utils.Response[RecentTransactionsResponse]
 
-	if resp.StatusCode == 429 {
+	if resp.StatusCode == 429 && backoff.Enabled() {
 		res, err := backoff.BackOff(resp.Request.URL.String())
 		if err != nil {
 			errorCode := resp.StatusCode
 			errorMessage := err.Error()
-			return &utils.Response[RecentTransactionsResponse]{Data: nil, Error: true, ErrorCode: &errorCode, ErrorMessage: &errorMessage}, err
+			return &utils.Response[RecentTransactionsResponse]{Data: nil, Error: true, ErrorCode: &errorCode, ErrorMessage: &errorMessage, Meta: meta.Finish()}, err
 		}
+		meta.Retried(res, 1+backoff.RetryCount)
 
-		if err := json.NewDecoder(res.Body).Decode(&data); err != nil {
+		if err := requestSettings.Decode(res.Body, &data, "RecentTransactionsResponse.Prev"); err != nil {
 			res.Body.Close()
 			errorCode := 500
 			errorMessage := err.Error()
-			return &utils.Response[RecentTransactionsResponse]{Data: nil, Error: true, ErrorCode: &errorCode, ErrorMessage: &errorMessage}, err
+			return &utils.Response[RecentTransactionsResponse]{Data: nil, Error: true, ErrorCode: &errorCode, ErrorMessage: &errorMessage, Meta: meta.Finish()}, err
 		}
 		res.Body.Close()
 	} else {
-		err = json.NewDecoder(resp.Body).Decode(&data)
+		err = requestSettings.Decode(resp.Body, &data, "RecentTransactionsResponse.Prev")
 		if err != nil {
 			errorCode := 500
 			errorMessage := err.Error()
-			return &utils.Response[RecentTransactionsResponse]{Data: nil, Error: true, ErrorCode: &errorCode, ErrorMessage: &errorMessage}, err
+			return &utils.Response[RecentTransactionsResponse]{Data: nil, Error: true, ErrorCode: &errorCode, ErrorMessage: &errorMessage, Meta: meta.Finish()}, err
 		}
 	}
 
 	if data.Error {
-		return &utils.Response[RecentTransactionsResponse]{Data: data.Data, Error: data.Error, ErrorCode: data.ErrorCode, ErrorMessage: data.ErrorMessage}, fmt.Errorf(*data.ErrorMessage)
+		return &utils.Response[RecentTransactionsResponse]{Data: data.Data, Error: data.Error, ErrorCode: data.ErrorCode, ErrorMessage: data.ErrorMessage, Meta: meta.Finish()}, fmt.Errorf(*data.ErrorMessage)
 	}
 
-	return &utils.Response[RecentTransactionsResponse]{Data: data.Data, Error: data.Error, ErrorCode: data.ErrorCode, ErrorMessage: data.ErrorMessage}, nil
+	return &utils.Response[RecentTransactionsResponse]{Data: data.Data, Error: data.Error, ErrorCode: data.ErrorCode, ErrorMessage: data.ErrorMessage, Meta: meta.Finish()}, nil
 
 }
 
@@ -512,7 +523,7 @@ func (t *RecentTransactionsResponse) Next() (*utils.Response[RecentTransactionsR
 	}
 
 	// Create an HTTP client
-	client := &http.Client{}
+	client := requestSettings.HTTPClient
 
 	parsedURL, err := url.Parse(*t.Links.Next)
 	if err != nil {
@@ -520,14 +531,21 @@ func (t *RecentTransactionsResponse) Next() (*utils.Response[RecentTransactionsR
 	}
 
 	// Create a GET request
-	req, err := http.NewRequest("GET", parsedURL.String(), nil)
+	req, err := http.NewRequestWithContext(requestSettings.Context, "GET", parsedURL.String(), nil)
 	if err != nil {
 		errorCode := 500
 		errorMessage := "Unknown Error"
 		return &utils.Response[RecentTransactionsResponse]{Data: nil, Error: true, ErrorCode: &errorCode, ErrorMessage: &errorMessage}, err
 	}
 
-	req.Header.Set("Authorization", `Bearer `+clientKey)
+	apiKey, err := requestSettings.APIKey()
+	if err != nil {
+		errorCode := 401
+		errorMessage := err.Error()
+		return &utils.Response[RecentTransactionsResponse]{Data: nil, Error: true, ErrorCode: &errorCode, ErrorMessage: &errorMessage}, err
+	}
+
+	req.Header.Set("Authorization", `Bearer `+apiKey)
 
 	var startTime time.Time // Declares startTime, initially set to zero value of time.Time
 
@@ -536,6 +554,7 @@ func (t *RecentTransactionsResponse) Next() (*utils.Response[RecentTransactionsR
 	}
 
 	// Perform the request
+	requestStart := time.Now()
 	resp, err := client.Do(req)
 	if err != nil {
 		return nil, err
@@ -543,41 +562,44 @@ func (t *RecentTransactionsResponse) Next() (*utils.Response[RecentTransactionsR
 
 	defer resp.Body.Close()
 
+	requestSettings.Report(apiKey, resp.StatusCode)
+	meta := requestSettings.NewResponseMeta(resp, requestStart)
 	utils.DebugOutput(resp.Request.URL.String(), resp.StatusCode, startTime)
-	backoff := utils.NewExponentialBackoff(clientKey, debugOutput, 0, utils.UserAgent)
+	backoff := utils.NewExponentialBackoffWithSettings(requestSettings, debugOutput, 0, utils.UserAgent)
 
 	// // Read the response body
 	var data utils.Response[RecentTransactionsResponse]
 
-	if resp.StatusCode == 429 {
+	if resp.StatusCode == 429 && backoff.Enabled() {
 		res, err := backoff.BackOff(resp.Request.URL.String())
 		if err != nil {
 			errorCode := resp.StatusCode
 			errorMessage := err.Error()
-			return &utils.Response[RecentTransactionsResponse]{Data: nil, Error: true, ErrorCode: &errorCode, ErrorMessage: &errorMessage}, err
+			return &utils.Response[RecentTransactionsResponse]{Data: nil, Error: true, ErrorCode: &errorCode, ErrorMessage: &errorMessage, Meta: meta.Finish()}, err
 		}
+		meta.Retried(res, 1+backoff.RetryCount)
 
-		if err := json.NewDecoder(res.Body).Decode(&data); err != nil {
+		if err := requestSettings.Decode(res.Body, &data, "RecentTransactionsResponse.Next"); err != nil {
 			res.Body.Close()
 			errorCode := 500
 			errorMessage := err.Error()
-			return &utils.Response[RecentTransactionsResponse]{Data: nil, Error: true, ErrorCode: &errorCode, ErrorMessage: &errorMessage}, err
+			return &utils.Response[RecentTransactionsResponse]{Data: nil, Error: true, ErrorCode: &errorCode, ErrorMessage: &errorMessage, Meta: meta.Finish()}, err
 		}
 		res.Body.Close()
 	} else {
-		err = json.NewDecoder(resp.Body).Decode(&data)
+		err = requestSettings.Decode(resp.Body, &data, "RecentTransactionsResponse.Next")
 		if err != nil {
 			errorCode := 500
 			errorMessage := err.Error()
-			return &utils.Response[RecentTransactionsResponse]{Data: nil, Error: true, ErrorCode: &errorCode, ErrorMessage: &errorMessage}, err
+			return &utils.Response[RecentTransactionsResponse]{Data: nil, Error: true, ErrorCode: &errorCode, ErrorMessage: &errorMessage, Meta: meta.Finish()}, err
 		}
 	}
 
 	if data.Error {
-		return &utils.Response[RecentTransactionsResponse]{Data: data.Data, Error: data.Error, ErrorCode: data.ErrorCode, ErrorMessage: data.ErrorMessage}, fmt.Errorf(*data.ErrorMessage)
+		return &utils.Response[RecentTransactionsResponse]{Data: data.Data, Error: data.Error, ErrorCode: data.ErrorCode, ErrorMessage: data.ErrorMessage, Meta: meta.Finish()}, fmt.Errorf(*data.ErrorMessage)
 	}
 
-	return &utils.Response[RecentTransactionsResponse]{Data: data.Data, Error: data.Error, ErrorCode: data.ErrorCode, ErrorMessage: data.ErrorMessage}, nil
+	return &utils.Response[RecentTransactionsResponse]{Data: data.Data, Error: data.Error, ErrorCode: data.ErrorCode, ErrorMessage: data.ErrorMessage, Meta: meta.Finish()}, nil
 
 }
 
@@ -596,7 +618,7 @@ func (t *TransactionsResponse) Prev() (*utils.Response[TransactionsResponse], er
 	}
 
 	// Create an HTTP client
-	client := &http.Client{}
+	client := requestSettings.HTTPClient
 
 	parsedURL, err := url.Parse(*t.Links.Prev)
 	if err != nil {
@@ -604,14 +626,21 @@ func (t *TransactionsResponse) Prev() (*utils.Response[TransactionsResponse], er
 	}
 
 	// Create a GET request
-	req, err := http.NewRequest("GET", parsedURL.String(), nil)
+	req, err := http.NewRequestWithContext(requestSettings.Context, "GET", parsedURL.String(), nil)
 	if err != nil {
 		errorCode := 500
 		errorMessage := "Unknown Error"
 		return &utils.Response[TransactionsResponse]{Data: nil, Error: true, ErrorCode: &errorCode, ErrorMessage: &errorMessage}, err
 	}
 
-	req.Header.Set("Authorization", `Bearer `+clientKey)
+	apiKey, err := requestSettings.APIKey()
+	if err != nil {
+		errorCode := 401
+		errorMessage := err.Error()
+		return &utils.Response[TransactionsResponse]{Data: nil, Error: true, ErrorCode: &errorCode, ErrorMessage: &errorMessage}, err
+	}
+
+	req.Header.Set("Authorization", `Bearer `+apiKey)
 
 	var startTime time.Time // Declares startTime, initially set to zero value of time.Time
 
@@ -620,6 +649,7 @@ func (t *TransactionsResponse) Prev() (*utils.Response[TransactionsResponse], er
 	}
 
 	// Perform the request
+	requestStart := time.Now()
 	resp, err := client.Do(req)
 	if err != nil {
 		return nil, err
@@ -627,41 +657,44 @@ func (t *TransactionsResponse) Prev() (*utils.Response[TransactionsResponse], er
 
 	defer resp.Body.Close()
 
+	requestSettings.Report(apiKey, resp.StatusCode)
+	meta := requestSettings.NewResponseMeta(resp, requestStart)
 	utils.DebugOutput(resp.Request.URL.String(), resp.StatusCode, startTime)
-	backoff := utils.NewExponentialBackoff(clientKey, debugOutput, 0, utils.UserAgent)
+	backoff := utils.NewExponentialBackoffWithSettings(requestSettings, debugOutput, 0, utils.UserAgent)
 
 	// // Read the response body
 	var data utils.Response[TransactionsResponse]
 
-	if resp.StatusCode == 429 {
+	if resp.StatusCode == 429 && backoff.Enabled() {
 		res, err := backoff.BackOff(resp.Request.URL.String())
 		if err != nil {
 			errorCode := resp.StatusCode
 			errorMessage := err.Error()
-			return &utils.Response[TransactionsResponse]{Data: nil, Error: true, ErrorCode: &errorCode, ErrorMessage: &errorMessage}, err
+			return &utils.Response[TransactionsResponse]{Data: nil, Error: true, ErrorCode: &errorCode, ErrorMessage: &errorMessage, Meta: meta.Finish()}, err
 		}
+		meta.Retried(res, 1+backoff.RetryCount)
 
-		if err := json.NewDecoder(res.Body).Decode(&data); err != nil {
+		if err := requestSettings.Decode(res.Body, &data, "TransactionsResponse.Prev"); err != nil {
 			res.Body.Close()
 			errorCode := 500
 			errorMessage := err.Error()
-			return &utils.Response[TransactionsResponse]{Data: nil, Error: true, ErrorCode: &errorCode, ErrorMessage: &errorMessage}, err
+			return &utils.Response[TransactionsResponse]{Data: nil, Error: true, ErrorCode: &errorCode, ErrorMessage: &errorMessage, Meta: meta.Finish()}, err
 		}
 		res.Body.Close()
 	} else {
-		err = json.NewDecoder(resp.Body).Decode(&data)
+		err = requestSettings.Decode(resp.Body, &data, "TransactionsResponse.Prev")
 		if err != nil {
 			errorCode := 500
 			errorMessage := err.Error()
-			return &utils.Response[TransactionsResponse]{Data: nil, Error: true, ErrorCode: &errorCode, ErrorMessage: &errorMessage}, err
+			return &utils.Response[TransactionsResponse]{Data: nil, Error: true, ErrorCode: &errorCode, ErrorMessage: &errorMessage, Meta: meta.Finish()}, err
 		}
 	}
 
 	if data.Error {
-		return &utils.Response[TransactionsResponse]{Data: data.Data, Error: data.Error, ErrorCode: data.ErrorCode, ErrorMessage: data.ErrorMessage}, fmt.Errorf(*data.ErrorMessage)
+		return &utils.Response[TransactionsResponse]{Data: data.Data, Error: data.Error, ErrorCode: data.ErrorCode, ErrorMessage: data.ErrorMessage, Meta: meta.Finish()}, fmt.Errorf(*data.ErrorMessage)
 	}
 
-	return &utils.Response[TransactionsResponse]{Data: data.Data, Error: data.Error, ErrorCode: data.ErrorCode, ErrorMessage: data.ErrorMessage}, nil
+	return &utils.Response[TransactionsResponse]{Data: data.Data, Error: data.Error, ErrorCode: data.ErrorCode, ErrorMessage: data.ErrorMessage, Meta: meta.Finish()}, nil
 
 }
 
@@ -680,7 +713,7 @@ func (t *TransactionsResponse) Next() (*utils.Response[TransactionsResponse], er
 	}
 
 	// Create an HTTP client
-	client := &http.Client{}
+	client := requestSettings.HTTPClient
 
 	parsedURL, err := url.Parse(*t.Links.Next)
 	if err != nil {
@@ -688,14 +721,21 @@ func (t *TransactionsResponse) Next() (*utils.Response[TransactionsResponse], er
 	}
 
 	// Create a GET request
-	req, err := http.NewRequest("GET", parsedURL.String(), nil)
+	req, err := http.NewRequestWithContext(requestSettings.Context, "GET", parsedURL.String(), nil)
 	if err != nil {
 		errorCode := 500
 		errorMessage := "Unknown Error"
 		return &utils.Response[TransactionsResponse]{Data: nil, Error: true, ErrorCode: &errorCode, ErrorMessage: &errorMessage}, err
 	}
 
-	req.Header.Set("Authorization", `Bearer `+clientKey)
+	apiKey, err := requestSettings.APIKey()
+	if err != nil {
+		errorCode := 401
+		errorMessage := err.Error()
+		return &utils.Response[TransactionsResponse]{Data: nil, Error: true, ErrorCode: &errorCode, ErrorMessage: &errorMessage}, err
+	}
+
+	req.Header.Set("Authorization", `Bearer `+apiKey)
 
 	var startTime time.Time // Declares startTime, initially set to zero value of time.Time
 
@@ -704,6 +744,7 @@ func (t *TransactionsResponse) Next() (*utils.Response[TransactionsResponse], er
 	}
 
 	// Perform the request
+	requestStart := time.Now()
 	resp, err := client.Do(req)
 	if err != nil {
 		return nil, err
@@ -711,41 +752,44 @@ func (t *TransactionsResponse) Next() (*utils.Response[TransactionsResponse], er
 
 	defer resp.Body.Close()
 
+	requestSettings.Report(apiKey, resp.StatusCode)
+	meta := requestSettings.NewResponseMeta(resp, requestStart)
 	utils.DebugOutput(resp.Request.URL.String(), resp.StatusCode, startTime)
-	backoff := utils.NewExponentialBackoff(clientKey, debugOutput, 0, utils.UserAgent)
+	backoff := utils.NewExponentialBackoffWithSettings(requestSettings, debugOutput, 0, utils.UserAgent)
 
 	// // Read the response body
 	var data utils.Response[TransactionsResponse]
 
-	if resp.StatusCode == 429 {
+	if resp.StatusCode == 429 && backoff.Enabled() {
 		res, err := backoff.BackOff(resp.Request.URL.String())
 		if err != nil {
 			errorCode := resp.StatusCode
 			errorMessage := err.Error()
-			return &utils.Response[TransactionsResponse]{Data: nil, Error: true, ErrorCode: &errorCode, ErrorMessage: &errorMessage}, err
+			return &utils.Response[TransactionsResponse]{Data: nil, Error: true, ErrorCode: &errorCode, ErrorMessage: &errorMessage, Meta: meta.Finish()}, err
 		}
+		meta.Retried(res, 1+backoff.RetryCount)
 
-		if err := json.NewDecoder(res.Body).Decode(&data); err != nil {
+		if err := requestSettings.Decode(res.Body, &data, "TransactionsResponse.Next"); err != nil {
 			res.Body.Close()
 			errorCode := 500
 			errorMessage := err.Error()
-			return &utils.Response[TransactionsResponse]{Data: nil, Error: true, ErrorCode: &errorCode, ErrorMessage: &errorMessage}, err
+			return &utils.Response[TransactionsResponse]{Data: nil, Error: true, ErrorCode: &errorCode, ErrorMessage: &errorMessage, Meta: meta.Finish()}, err
 		}
 		res.Body.Close()
 	} else {
-		err = json.NewDecoder(resp.Body).Decode(&data)
+		err = requestSettings.Decode(resp.Body, &data, "TransactionsResponse.Next")
 		if err != nil {
 			errorCode := 500
 			errorMessage := err.Error()
-			return &utils.Response[TransactionsResponse]{Data: nil, Error: true, ErrorCode: &errorCode, ErrorMessage: &errorMessage}, err
+			return &utils.Response[TransactionsResponse]{Data: nil, Error: true, ErrorCode: &errorCode, ErrorMessage: &errorMessage, Meta: meta.Finish()}, err
 		}
 	}
 
 	if data.Error {
-		return &utils.Response[TransactionsResponse]{Data: data.Data, Error: data.Error, ErrorCode: data.ErrorCode, ErrorMessage: data.ErrorMessage}, fmt.Errorf(*data.ErrorMessage)
+		return &utils.Response[TransactionsResponse]{Data: data.Data, Error: data.Error, ErrorCode: data.ErrorCode, ErrorMessage: data.ErrorMessage, Meta: meta.Finish()}, fmt.Errorf(*data.ErrorMessage)
 	}
 
-	return &utils.Response[TransactionsResponse]{Data: data.Data, Error: data.Error, ErrorCode: data.ErrorCode, ErrorMessage: data.ErrorMessage}, nil
+	return &utils.Response[TransactionsResponse]{Data: data.Data, Error: data.Error, ErrorCode: data.ErrorCode, ErrorMessage: data.ErrorMessage, Meta: meta.Finish()}, nil
 
 }
 
@@ -764,7 +808,7 @@ func (t *TransactionsTimeBucketResponse) Prev() (*utils.Response[TransactionsTim
 	}
 
 	// Create an HTTP client
-	client := &http.Client{}
+	client := requestSettings.HTTPClient
 
 	parsedURL, err := url.Parse(*t.Links.Prev)
 	if err != nil {
@@ -772,14 +816,21 @@ func (t *TransactionsTimeBucketResponse) Prev() (*utils.Response[TransactionsTim
 	}
 
 	// Create a GET request
-	req, err := http.NewRequest("GET", parsedURL.String(), nil)
+	req, err := http.NewRequestWithContext(requestSettings.Context, "GET", parsedURL.String(), nil)
 	if err != nil {
 		errorCode := 500
 		errorMessage := "Unknown Error"
 		return &utils.Response[TransactionsTimeBucketResponse]{Data: nil, Error: true, ErrorCode: &errorCode, ErrorMessage: &errorMessage}, err
 	}
 
-	req.Header.Set("Authorization", `Bearer `+clientKey)
+	apiKey, err := requestSettings.APIKey()
+	if err != nil {
+		errorCode := 401
+		errorMessage := err.Error()
+		return &utils.Response[TransactionsTimeBucketResponse]{Data: nil, Error: true, ErrorCode: &errorCode, ErrorMessage: &errorMessage}, err
+	}
+
+	req.Header.Set("Authorization", `Bearer `+apiKey)
 
 	var startTime time.Time // Declares startTime, initially set to zero value of time.Time
 
@@ -788,6 +839,7 @@ func (t *TransactionsTimeBucketResponse) Prev() (*utils.Response[TransactionsTim
 	}
 
 	// Perform the request
+	requestStart := time.Now()
 	resp, err := client.Do(req)
 	if err != nil {
 		return nil, err
@@ -795,41 +847,44 @@ func (t *TransactionsTimeBucketResponse) Prev() (*utils.Response[TransactionsTim
 
 	defer resp.Body.Close()
 
+	requestSettings.Report(apiKey, resp.StatusCode)
+	meta := requestSettings.NewResponseMeta(resp, requestStart)
 	utils.DebugOutput(resp.Request.URL.String(), resp.StatusCode, startTime)
-	backoff := utils.NewExponentialBackoff(clientKey, debugOutput, 0, utils.UserAgent)
+	backoff := utils.NewExponentialBackoffWithSettings(requestSettings, debugOutput, 0, utils.UserAgent)
 
 	// // Read the response body
 	var data utils.Response[TransactionsTimeBucketResponse]
 
-	if resp.StatusCode == 429 {
+	if resp.StatusCode == 429 && backoff.Enabled() {
 		res, err := backoff.BackOff(resp.Request.URL.String())
 		if err != nil {
 			errorCode := resp.StatusCode
 			errorMessage := err.Error()
-			return &utils.Response[TransactionsTimeBucketResponse]{Data: nil, Error: true, ErrorCode: &errorCode, ErrorMessage: &errorMessage}, err
+			return &utils.Response[TransactionsTimeBucketResponse]{Data: nil, Error: true, ErrorCode: &errorCode, ErrorMessage: &errorMessage, Meta: meta.Finish()}, err
 		}
+		meta.Retried(res, 1+backoff.RetryCount)
 
-		if err := json.NewDecoder(res.Body).Decode(&data); err != nil {
+		if err := requestSettings.Decode(res.Body, &data, "TransactionsTimeBucketResponse.Prev"); err != nil {
 			res.Body.Close()
 			errorCode := 500
 			errorMessage := err.Error()
-			return &utils.Response[TransactionsTimeBucketResponse]{Data: nil, Error: true, ErrorCode: &errorCode, ErrorMessage: &errorMessage}, err
+			return &utils.Response[TransactionsTimeBucketResponse]{Data: nil, Error: true, ErrorCode: &errorCode, ErrorMessage: &errorMessage, Meta: meta.Finish()}, err
 		}
 		res.Body.Close()
 	} else {
-		err = json.NewDecoder(resp.Body).Decode(&data)
+		err = requestSettings.Decode(resp.Body, &data, "TransactionsTimeBucketResponse.Prev")
 		if err != nil {
 			errorCode := 500
 			errorMessage := err.Error()
-			return &utils.Response[TransactionsTimeBucketResponse]{Data: nil, Error: true, ErrorCode: &errorCode, ErrorMessage: &errorMessage}, err
+			return &utils.Response[TransactionsTimeBucketResponse]{Data: nil, Error: true, ErrorCode: &errorCode, ErrorMessage: &errorMessage, Meta: meta.Finish()}, err
 		}
 	}
 
 	if data.Error {
-		return &utils.Response[TransactionsTimeBucketResponse]{Data: data.Data, Error: data.Error, ErrorCode: data.ErrorCode, ErrorMessage: data.ErrorMessage}, fmt.Errorf(*data.ErrorMessage)
+		return &utils.Response[TransactionsTimeBucketResponse]{Data: data.Data, Error: data.Error, ErrorCode: data.ErrorCode, ErrorMessage: data.ErrorMessage, Meta: meta.Finish()}, fmt.Errorf(*data.ErrorMessage)
 	}
 
-	return &utils.Response[TransactionsTimeBucketResponse]{Data: data.Data, Error: data.Error, ErrorCode: data.ErrorCode, ErrorMessage: data.ErrorMessage}, nil
+	return &utils.Response[TransactionsTimeBucketResponse]{Data: data.Data, Error: data.Error, ErrorCode: data.ErrorCode, ErrorMessage: data.ErrorMessage, Meta: meta.Finish()}, nil
 
 }
 
@@ -848,7 +903,7 @@ func (t *TransactionsTimeBucketResponse) Next() (*utils.Response[TransactionsTim
 	}
 
 	// Create an HTTP client
-	client := &http.Client{}
+	client := requestSettings.HTTPClient
 
 	parsedURL, err := url.Parse(*t.Links.Next)
 	if err != nil {
@@ -856,14 +911,21 @@ func (t *TransactionsTimeBucketResponse) Next() (*utils.Response[TransactionsTim
 	}
 
 	// Create a GET request
-	req, err := http.NewRequest("GET", parsedURL.String(), nil)
+	req, err := http.NewRequestWithContext(requestSettings.Context, "GET", parsedURL.String(), nil)
 	if err != nil {
 		errorCode := 500
 		errorMessage := "Unknown Error"
 		return &utils.Response[TransactionsTimeBucketResponse]{Data: nil, Error: true, ErrorCode: &errorCode, ErrorMessage: &errorMessage}, err
 	}
 
-	req.Header.Set("Authorization", `Bearer `+clientKey)
+	apiKey, err := requestSettings.APIKey()
+	if err != nil {
+		errorCode := 401
+		errorMessage := err.Error()
+		return &utils.Response[TransactionsTimeBucketResponse]{Data: nil, Error: true, ErrorCode: &errorCode, ErrorMessage: &errorMessage}, err
+	}
+
+	req.Header.Set("Authorization", `Bearer `+apiKey)
 
 	var startTime time.Time // Declares startTime, initially set to zero value of time.Time
 
@@ -872,6 +934,7 @@ func (t *TransactionsTimeBucketResponse) Next() (*utils.Response[TransactionsTim
 	}
 
 	// Perform the request
+	requestStart := time.Now()
 	resp, err := client.Do(req)
 	if err != nil {
 		return nil, err
@@ -879,41 +942,44 @@ func (t *TransactionsTimeBucketResponse) Next() (*utils.Response[TransactionsTim
 
 	defer resp.Body.Close()
 
+	requestSettings.Report(apiKey, resp.StatusCode)
+	meta := requestSettings.NewResponseMeta(resp, requestStart)
 	utils.DebugOutput(resp.Request.URL.String(), resp.StatusCode, startTime)
-	backoff := utils.NewExponentialBackoff(clientKey, debugOutput, 0, utils.UserAgent)
+	backoff := utils.NewExponentialBackoffWithSettings(requestSettings, debugOutput, 0, utils.UserAgent)
 
 	// // Read the response body
 	var data utils.Response[TransactionsTimeBucketResponse]
 
-	if resp.StatusCode == 429 {
+	if resp.StatusCode == 429 && backoff.Enabled() {
 		res, err := backoff.BackOff(resp.Request.URL.String())
 		if err != nil {
 			errorCode := resp.StatusCode
 			errorMessage := err.Error()
-			return &utils.Response[TransactionsTimeBucketResponse]{Data: nil, Error: true, ErrorCode: &errorCode, ErrorMessage: &errorMessage}, err
+			return &utils.Response[TransactionsTimeBucketResponse]{Data: nil, Error: true, ErrorCode: &errorCode, ErrorMessage: &errorMessage, Meta: meta.Finish()}, err
 		}
+		meta.Retried(res, 1+backoff.RetryCount)
 
-		if err := json.NewDecoder(res.Body).Decode(&data); err != nil {
+		if err := requestSettings.Decode(res.Body, &data, "TransactionsTimeBucketResponse.Next"); err != nil {
 			res.Body.Close()
 			errorCode := 500
 			errorMessage := err.Error()
-			return &utils.Response[TransactionsTimeBucketResponse]{Data: nil, Error: true, ErrorCode: &errorCode, ErrorMessage: &errorMessage}, err
+			return &utils.Response[TransactionsTimeBucketResponse]{Data: nil, Error: true, ErrorCode: &errorCode, ErrorMessage: &errorMessage, Meta: meta.Finish()}, err
 		}
 		res.Body.Close()
 	} else {
-		err = json.NewDecoder(resp.Body).Decode(&data)
+		err = requestSettings.Decode(resp.Body, &data, "TransactionsTimeBucketResponse.Next")
 		if err != nil {
 			errorCode := 500
 			errorMessage := err.Error()
-			return &utils.Response[TransactionsTimeBucketResponse]{Data: nil, Error: true, ErrorCode: &errorCode, ErrorMessage: &errorMessage}, err
+			return &utils.Response[TransactionsTimeBucketResponse]{Data: nil, Error: true, ErrorCode: &errorCode, ErrorMessage: &errorMessage, Meta: meta.Finish()}, err
 		}
 	}
 
 	if data.Error {
-		return &utils.Response[TransactionsTimeBucketResponse]{Data: data.Data, Error: data.Error, ErrorCode: data.ErrorCode, ErrorMessage: data.ErrorMessage}, fmt.Errorf(*data.ErrorMessage)
+		return &utils.Response[TransactionsTimeBucketResponse]{Data: data.Data, Error: data.Error, ErrorCode: data.ErrorCode, ErrorMessage: data.ErrorMessage, Meta: meta.Finish()}, fmt.Errorf(*data.ErrorMessage)
 	}
 
-	return &utils.Response[TransactionsTimeBucketResponse]{Data: data.Data, Error: data.Error, ErrorCode: data.ErrorCode, ErrorMessage: data.ErrorMessage}, nil
+	return &utils.Response[TransactionsTimeBucketResponse]{Data: data.Data, Error: data.Error, ErrorCode: data.ErrorCode, ErrorMessage: data.ErrorMessage, Meta: meta.Finish()}, nil
 
 }
 
@@ -932,7 +998,7 @@ func (t *TransactionsBlockPageResponse) Prev() (*utils.Response[TransactionsBloc
 	}
 
 	// Create an HTTP client
-	client := &http.Client{}
+	client := requestSettings.HTTPClient
 
 	parsedURL, err := url.Parse(*t.Links.Prev)
 	if err != nil {
@@ -940,14 +1006,21 @@ func (t *TransactionsBlockPageResponse) Prev() (*utils.Response[TransactionsBloc
 	}
 
 	// Create a GET request
-	req, err := http.NewRequest("GET", parsedURL.String(), nil)
+	req, err := http.NewRequestWithContext(requestSettings.Context, "GET", parsedURL.String(), nil)
 	if err != nil {
 		errorCode := 500
 		errorMessage := "Unknown Error"
 		return &utils.Response[TransactionsBlockPageResponse]{Data: nil, Error: true, ErrorCode: &errorCode, ErrorMessage: &errorMessage}, err
 	}
 
-	req.Header.Set("Authorization", `Bearer `+clientKey)
+	apiKey, err := requestSettings.APIKey()
+	if err != nil {
+		errorCode := 401
+		errorMessage := err.Error()
+		return &utils.Response[TransactionsBlockPageResponse]{Data: nil, Error: true, ErrorCode: &errorCode, ErrorMessage: &errorMessage}, err
+	}
+
+	req.Header.Set("Authorization", `Bearer `+apiKey)
 
 	var startTime time.Time // Declares startTime, initially set to zero value of time.Time
 
@@ -956,6 +1029,7 @@ func (t *TransactionsBlockPageResponse) Prev() (*utils.Response[TransactionsBloc
 	}
 
 	// Perform the request
+	requestStart := time.Now()
 	resp, err := client.Do(req)
 	if err != nil {
 		return nil, err
@@ -963,41 +1037,44 @@ func (t *TransactionsBlockPageResponse) Prev() (*utils.Response[TransactionsBloc
 
 	defer resp.Body.Close()
 
+	requestSettings.Report(apiKey, resp.StatusCode)
+	meta := requestSettings.NewResponseMeta(resp, requestStart)
 	utils.DebugOutput(resp.Request.URL.String(), resp.StatusCode, startTime)
-	backoff := utils.NewExponentialBackoff(clientKey, debugOutput, 0, utils.UserAgent)
+	backoff := utils.NewExponentialBackoffWithSettings(requestSettings, debugOutput, 0, utils.UserAgent)
 
 	// // Read the response body
 	var data utils.Response[TransactionsBlockPageResponse]
 
-	if resp.StatusCode == 429 {
+	if resp.StatusCode == 429 && backoff.Enabled() {
 		res, err := backoff.BackOff(resp.Request.URL.String())
 		if err != nil {
 			errorCode := resp.StatusCode
 			errorMessage := err.Error()
-			return &utils.Response[TransactionsBlockPageResponse]{Data: nil, Error: true, ErrorCode: &errorCode, ErrorMessage: &errorMessage}, err
+			return &utils.Response[TransactionsBlockPageResponse]{Data: nil, Error: true, ErrorCode: &errorCode, ErrorMessage: &errorMessage, Meta: meta.Finish()}, err
 		}
+		meta.Retried(res, 1+backoff.RetryCount)
 
-		if err := json.NewDecoder(res.Body).Decode(&data); err != nil {
+		if err := requestSettings.Decode(res.Body, &data, "TransactionsBlockPageResponse.Prev"); err != nil {
 			res.Body.Close()
 			errorCode := 500
 			errorMessage := err.Error()
-			return &utils.Response[TransactionsBlockPageResponse]{Data: nil, Error: true, ErrorCode: &errorCode, ErrorMessage: &errorMessage}, err
+			return &utils.Response[TransactionsBlockPageResponse]{Data: nil, Error: true, ErrorCode: &errorCode, ErrorMessage: &errorMessage, Meta: meta.Finish()}, err
 		}
 		res.Body.Close()
 	} else {
-		err = json.NewDecoder(resp.Body).Decode(&data)
+		err = requestSettings.Decode(resp.Body, &data, "TransactionsBlockPageResponse.Prev")
 		if err != nil {
 			errorCode := 500
 			errorMessage := err.Error()
-			return &utils.Response[TransactionsBlockPageResponse]{Data: nil, Error: true, ErrorCode: &errorCode, ErrorMessage: &errorMessage}, err
+			return &utils.Response[TransactionsBlockPageResponse]{Data: nil, Error: true, ErrorCode: &errorCode, ErrorMessage: &errorMessage, Meta: meta.Finish()}, err
 		}
 	}
 
 	if data.Error {
-		return &utils.Response[TransactionsBlockPageResponse]{Data: data.Data, Error: data.Error, ErrorCode: data.ErrorCode, ErrorMessage: data.ErrorMessage}, fmt.Errorf(*data.ErrorMessage)
+		return &utils.Response[TransactionsBlockPageResponse]{Data: data.Data, Error: data.Error, ErrorCode: data.ErrorCode, ErrorMessage: data.ErrorMessage, Meta: meta.Finish()}, fmt.Errorf(*data.ErrorMessage)
 	}
 
-	return &utils.Response[TransactionsBlockPageResponse]{Data: data.Data, Error: data.Error, ErrorCode: data.ErrorCode, ErrorMessage: data.ErrorMessage}, nil
+	return &utils.Response[TransactionsBlockPageResponse]{Data: data.Data, Error: data.Error, ErrorCode: data.ErrorCode, ErrorMessage: data.ErrorMessage, Meta: meta.Finish()}, nil
 
 }
 
@@ -1016,7 +1093,7 @@ func (t *TransactionsBlockPageResponse) Next() (*utils.Response[TransactionsBloc
 	}
 
 	// Create an HTTP client
-	client := &http.Client{}
+	client := requestSettings.HTTPClient
 
 	parsedURL, err := url.Parse(*t.Links.Next)
 	if err != nil {
@@ -1024,14 +1101,21 @@ func (t *TransactionsBlockPageResponse) Next() (*utils.Response[TransactionsBloc
 	}
 
 	// Create a GET request
-	req, err := http.NewRequest("GET", parsedURL.String(), nil)
+	req, err := http.NewRequestWithContext(requestSettings.Context, "GET", parsedURL.String(), nil)
 	if err != nil {
 		errorCode := 500
 		errorMessage := "Unknown Error"
 		return &utils.Response[TransactionsBlockPageResponse]{Data: nil, Error: true, ErrorCode: &errorCode, ErrorMessage: &errorMessage}, err
 	}
 
-	req.Header.Set("Authorization", `Bearer `+clientKey)
+	apiKey, err := requestSettings.APIKey()
+	if err != nil {
+		errorCode := 401
+		errorMessage := err.Error()
+		return &utils.Response[TransactionsBlockPageResponse]{Data: nil, Error: true, ErrorCode: &errorCode, ErrorMessage: &errorMessage}, err
+	}
+
+	req.Header.Set("Authorization", `Bearer `+apiKey)
 
 	var startTime time.Time // Declares startTime, initially set to zero value of time.Time
 
@@ -1040,6 +1124,7 @@ func (t *TransactionsBlockPageResponse) Next() (*utils.Response[TransactionsBloc
 	}
 
 	// Perform the request
+	requestStart := time.Now()
 	resp, err := client.Do(req)
 	if err != nil {
 		return nil, err
@@ -1047,41 +1132,44 @@ func (t *TransactionsBlockPageResponse) Next() (*utils.Response[TransactionsBloc
 
 	defer resp.Body.Close()
 
+	requestSettings.Report(apiKey, resp.StatusCode)
+	meta := requestSettings.NewResponseMeta(resp, requestStart)
 	utils.DebugOutput(resp.Request.URL.String(), resp.StatusCode, startTime)
-	backoff := utils.NewExponentialBackoff(clientKey, debugOutput, 0, utils.UserAgent)
+	backoff := utils.NewExponentialBackoffWithSettings(requestSettings, debugOutput, 0, utils.UserAgent)
 
 	// // Read the response body
 	var data utils.Response[TransactionsBlockPageResponse]
 
-	if resp.StatusCode == 429 {
+	if resp.StatusCode == 429 && backoff.Enabled() {
 		res, err := backoff.BackOff(resp.Request.URL.String())
 		if err != nil {
 			errorCode := resp.StatusCode
 			errorMessage := err.Error()
-			return &utils.Response[TransactionsBlockPageResponse]{Data: nil, Error: true, ErrorCode: &errorCode, ErrorMessage: &errorMessage}, err
+			return &utils.Response[TransactionsBlockPageResponse]{Data: nil, Error: true, ErrorCode: &errorCode, ErrorMessage: &errorMessage, Meta: meta.Finish()}, err
 		}
+		meta.Retried(res, 1+backoff.RetryCount)
 
-		if err := json.NewDecoder(res.Body).Decode(&data); err != nil {
+		if err := requestSettings.Decode(res.Body, &data, "TransactionsBlockPageResponse.Next"); err != nil {
 			res.Body.Close()
 			errorCode := 500
 			errorMessage := err.Error()
-			return &utils.Response[TransactionsBlockPageResponse]{Data: nil, Error: true, ErrorCode: &errorCode, ErrorMessage: &errorMessage}, err
+			return &utils.Response[TransactionsBlockPageResponse]{Data: nil, Error: true, ErrorCode: &errorCode, ErrorMessage: &errorMessage, Meta: meta.Finish()}, err
 		}
 		res.Body.Close()
 	} else {
-		err = json.NewDecoder(resp.Body).Decode(&data)
+		err = requestSettings.Decode(resp.Body, &data, "TransactionsBlockPageResponse.Next")
 		if err != nil {
 			errorCode := 500
 			errorMessage := err.Error()
-			return &utils.Response[TransactionsBlockPageResponse]{Data: nil, Error: true, ErrorCode: &errorCode, ErrorMessage: &errorMessage}, err
+			return &utils.Response[TransactionsBlockPageResponse]{Data: nil, Error: true, ErrorCode: &errorCode, ErrorMessage: &errorMessage, Meta: meta.Finish()}, err
 		}
 	}
 
 	if data.Error {
-		return &utils.Response[TransactionsBlockPageResponse]{Data: data.Data, Error: data.Error, ErrorCode: data.ErrorCode, ErrorMessage: data.ErrorMessage}, fmt.Errorf(*data.ErrorMessage)
+		return &utils.Response[TransactionsBlockPageResponse]{Data: data.Data, Error: data.Error, ErrorCode: data.ErrorCode, ErrorMessage: data.ErrorMessage, Meta: meta.Finish()}, fmt.Errorf(*data.ErrorMessage)
 	}
 
-	return &utils.Response[TransactionsBlockPageResponse]{Data: data.Data, Error: data.Error, ErrorCode: data.ErrorCode, ErrorMessage: data.ErrorMessage}, nil
+	return &utils.Response[TransactionsBlockPageResponse]{Data: data.Data, Error: data.Error, ErrorCode: data.ErrorCode, ErrorMessage: data.ErrorMessage, Meta: meta.Finish()}, nil
 
 }
 
@@ -1182,14 +1270,15 @@ type GetTransactionSummaryQueryParamOpts struct {
 	WithGas *bool `json:"withGas,omitempty"`
 }
 
-func NewTransactionServiceImpl(apiKey string, debug bool, threadCount int, isValidKey bool) TransactionService {
+func NewTransactionServiceImpl(apiKey string, debug bool, threadCount int, isValidKey bool, settings ...utils.RequestSettings) TransactionService {
 
 	clientKey = apiKey
 	debugOutput = debug
 	workerCount = threadCount
 	isKeyValid = isValidKey
+	requestSettings = utils.NewRequestSettings(apiKey, settings...)
 
-	return &transactionServiceImpl{APIKey: apiKey, Debug: debug, ThreadCount: threadCount, IskeyValid: isValidKey}
+	return &transactionServiceImpl{APIKey: apiKey, Debug: debug, ThreadCount: threadCount, IskeyValid: isValidKey, Settings: requestSettings}
 }
 
 type TransactionService interface {
@@ -1257,11 +1346,12 @@ type transactionServiceImpl struct {
 	Debug       bool
 	ThreadCount int
 	IskeyValid  bool
+	Settings    utils.RequestSettings
 }
 
 func (s *transactionServiceImpl) GetTransaction(chainName chains.Chain, txHash string, queryParamOpts ...GetTransactionQueryParamOpts) (*utils.Response[TransactionResponse], error) {
 
-	apiURL := fmt.Sprintf("https://api.covalenthq.com/v1/%v/transaction_v2/%s/", chainName, txHash)
+	apiURL := fmt.Sprintf("%s/v1/%v/transaction_v2/%s/", s.Settings.BaseURL, chainName, txHash)
 
 	if !s.IskeyValid {
 		errorCode := 401
@@ -1308,10 +1398,10 @@ func (s *transactionServiceImpl) GetTransaction(chainName chains.Chain, txHash s
 	parsedURL.RawQuery = params.Encode()
 
 	// Create an HTTP client
-	client := &http.Client{}
+	client := s.Settings.HTTPClient
 
 	// Create a GET request
-	req, err := http.NewRequest("GET", parsedURL.String(), nil)
+	req, err := http.NewRequestWithContext(s.Settings.Context, "GET", parsedURL.String(), nil)
 
 	if err != nil {
 		errorCode := 500
@@ -1319,7 +1409,14 @@ func (s *transactionServiceImpl) GetTransaction(chainName chains.Chain, txHash s
 		return &utils.Response[TransactionResponse]{Data: nil, Error: true, ErrorCode: &errorCode, ErrorMessage: &errorMessage}, err
 	}
 
-	req.Header.Set("Authorization", `Bearer `+s.APIKey)
+	apiKey, err := s.Settings.APIKey()
+	if err != nil {
+		errorCode := 401
+		errorMessage := err.Error()
+		return &utils.Response[TransactionResponse]{Data: nil, Error: true, ErrorCode: &errorCode, ErrorMessage: &errorMessage}, err
+	}
+
+	req.Header.Set("Authorization", `Bearer `+apiKey)
 	req.Header.Set("X-Requested-With", utils.UserAgent)
 
 	var startTime time.Time // Declares startTime, initially set to zero value of time.Time
@@ -1329,6 +1426,7 @@ func (s *transactionServiceImpl) GetTransaction(chainName chains.Chain, txHash s
 	}
 
 	// Perform the request
+	requestStart := time.Now()
 	resp, err := client.Do(req)
 	if err != nil {
 		// create a &Response that returns data: nil, error: true, errorCode: "Unknown Error Code", errorMessage: "Unknown Error"
@@ -1337,41 +1435,44 @@ func (s *transactionServiceImpl) GetTransaction(chainName chains.Chain, txHash s
 
 	defer resp.Body.Close()
 
+	s.Settings.Report(apiKey, resp.StatusCode)
+	meta := s.Settings.NewResponseMeta(resp, requestStart)
 	utils.DebugOutput(resp.Request.URL.String(), resp.StatusCode, startTime)
-	backoff := utils.NewExponentialBackoff(s.APIKey, s.Debug, 0, utils.UserAgent)
+	backoff := utils.NewExponentialBackoffWithSettings(s.Settings, s.Debug, 0, utils.UserAgent)
 
 	// // Read the response body
 	var data utils.Response[TransactionResponse]
 
-	if resp.StatusCode == 429 {
+	if resp.StatusCode == 429 && backoff.Enabled() {
 		res, err := backoff.BackOff(resp.Request.URL.String())
 		if err != nil {
 			errorCode := resp.StatusCode
 			errorMessage := err.Error()
-			return &utils.Response[TransactionResponse]{Data: nil, Error: true, ErrorCode: &errorCode, ErrorMessage: &errorMessage}, err
+			return &utils.Response[TransactionResponse]{Data: nil, Error: true, ErrorCode: &errorCode, ErrorMessage: &errorMessage, Meta: meta.Finish()}, err
 		}
+		meta.Retried(res, 1+backoff.RetryCount)
 
-		if err := json.NewDecoder(res.Body).Decode(&data); err != nil {
+		if err := s.Settings.Decode(res.Body, &data, "GetTransaction"); err != nil {
 			res.Body.Close()
 			errorCode := 500
 			errorMessage := err.Error()
-			return &utils.Response[TransactionResponse]{Data: nil, Error: true, ErrorCode: &errorCode, ErrorMessage: &errorMessage}, err
+			return &utils.Response[TransactionResponse]{Data: nil, Error: true, ErrorCode: &errorCode, ErrorMessage: &errorMessage, Meta: meta.Finish()}, err
 		}
 		res.Body.Close()
 	} else {
-		err = json.NewDecoder(resp.Body).Decode(&data)
+		err = s.Settings.Decode(resp.Body, &data, "GetTransaction")
 		if err != nil {
 			errorCode := 500
 			errorMessage := err.Error()
-			return &utils.Response[TransactionResponse]{Data: nil, Error: true, ErrorCode: &errorCode, ErrorMessage: &errorMessage}, err
+			return &utils.Response[TransactionResponse]{Data: nil, Error: true, ErrorCode: &errorCode, ErrorMessage: &errorMessage, Meta: meta.Finish()}, err
 		}
 	}
 
 	if data.Error {
-		return &utils.Response[TransactionResponse]{Data: data.Data, Error: data.Error, ErrorCode: data.ErrorCode, ErrorMessage: data.ErrorMessage}, fmt.Errorf(*data.ErrorMessage)
+		return &utils.Response[TransactionResponse]{Data: data.Data, Error: data.Error, ErrorCode: data.ErrorCode, ErrorMessage: data.ErrorMessage, Meta: meta.Finish()}, fmt.Errorf(*data.ErrorMessage)
 	}
 
-	return &utils.Response[TransactionResponse]{Data: data.Data, Error: data.Error, ErrorCode: data.ErrorCode, ErrorMessage: data.ErrorMessage}, nil
+	return &utils.Response[TransactionResponse]{Data: data.Data, Error: data.Error, ErrorCode: data.ErrorCode, ErrorMessage: data.ErrorMessage, Meta: meta.Finish()}, nil
 }
 
 func (s *transactionServiceImpl) GetAllTransactionsForAddress(chainName chains.Chain, walletAddress string, queryParamOpts ...GetAllTransactionsForAddressQueryParamOpts) <-chan TransactionResult {
@@ -1387,7 +1488,7 @@ func (s *transactionServiceImpl) GetAllTransactionsForAddress(chainName chains.C
 			return
 		}
 
-		apiURL := fmt.Sprintf("https://api.covalenthq.com/v1/%v/address/%s/transactions_v3/", chainName, walletAddress)
+		apiURL := fmt.Sprintf("%s/v1/%v/address/%s/transactions_v3/", s.Settings.BaseURL, chainName, walletAddress)
 
 		// Parse the formatted URL
 		parsedURL, err := url.Parse(apiURL)
@@ -1424,13 +1525,15 @@ func (s *transactionServiceImpl) GetAllTransactionsForAddress(chainName chains.C
 		var data utils.Response[RecentTransactionsResponse]
 		for hasNext {
 
-			res, err := utils.PaginateEndpointUsingLinks(apiURL, s.APIKey, params, s.Debug, s.ThreadCount, utils.UserAgent)
+			res, err := utils.PaginateEndpointUsingLinksWithSettings(s.Settings, apiURL, params, s.Debug, s.ThreadCount, utils.UserAgent)
 			if err != nil {
 				transactionChannel <- TransactionResult{Err: err}
 				hasNext = false
 				return
 			}
-			if err := json.NewDecoder(res.Body).Decode(&data); err != nil {
+			if err := utils.DecodeItems(s.Settings, res.Body, &data, "GetAllTransactionsForAddress", func(item Transaction) {
+				transactionChannel <- TransactionResult{Transaction: item}
+			}); err != nil {
 				transactionChannel <- TransactionResult{Err: err}
 				res.Body.Close()
 				hasNext = false
@@ -1449,10 +1552,6 @@ func (s *transactionServiceImpl) GetAllTransactionsForAddress(chainName chains.C
 				return
 			}
 
-			for _, item := range data.Data.Items {
-				transactionChannel <- TransactionResult{Transaction: item, Err: err}
-			}
-
 			if data.Data.Links.Prev == nil {
 				hasNext = false
 			} else {
@@ -1466,7 +1565,7 @@ func (s *transactionServiceImpl) GetAllTransactionsForAddress(chainName chains.C
 }
 
 func (s *transactionServiceImpl) GetAllTransactionsForAddressByPage(chainName chains.Chain, walletAddress string, queryParamOpts ...GetAllTransactionsForAddressQueryParamOpts) (*utils.Response[RecentTransactionsResponse], error) {
-	apiURL := fmt.Sprintf("https://api.covalenthq.com/v1/%v/address/%s/transactions_v3/", chainName, walletAddress)
+	apiURL := fmt.Sprintf("%s/v1/%v/address/%s/transactions_v3/", s.Settings.BaseURL, chainName, walletAddress)
 
 	if !s.IskeyValid {
 		errorCode := 401
@@ -1505,10 +1604,10 @@ func (s *transactionServiceImpl) GetAllTransactionsForAddressByPage(chainName ch
 	parsedURL.RawQuery = params.Encode()
 
 	// Create an HTTP client
-	client := &http.Client{}
+	client := s.Settings.HTTPClient
 
 	// Create a GET request
-	req, err := http.NewRequest("GET", parsedURL.String(), nil)
+	req, err := http.NewRequestWithContext(s.Settings.Context, "GET", parsedURL.String(), nil)
 
 	if err != nil {
 		errorCode := 500
@@ -1516,7 +1615,14 @@ func (s *transactionServiceImpl) GetAllTransactionsForAddressByPage(chainName ch
 		return &utils.Response[RecentTransactionsResponse]{Data: nil, Error: true, ErrorCode: &errorCode, ErrorMessage: &errorMessage}, err
 	}
 
-	req.Header.Set("Authorization", `Bearer `+s.APIKey)
+	apiKey, err := s.Settings.APIKey()
+	if err != nil {
+		errorCode := 401
+		errorMessage := err.Error()
+		return &utils.Response[RecentTransactionsResponse]{Data: nil, Error: true, ErrorCode: &errorCode, ErrorMessage: &errorMessage}, err
+	}
+
+	req.Header.Set("Authorization", `Bearer `+apiKey)
 	req.Header.Set("X-Requested-With", utils.UserAgent)
 
 	var startTime time.Time // Declares startTime, initially set to zero value of time.Time
@@ -1526,6 +1632,7 @@ func (s *transactionServiceImpl) GetAllTransactionsForAddressByPage(chainName ch
 	}
 
 	// Perform the request
+	requestStart := time.Now()
 	resp, err := client.Do(req)
 	if err != nil {
 		// create a &Response that returns data: nil, error: true, errorCode: "Unknown Error Code", errorMessage: "Unknown Error"
@@ -1534,45 +1641,48 @@ func (s *transactionServiceImpl) GetAllTransactionsForAddressByPage(chainName ch
 
 	defer resp.Body.Close()
 
+	s.Settings.Report(apiKey, resp.StatusCode)
+	meta := s.Settings.NewResponseMeta(resp, requestStart)
 	utils.DebugOutput(resp.Request.URL.String(), resp.StatusCode, startTime)
-	backoff := utils.NewExponentialBackoff(s.APIKey, s.Debug, 0, utils.UserAgent)
+	backoff := utils.NewExponentialBackoffWithSettings(s.Settings, s.Debug, 0, utils.UserAgent)
 
 	// // Read the response body
 	var data utils.Response[RecentTransactionsResponse]
 
-	if resp.StatusCode == 429 {
+	if resp.StatusCode == 429 && backoff.Enabled() {
 		res, err := backoff.BackOff(resp.Request.URL.String())
 		if err != nil {
 			errorCode := resp.StatusCode
 			errorMessage := err.Error()
-			return &utils.Response[RecentTransactionsResponse]{Data: nil, Error: true, ErrorCode: &errorCode, ErrorMessage: &errorMessage}, err
+			return &utils.Response[RecentTransactionsResponse]{Data: nil, Error: true, ErrorCode: &errorCode, ErrorMessage: &errorMessage, Meta: meta.Finish()}, err
 		}
+		meta.Retried(res, 1+backoff.RetryCount)
 
-		if err := json.NewDecoder(res.Body).Decode(&data); err != nil {
+		if err := s.Settings.Decode(res.Body, &data, "GetAllTransactionsForAddressByPage"); err != nil {
 			res.Body.Close()
 			errorCode := 500
 			errorMessage := err.Error()
-			return &utils.Response[RecentTransactionsResponse]{Data: nil, Error: true, ErrorCode: &errorCode, ErrorMessage: &errorMessage}, err
+			return &utils.Response[RecentTransactionsResponse]{Data: nil, Error: true, ErrorCode: &errorCode, ErrorMessage: &errorMessage, Meta: meta.Finish()}, err
 		}
 		res.Body.Close()
 	} else {
-		err = json.NewDecoder(resp.Body).Decode(&data)
+		err = s.Settings.Decode(resp.Body, &data, "GetAllTransactionsForAddressByPage")
 		if err != nil {
 			errorCode := 500
 			errorMessage := err.Error()
-			return &utils.Response[RecentTransactionsResponse]{Data: nil, Error: true, ErrorCode: &errorCode, ErrorMessage: &errorMessage}, err
+			return &utils.Response[RecentTransactionsResponse]{Data: nil, Error: true, ErrorCode: &errorCode, ErrorMessage: &errorMessage, Meta: meta.Finish()}, err
 		}
 	}
 
 	if data.Error {
-		return &utils.Response[RecentTransactionsResponse]{Data: data.Data, Error: data.Error, ErrorCode: data.ErrorCode, ErrorMessage: data.ErrorMessage}, fmt.Errorf(*data.ErrorMessage)
+		return &utils.Response[RecentTransactionsResponse]{Data: data.Data, Error: data.Error, ErrorCode: data.ErrorCode, ErrorMessage: data.ErrorMessage, Meta: meta.Finish()}, fmt.Errorf(*data.ErrorMessage)
 	}
 
-	return &utils.Response[RecentTransactionsResponse]{Data: data.Data, Error: data.Error, ErrorCode: data.ErrorCode, ErrorMessage: data.ErrorMessage}, nil
+	return &utils.Response[RecentTransactionsResponse]{Data: data.Data, Error: data.Error, ErrorCode: data.ErrorCode, ErrorMessage: data.ErrorMessage, Meta: meta.Finish()}, nil
 }
 
 func (s *transactionServiceImpl) GetTransactionsForAddressV3(chainName chains.Chain, walletAddress string, page int, queryParamOpts ...GetTransactionsForAddressV3QueryParamOpts) (*utils.Response[TransactionsResponse], error) {
-	apiURL := fmt.Sprintf("https://api.covalenthq.com/v1/%v/address/%s/transactions_v3/page/%d/", chainName, walletAddress, page)
+	apiURL := fmt.Sprintf("%s/v1/%v/address/%s/transactions_v3/page/%d/", s.Settings.BaseURL, chainName, walletAddress, page)
 
 	if !s.IskeyValid {
 		errorCode := 401
@@ -1611,10 +1721,10 @@ func (s *transactionServiceImpl) GetTransactionsForAddressV3(chainName chains.Ch
 	parsedURL.RawQuery = params.Encode()
 
 	// Create an HTTP client
-	client := &http.Client{}
+	client := s.Settings.HTTPClient
 
 	// Create a GET request
-	req, err := http.NewRequest("GET", parsedURL.String(), nil)
+	req, err := http.NewRequestWithContext(s.Settings.Context, "GET", parsedURL.String(), nil)
 
 	if err != nil {
 		errorCode := 500
@@ -1622,7 +1732,14 @@ func (s *transactionServiceImpl) GetTransactionsForAddressV3(chainName chains.Ch
 		return &utils.Response[TransactionsResponse]{Data: nil, Error: true, ErrorCode: &errorCode, ErrorMessage: &errorMessage}, err
 	}
 
-	req.Header.Set("Authorization", `Bearer `+s.APIKey)
+	apiKey, err := s.Settings.APIKey()
+	if err != nil {
+		errorCode := 401
+		errorMessage := err.Error()
+		return &utils.Response[TransactionsResponse]{Data: nil, Error: true, ErrorCode: &errorCode, ErrorMessage: &errorMessage}, err
+	}
+
+	req.Header.Set("Authorization", `Bearer `+apiKey)
 	req.Header.Set("X-Requested-With", utils.UserAgent)
 
 	var startTime time.Time // Declares startTime, initially set to zero value of time.Time
@@ -1632,6 +1749,7 @@ func (s *transactionServiceImpl) GetTransactionsForAddressV3(chainName chains.Ch
 	}
 
 	// Perform the request
+	requestStart := time.Now()
 	resp, err := client.Do(req)
 	if err != nil {
 		// create a &Response that returns data: nil, error: true, errorCode: "Unknown Error Code", errorMessage: "Unknown Error"
@@ -1640,45 +1758,48 @@ func (s *transactionServiceImpl) GetTransactionsForAddressV3(chainName chains.Ch
 
 	defer resp.Body.Close()
 
+	s.Settings.Report(apiKey, resp.StatusCode)
+	meta := s.Settings.NewResponseMeta(resp, requestStart)
 	utils.DebugOutput(resp.Request.URL.String(), resp.StatusCode, startTime)
-	backoff := utils.NewExponentialBackoff(s.APIKey, s.Debug, 0, utils.UserAgent)
+	backoff := utils.NewExponentialBackoffWithSettings(s.Settings, s.Debug, 0, utils.UserAgent)
 
 	// // Read the response body
 	var data utils.Response[TransactionsResponse]
 
-	if resp.StatusCode == 429 {
+	if resp.StatusCode == 429 && backoff.Enabled() {
 		res, err := backoff.BackOff(resp.Request.URL.String())
 		if err != nil {
 			errorCode := resp.StatusCode
 			errorMessage := err.Error()
-			return &utils.Response[TransactionsResponse]{Data: nil, Error: true, ErrorCode: &errorCode, ErrorMessage: &errorMessage}, err
+			return &utils.Response[TransactionsResponse]{Data: nil, Error: true, ErrorCode: &errorCode, ErrorMessage: &errorMessage, Meta: meta.Finish()}, err
 		}
+		meta.Retried(res, 1+backoff.RetryCount)
 
-		if err := json.NewDecoder(res.Body).Decode(&data); err != nil {
+		if err := s.Settings.Decode(res.Body, &data, "GetTransactionsForAddressV3"); err != nil {
 			res.Body.Close()
 			errorCode := 500
 			errorMessage := err.Error()
-			return &utils.Response[TransactionsResponse]{Data: nil, Error: true, ErrorCode: &errorCode, ErrorMessage: &errorMessage}, err
+			return &utils.Response[TransactionsResponse]{Data: nil, Error: true, ErrorCode: &errorCode, ErrorMessage: &errorMessage, Meta: meta.Finish()}, err
 		}
 		res.Body.Close()
 	} else {
-		err = json.NewDecoder(resp.Body).Decode(&data)
+		err = s.Settings.Decode(resp.Body, &data, "GetTransactionsForAddressV3")
 		if err != nil {
 			errorCode := 500
 			errorMessage := err.Error()
-			return &utils.Response[TransactionsResponse]{Data: nil, Error: true, ErrorCode: &errorCode, ErrorMessage: &errorMessage}, err
+			return &utils.Response[TransactionsResponse]{Data: nil, Error: true, ErrorCode: &errorCode, ErrorMessage: &errorMessage, Meta: meta.Finish()}, err
 		}
 	}
 
 	if data.Error {
-		return &utils.Response[TransactionsResponse]{Data: data.Data, Error: data.Error, ErrorCode: data.ErrorCode, ErrorMessage: data.ErrorMessage}, fmt.Errorf(*data.ErrorMessage)
+		return &utils.Response[TransactionsResponse]{Data: data.Data, Error: data.Error, ErrorCode: data.ErrorCode, ErrorMessage: data.ErrorMessage, Meta: meta.Finish()}, fmt.Errorf(*data.ErrorMessage)
 	}
 
-	return &utils.Response[TransactionsResponse]{Data: data.Data, Error: data.Error, ErrorCode: data.ErrorCode, ErrorMessage: data.ErrorMessage}, nil
+	return &utils.Response[TransactionsResponse]{Data: data.Data, Error: data.Error, ErrorCode: data.ErrorCode, ErrorMessage: data.ErrorMessage, Meta: meta.Finish()}, nil
 }
 
 func (s *transactionServiceImpl) GetTimeBucketTransactionsForAddress(chainName chains.Chain, walletAddress string, timeBucket int, queryParamOpts ...GetTimeBucketTransactionsForAddressQueryParamOpts) (*utils.Response[TransactionsTimeBucketResponse], error) {
-	apiURL := fmt.Sprintf("https://api.covalenthq.com/v1/%v/bulk/transactions/%s/%d/", chainName, walletAddress, timeBucket)
+	apiURL := fmt.Sprintf("%s/v1/%v/bulk/transactions/%s/%d/", s.Settings.BaseURL, chainName, walletAddress, timeBucket)
 
 	if !s.IskeyValid {
 		errorCode := 401
@@ -1713,10 +1834,10 @@ func (s *transactionServiceImpl) GetTimeBucketTransactionsForAddress(chainName c
 	parsedURL.RawQuery = params.Encode()
 
 	// Create an HTTP client
-	client := &http.Client{}
+	client := s.Settings.HTTPClient
 
 	// Create a GET request
-	req, err := http.NewRequest("GET", parsedURL.String(), nil)
+	req, err := http.NewRequestWithContext(s.Settings.Context, "GET", parsedURL.String(), nil)
 
 	if err != nil {
 		errorCode := 500
@@ -1724,7 +1845,14 @@ func (s *transactionServiceImpl) GetTimeBucketTransactionsForAddress(chainName c
 		return &utils.Response[TransactionsTimeBucketResponse]{Data: nil, Error: true, ErrorCode: &errorCode, ErrorMessage: &errorMessage}, err
 	}
 
-	req.Header.Set("Authorization", `Bearer `+s.APIKey)
+	apiKey, err := s.Settings.APIKey()
+	if err != nil {
+		errorCode := 401
+		errorMessage := err.Error()
+		return &utils.Response[TransactionsTimeBucketResponse]{Data: nil, Error: true, ErrorCode: &errorCode, ErrorMessage: &errorMessage}, err
+	}
+
+	req.Header.Set("Authorization", `Bearer `+apiKey)
 	req.Header.Set("X-Requested-With", utils.UserAgent)
 
 	var startTime time.Time // Declares startTime, initially set to zero value of time.Time
@@ -1734,6 +1862,7 @@ func (s *transactionServiceImpl) GetTimeBucketTransactionsForAddress(chainName c
 	}
 
 	// Perform the request
+	requestStart := time.Now()
 	resp, err := client.Do(req)
 	if err != nil {
 		// create a &Response that returns data: nil, error: true, errorCode: "Unknown Error Code", errorMessage: "Unknown Error"
@@ -1742,46 +1871,49 @@ func (s *transactionServiceImpl) GetTimeBucketTransactionsForAddress(chainName c
 
 	defer resp.Body.Close()
 
+	s.Settings.Report(apiKey, resp.StatusCode)
+	meta := s.Settings.NewResponseMeta(resp, requestStart)
 	utils.DebugOutput(resp.Request.URL.String(), resp.StatusCode, startTime)
-	backoff := utils.NewExponentialBackoff(s.APIKey, s.Debug, 0, utils.UserAgent)
+	backoff := utils.NewExponentialBackoffWithSettings(s.Settings, s.Debug, 0, utils.UserAgent)
 
 	// // Read the response body
 	var data utils.Response[TransactionsTimeBucketResponse]
 
-	if resp.StatusCode == 429 {
+	if resp.StatusCode == 429 && backoff.Enabled() {
 		res, err := backoff.BackOff(resp.Request.URL.String())
 		if err != nil {
 			errorCode := resp.StatusCode
 			errorMessage := err.Error()
-			return &utils.Response[TransactionsTimeBucketResponse]{Data: nil, Error: true, ErrorCode: &errorCode, ErrorMessage: &errorMessage}, err
+			return &utils.Response[TransactionsTimeBucketResponse]{Data: nil, Error: true, ErrorCode: &errorCode, ErrorMessage: &errorMessage, Meta: meta.Finish()}, err
 		}
+		meta.Retried(res, 1+backoff.RetryCount)
 
-		if err := json.NewDecoder(res.Body).Decode(&data); err != nil {
+		if err := s.Settings.Decode(res.Body, &data, "GetTimeBucketTransactionsForAddress"); err != nil {
 			res.Body.Close()
 			errorCode := 500
 			errorMessage := err.Error()
-			return &utils.Response[TransactionsTimeBucketResponse]{Data: nil, Error: true, ErrorCode: &errorCode, ErrorMessage: &errorMessage}, err
+			return &utils.Response[TransactionsTimeBucketResponse]{Data: nil, Error: true, ErrorCode: &errorCode, ErrorMessage: &errorMessage, Meta: meta.Finish()}, err
 		}
 		res.Body.Close()
 	} else {
-		err = json.NewDecoder(resp.Body).Decode(&data)
+		err = s.Settings.Decode(resp.Body, &data, "GetTimeBucketTransactionsForAddress")
 		if err != nil {
 			errorCode := 500
 			errorMessage := err.Error()
-			return &utils.Response[TransactionsTimeBucketResponse]{Data: nil, Error: true, ErrorCode: &errorCode, ErrorMessage: &errorMessage}, err
+			return &utils.Response[TransactionsTimeBucketResponse]{Data: nil, Error: true, ErrorCode: &errorCode, ErrorMessage: &errorMessage, Meta: meta.Finish()}, err
 		}
 	}
 
 	if data.Error {
-		return &utils.Response[TransactionsTimeBucketResponse]{Data: data.Data, Error: data.Error, ErrorCode: data.ErrorCode, ErrorMessage: data.ErrorMessage}, fmt.Errorf(*data.ErrorMessage)
+		return &utils.Response[TransactionsTimeBucketResponse]{Data: data.Data, Error: data.Error, ErrorCode: data.ErrorCode, ErrorMessage: data.ErrorMessage, Meta: meta.Finish()}, fmt.Errorf(*data.ErrorMessage)
 	}
 
-	return &utils.Response[TransactionsTimeBucketResponse]{Data: data.Data, Error: data.Error, ErrorCode: data.ErrorCode, ErrorMessage: data.ErrorMessage}, nil
+	return &utils.Response[TransactionsTimeBucketResponse]{Data: data.Data, Error: data.Error, ErrorCode: data.ErrorCode, ErrorMessage: data.ErrorMessage, Meta: meta.Finish()}, nil
 }
 
 func (s *transactionServiceImpl) GetTransactionsForBlock(chainName chains.Chain, blockHeight string, queryParamOpts ...GetTransactionsForBlockQueryParamOpts) (*utils.Response[TransactionsBlockResponse], error) {
 
-	apiURL := fmt.Sprintf("https://api.covalenthq.com/v1/%v/block/%s/transactions_v3/", chainName, blockHeight)
+	apiURL := fmt.Sprintf("%s/v1/%v/block/%s/transactions_v3/", s.Settings.BaseURL, chainName, blockHeight)
 
 	if !s.IskeyValid {
 		errorCode := 401
@@ -1816,10 +1948,10 @@ func (s *transactionServiceImpl) GetTransactionsForBlock(chainName chains.Chain,
 	parsedURL.RawQuery = params.Encode()
 
 	// Create an HTTP client
-	client := &http.Client{}
+	client := s.Settings.HTTPClient
 
 	// Create a GET request
-	req, err := http.NewRequest("GET", parsedURL.String(), nil)
+	req, err := http.NewRequestWithContext(s.Settings.Context, "GET", parsedURL.String(), nil)
 
 	if err != nil {
 		errorCode := 500
@@ -1827,7 +1959,14 @@ func (s *transactionServiceImpl) GetTransactionsForBlock(chainName chains.Chain,
 		return &utils.Response[TransactionsBlockResponse]{Data: nil, Error: true, ErrorCode: &errorCode, ErrorMessage: &errorMessage}, err
 	}
 
-	req.Header.Set("Authorization", `Bearer `+s.APIKey)
+	apiKey, err := s.Settings.APIKey()
+	if err != nil {
+		errorCode := 401
+		errorMessage := err.Error()
+		return &utils.Response[TransactionsBlockResponse]{Data: nil, Error: true, ErrorCode: &errorCode, ErrorMessage: &errorMessage}, err
+	}
+
+	req.Header.Set("Authorization", `Bearer `+apiKey)
 	req.Header.Set("X-Requested-With", utils.UserAgent)
 
 	var startTime time.Time // Declares startTime, initially set to zero value of time.Time
@@ -1837,6 +1976,7 @@ func (s *transactionServiceImpl) GetTransactionsForBlock(chainName chains.Chain,
 	}
 
 	// Perform the request
+	requestStart := time.Now()
 	resp, err := client.Do(req)
 	if err != nil {
 		// create a &Response that returns data: nil, error: true, errorCode: "Unknown Error Code", errorMessage: "Unknown Error"
@@ -1845,45 +1985,48 @@ func (s *transactionServiceImpl) GetTransactionsForBlock(chainName chains.Chain,
 
 	defer resp.Body.Close()
 
+	s.Settings.Report(apiKey, resp.StatusCode)
+	meta := s.Settings.NewResponseMeta(resp, requestStart)
 	utils.DebugOutput(resp.Request.URL.String(), resp.StatusCode, startTime)
-	backoff := utils.NewExponentialBackoff(s.APIKey, s.Debug, 0, utils.UserAgent)
+	backoff := utils.NewExponentialBackoffWithSettings(s.Settings, s.Debug, 0, utils.UserAgent)
 
 	// // Read the response body
 	var data utils.Response[TransactionsBlockResponse]
 
-	if resp.StatusCode == 429 {
+	if resp.StatusCode == 429 && backoff.Enabled() {
 		res, err := backoff.BackOff(resp.Request.URL.String())
 		if err != nil {
 			errorCode := resp.StatusCode
 			errorMessage := err.Error()
-			return &utils.Response[TransactionsBlockResponse]{Data: nil, Error: true, ErrorCode: &errorCode, ErrorMessage: &errorMessage}, err
+			return &utils.Response[TransactionsBlockResponse]{Data: nil, Error: true, ErrorCode: &errorCode, ErrorMessage: &errorMessage, Meta: meta.Finish()}, err
 		}
+		meta.Retried(res, 1+backoff.RetryCount)
 
-		if err := json.NewDecoder(res.Body).Decode(&data); err != nil {
+		if err := s.Settings.Decode(res.Body, &data, "GetTransactionsForBlock"); err != nil {
 			res.Body.Close()
 			errorCode := 500
 			errorMessage := err.Error()
-			return &utils.Response[TransactionsBlockResponse]{Data: nil, Error: true, ErrorCode: &errorCode, ErrorMessage: &errorMessage}, err
+			return &utils.Response[TransactionsBlockResponse]{Data: nil, Error: true, ErrorCode: &errorCode, ErrorMessage: &errorMessage, Meta: meta.Finish()}, err
 		}
 		res.Body.Close()
 	} else {
-		err = json.NewDecoder(resp.Body).Decode(&data)
+		err = s.Settings.Decode(resp.Body, &data, "GetTransactionsForBlock")
 		if err != nil {
 			errorCode := 500
 			errorMessage := err.Error()
-			return &utils.Response[TransactionsBlockResponse]{Data: nil, Error: true, ErrorCode: &errorCode, ErrorMessage: &errorMessage}, err
+			return &utils.Response[TransactionsBlockResponse]{Data: nil, Error: true, ErrorCode: &errorCode, ErrorMessage: &errorMessage, Meta: meta.Finish()}, err
 		}
 	}
 
 	if data.Error {
-		return &utils.Response[TransactionsBlockResponse]{Data: data.Data, Error: data.Error, ErrorCode: data.ErrorCode, ErrorMessage: data.ErrorMessage}, fmt.Errorf(*data.ErrorMessage)
+		return &utils.Response[TransactionsBlockResponse]{Data: data.Data, Error: data.Error, ErrorCode: data.ErrorCode, ErrorMessage: data.ErrorMessage, Meta: meta.Finish()}, fmt.Errorf(*data.ErrorMessage)
 	}
 
-	return &utils.Response[TransactionsBlockResponse]{Data: data.Data, Error: data.Error, ErrorCode: data.ErrorCode, ErrorMessage: data.ErrorMessage}, nil
+	return &utils.Response[TransactionsBlockResponse]{Data: data.Data, Error: data.Error, ErrorCode: data.ErrorCode, ErrorMessage: data.ErrorMessage, Meta: meta.Finish()}, nil
 }
 
 func (s *transactionServiceImpl) GetTransactionsForBlockHashByPage(chainName chains.Chain, blockHash string, page int, queryParamOpts ...GetTransactionsForBlockHashByPageQueryParamOpts) (*utils.Response[TransactionsBlockPageResponse], error) {
-	apiURL := fmt.Sprintf("https://api.covalenthq.com/v1/%v/block_hash/%s/transactions_v3/page/%d/", chainName, blockHash, page)
+	apiURL := fmt.Sprintf("%s/v1/%v/block_hash/%s/transactions_v3/page/%d/", s.Settings.BaseURL, chainName, blockHash, page)
 
 	if !s.IskeyValid {
 		errorCode := 401
@@ -1918,10 +2061,10 @@ func (s *transactionServiceImpl) GetTransactionsForBlockHashByPage(chainName cha
 	parsedURL.RawQuery = params.Encode()
 
 	// Create an HTTP client
-	client := &http.Client{}
+	client := s.Settings.HTTPClient
 
 	// Create a GET request
-	req, err := http.NewRequest("GET", parsedURL.String(), nil)
+	req, err := http.NewRequestWithContext(s.Settings.Context, "GET", parsedURL.String(), nil)
 
 	if err != nil {
 		errorCode := 500
@@ -1929,7 +2072,14 @@ func (s *transactionServiceImpl) GetTransactionsForBlockHashByPage(chainName cha
 		return &utils.Response[TransactionsBlockPageResponse]{Data: nil, Error: true, ErrorCode: &errorCode, ErrorMessage: &errorMessage}, err
 	}
 
-	req.Header.Set("Authorization", `Bearer `+s.APIKey)
+	apiKey, err := s.Settings.APIKey()
+	if err != nil {
+		errorCode := 401
+		errorMessage := err.Error()
+		return &utils.Response[TransactionsBlockPageResponse]{Data: nil, Error: true, ErrorCode: &errorCode, ErrorMessage: &errorMessage}, err
+	}
+
+	req.Header.Set("Authorization", `Bearer `+apiKey)
 	req.Header.Set("X-Requested-With", utils.UserAgent)
 
 	var startTime time.Time // Declares startTime, initially set to zero value of time.Time
@@ -1939,6 +2089,7 @@ func (s *transactionServiceImpl) GetTransactionsForBlockHashByPage(chainName cha
 	}
 
 	// Perform the request
+	requestStart := time.Now()
 	resp, err := client.Do(req)
 	if err != nil {
 		// create a &Response that returns data: nil, error: true, errorCode: "Unknown Error Code", errorMessage: "Unknown Error"
@@ -1947,46 +2098,49 @@ func (s *transactionServiceImpl) GetTransactionsForBlockHashByPage(chainName cha
 
 	defer resp.Body.Close()
 
+	s.Settings.Report(apiKey, resp.StatusCode)
+	meta := s.Settings.NewResponseMeta(resp, requestStart)
 	utils.DebugOutput(resp.Request.URL.String(), resp.StatusCode, startTime)
-	backoff := utils.NewExponentialBackoff(s.APIKey, s.Debug, 0, utils.UserAgent)
+	backoff := utils.NewExponentialBackoffWithSettings(s.Settings, s.Debug, 0, utils.UserAgent)
 
 	// // Read the response body
 	var data utils.Response[TransactionsBlockPageResponse]
 
-	if resp.StatusCode == 429 {
+	if resp.StatusCode == 429 && backoff.Enabled() {
 		res, err := backoff.BackOff(resp.Request.URL.String())
 		if err != nil {
 			errorCode := resp.StatusCode
 			errorMessage := err.Error()
-			return &utils.Response[TransactionsBlockPageResponse]{Data: nil, Error: true, ErrorCode: &errorCode, ErrorMessage: &errorMessage}, err
+			return &utils.Response[TransactionsBlockPageResponse]{Data: nil, Error: true, ErrorCode: &errorCode, ErrorMessage: &errorMessage, Meta: meta.Finish()}, err
 		}
+		meta.Retried(res, 1+backoff.RetryCount)
 
-		if err := json.NewDecoder(res.Body).Decode(&data); err != nil {
+		if err := s.Settings.Decode(res.Body, &data, "GetTransactionsForBlockHashByPage"); err != nil {
 			res.Body.Close()
 			errorCode := 500
 			errorMessage := err.Error()
-			return &utils.Response[TransactionsBlockPageResponse]{Data: nil, Error: true, ErrorCode: &errorCode, ErrorMessage: &errorMessage}, err
+			return &utils.Response[TransactionsBlockPageResponse]{Data: nil, Error: true, ErrorCode: &errorCode, ErrorMessage: &errorMessage, Meta: meta.Finish()}, err
 		}
 		res.Body.Close()
 	} else {
-		err = json.NewDecoder(resp.Body).Decode(&data)
+		err = s.Settings.Decode(resp.Body, &data, "GetTransactionsForBlockHashByPage")
 		if err != nil {
 			errorCode := 500
 			errorMessage := err.Error()
-			return &utils.Response[TransactionsBlockPageResponse]{Data: nil, Error: true, ErrorCode: &errorCode, ErrorMessage: &errorMessage}, err
+			return &utils.Response[TransactionsBlockPageResponse]{Data: nil, Error: true, ErrorCode: &errorCode, ErrorMessage: &errorMessage, Meta: meta.Finish()}, err
 		}
 	}
 
 	if data.Error {
-		return &utils.Response[TransactionsBlockPageResponse]{Data: data.Data, Error: data.Error, ErrorCode: data.ErrorCode, ErrorMessage: data.ErrorMessage}, fmt.Errorf(*data.ErrorMessage)
+		return &utils.Response[TransactionsBlockPageResponse]{Data: data.Data, Error: data.Error, ErrorCode: data.ErrorCode, ErrorMessage: data.ErrorMessage, Meta: meta.Finish()}, fmt.Errorf(*data.ErrorMessage)
 	}
 
-	return &utils.Response[TransactionsBlockPageResponse]{Data: data.Data, Error: data.Error, ErrorCode: data.ErrorCode, ErrorMessage: data.ErrorMessage}, nil
+	return &utils.Response[TransactionsBlockPageResponse]{Data: data.Data, Error: data.Error, ErrorCode: data.ErrorCode, ErrorMessage: data.ErrorMessage, Meta: meta.Finish()}, nil
 }
 
 func (s *transactionServiceImpl) GetTransactionsForBlockHash(chainName chains.Chain, blockHash string, queryParamOpts ...GetTransactionsForBlockHashQueryParamOpts) (*utils.Response[TransactionsBlockResponse], error) {
 
-	apiURL := fmt.Sprintf("https://api.covalenthq.com/v1/%v/block_hash/%s/transactions_v3/", chainName, blockHash)
+	apiURL := fmt.Sprintf("%s/v1/%v/block_hash/%s/transactions_v3/", s.Settings.BaseURL, chainName, blockHash)
 
 	if !s.IskeyValid {
 		errorCode := 401
@@ -2021,10 +2175,10 @@ func (s *transactionServiceImpl) GetTransactionsForBlockHash(chainName chains.Ch
 	parsedURL.RawQuery = params.Encode()
 
 	// Create an HTTP client
-	client := &http.Client{}
+	client := s.Settings.HTTPClient
 
 	// Create a GET request
-	req, err := http.NewRequest("GET", parsedURL.String(), nil)
+	req, err := http.NewRequestWithContext(s.Settings.Context, "GET", parsedURL.String(), nil)
 
 	if err != nil {
 		errorCode := 500
@@ -2032,7 +2186,14 @@ func (s *transactionServiceImpl) GetTransactionsForBlockHash(chainName chains.Ch
 		return &utils.Response[TransactionsBlockResponse]{Data: nil, Error: true, ErrorCode: &errorCode, ErrorMessage: &errorMessage}, err
 	}
 
-	req.Header.Set("Authorization", `Bearer `+s.APIKey)
+	apiKey, err := s.Settings.APIKey()
+	if err != nil {
+		errorCode := 401
+		errorMessage := err.Error()
+		return &utils.Response[TransactionsBlockResponse]{Data: nil, Error: true, ErrorCode: &errorCode, ErrorMessage: &errorMessage}, err
+	}
+
+	req.Header.Set("Authorization", `Bearer `+apiKey)
 	req.Header.Set("X-Requested-With", utils.UserAgent)
 
 	var startTime time.Time // Declares startTime, initially set to zero value of time.Time
@@ -2042,6 +2203,7 @@ func (s *transactionServiceImpl) GetTransactionsForBlockHash(chainName chains.Ch
 	}
 
 	// Perform the request
+	requestStart := time.Now()
 	resp, err := client.Do(req)
 	if err != nil {
 		// create a &Response that returns data: nil, error: true, errorCode: "Unknown Error Code", errorMessage: "Unknown Error"
@@ -2050,46 +2212,49 @@ func (s *transactionServiceImpl) GetTransactionsForBlockHash(chainName chains.Ch
 
 	defer resp.Body.Close()
 
+	s.Settings.Report(apiKey, resp.StatusCode)
+	meta := s.Settings.NewResponseMeta(resp, requestStart)
 	utils.DebugOutput(resp.Request.URL.String(), resp.StatusCode, startTime)
-	backoff := utils.NewExponentialBackoff(s.APIKey, s.Debug, 0, utils.UserAgent)
+	backoff := utils.NewExponentialBackoffWithSettings(s.Settings, s.Debug, 0, utils.UserAgent)
 
 	// // Read the response body
 	var data utils.Response[TransactionsBlockResponse]
 
-	if resp.StatusCode == 429 {
+	if resp.StatusCode == 429 && backoff.Enabled() {
 		res, err := backoff.BackOff(resp.Request.URL.String())
 		if err != nil {
 			errorCode := resp.StatusCode
 			errorMessage := err.Error()
-			return &utils.Response[TransactionsBlockResponse]{Data: nil, Error: true, ErrorCode: &errorCode, ErrorMessage: &errorMessage}, err
+			return &utils.Response[TransactionsBlockResponse]{Data: nil, Error: true, ErrorCode: &errorCode, ErrorMessage: &errorMessage, Meta: meta.Finish()}, err
 		}
+		meta.Retried(res, 1+backoff.RetryCount)
 
-		if err := json.NewDecoder(res.Body).Decode(&data); err != nil {
+		if err := s.Settings.Decode(res.Body, &data, "GetTransactionsForBlockHash"); err != nil {
 			res.Body.Close()
 			errorCode := 500
 			errorMessage := err.Error()
-			return &utils.Response[TransactionsBlockResponse]{Data: nil, Error: true, ErrorCode: &errorCode, ErrorMessage: &errorMessage}, err
+			return &utils.Response[TransactionsBlockResponse]{Data: nil, Error: true, ErrorCode: &errorCode, ErrorMessage: &errorMessage, Meta: meta.Finish()}, err
 		}
 		res.Body.Close()
 	} else {
-		err = json.NewDecoder(resp.Body).Decode(&data)
+		err = s.Settings.Decode(resp.Body, &data, "GetTransactionsForBlockHash")
 		if err != nil {
 			errorCode := 500
 			errorMessage := err.Error()
-			return &utils.Response[TransactionsBlockResponse]{Data: nil, Error: true, ErrorCode: &errorCode, ErrorMessage: &errorMessage}, err
+			return &utils.Response[TransactionsBlockResponse]{Data: nil, Error: true, ErrorCode: &errorCode, ErrorMessage: &errorMessage, Meta: meta.Finish()}, err
 		}
 	}
 
 	if data.Error {
-		return &utils.Response[TransactionsBlockResponse]{Data: data.Data, Error: data.Error, ErrorCode: data.ErrorCode, ErrorMessage: data.ErrorMessage}, fmt.Errorf(*data.ErrorMessage)
+		return &utils.Response[TransactionsBlockResponse]{Data: data.Data, Error: data.Error, ErrorCode: data.ErrorCode, ErrorMessage: data.ErrorMessage, Meta: meta.Finish()}, fmt.Errorf(*data.ErrorMessage)
 	}
 
-	return &utils.Response[TransactionsBlockResponse]{Data: data.Data, Error: data.Error, ErrorCode: data.ErrorCode, ErrorMessage: data.ErrorMessage}, nil
+	return &utils.Response[TransactionsBlockResponse]{Data: data.Data, Error: data.Error, ErrorCode: data.ErrorCode, ErrorMessage: data.ErrorMessage, Meta: meta.Finish()}, nil
 }
 
 func (s *transactionServiceImpl) GetTransactionSummary(chainName chains.Chain, walletAddress string, queryParamOpts ...GetTransactionSummaryQueryParamOpts) (*utils.Response[TransactionsSummaryResponse], error) {
 
-	apiURL := fmt.Sprintf("https://api.covalenthq.com/v1/%v/address/%s/transactions_summary/", chainName, walletAddress)
+	apiURL := fmt.Sprintf("%s/v1/%v/address/%s/transactions_summary/", s.Settings.BaseURL, chainName, walletAddress)
 
 	if !s.IskeyValid {
 		errorCode := 401
@@ -2120,10 +2285,10 @@ func (s *transactionServiceImpl) GetTransactionSummary(chainName chains.Chain, w
 	parsedURL.RawQuery = params.Encode()
 
 	// Create an HTTP client
-	client := &http.Client{}
+	client := s.Settings.HTTPClient
 
 	// Create a GET request
-	req, err := http.NewRequest("GET", parsedURL.String(), nil)
+	req, err := http.NewRequestWithContext(s.Settings.Context, "GET", parsedURL.String(), nil)
 
 	if err != nil {
 		errorCode := 500
@@ -2131,7 +2296,14 @@ func (s *transactionServiceImpl) GetTransactionSummary(chainName chains.Chain, w
 		return &utils.Response[TransactionsSummaryResponse]{Data: nil, Error: true, ErrorCode: &errorCode, ErrorMessage: &errorMessage}, err
 	}
 
-	req.Header.Set("Authorization", `Bearer `+s.APIKey)
+	apiKey, err := s.Settings.APIKey()
+	if err != nil {
+		errorCode := 401
+		errorMessage := err.Error()
+		return &utils.Response[TransactionsSummaryResponse]{Data: nil, Error: true, ErrorCode: &errorCode, ErrorMessage: &errorMessage}, err
+	}
+
+	req.Header.Set("Authorization", `Bearer `+apiKey)
 	req.Header.Set("X-Requested-With", utils.UserAgent)
 
 	var startTime time.Time // Declares startTime, initially set to zero value of time.Time
@@ -2141,6 +2313,7 @@ func (s *transactionServiceImpl) GetTransactionSummary(chainName chains.Chain, w
 	}
 
 	// Perform the request
+	requestStart := time.Now()
 	resp, err := client.Do(req)
 	if err != nil {
 		// create a &Response that returns data: nil, error: true, errorCode: "Unknown Error Code", errorMessage: "Unknown Error"
@@ -2149,39 +2322,42 @@ func (s *transactionServiceImpl) GetTransactionSummary(chainName chains.Chain, w
 
 	defer resp.Body.Close()
 
+	s.Settings.Report(apiKey, resp.StatusCode)
+	meta := s.Settings.NewResponseMeta(resp, requestStart)
 	utils.DebugOutput(resp.Request.URL.String(), resp.StatusCode, startTime)
-	backoff := utils.NewExponentialBackoff(s.APIKey, s.Debug, 0, utils.UserAgent)
+	backoff := utils.NewExponentialBackoffWithSettings(s.Settings, s.Debug, 0, utils.UserAgent)
 
 	// // Read the response body
 	var data utils.Response[TransactionsSummaryResponse]
 
-	if resp.StatusCode == 429 {
+	if resp.StatusCode == 429 && backoff.Enabled() {
 		res, err := backoff.BackOff(resp.Request.URL.String())
 		if err != nil {
 			errorCode := resp.StatusCode
 			errorMessage := err.Error()
-			return &utils.Response[TransactionsSummaryResponse]{Data: nil, Error: true, ErrorCode: &errorCode, ErrorMessage: &errorMessage}, err
+			return &utils.Response[TransactionsSummaryResponse]{Data: nil, Error: true, ErrorCode: &errorCode, ErrorMessage: &errorMessage, Meta: meta.Finish()}, err
 		}
+		meta.Retried(res, 1+backoff.RetryCount)
 
-		if err := json.NewDecoder(res.Body).Decode(&data); err != nil {
+		if err := s.Settings.Decode(res.Body, &data, "GetTransactionSummary"); err != nil {
 			res.Body.Close()
 			errorCode := 500
 			errorMessage := err.Error()
-			return &utils.Response[TransactionsSummaryResponse]{Data: nil, Error: true, ErrorCode: &errorCode, ErrorMessage: &errorMessage}, err
+			return &utils.Response[TransactionsSummaryResponse]{Data: nil, Error: true, ErrorCode: &errorCode, ErrorMessage: &errorMessage, Meta: meta.Finish()}, err
 		}
 		res.Body.Close()
 	} else {
-		err = json.NewDecoder(resp.Body).Decode(&data)
+		err = s.Settings.Decode(resp.Body, &data, "GetTransactionSummary")
 		if err != nil {
 			errorCode := 500
 			errorMessage := err.Error()
-			return &utils.Response[TransactionsSummaryResponse]{Data: nil, Error: true, ErrorCode: &errorCode, ErrorMessage: &errorMessage}, err
+			return &utils.Response[TransactionsSummaryResponse]{Data: nil, Error: true, ErrorCode: &errorCode, ErrorMessage: &errorMessage, Meta: meta.Finish()}, err
 		}
 	}
 
 	if data.Error {
-		return &utils.Response[TransactionsSummaryResponse]{Data: data.Data, Error: data.Error, ErrorCode: data.ErrorCode, ErrorMessage: data.ErrorMessage}, fmt.Errorf(*data.ErrorMessage)
+		return &utils.Response[TransactionsSummaryResponse]{Data: data.Data, Error: data.Error, ErrorCode: data.ErrorCode, ErrorMessage: data.ErrorMessage, Meta: meta.Finish()}, fmt.Errorf(*data.ErrorMessage)
 	}
 
-	return &utils.Response[TransactionsSummaryResponse]{Data: data.Data, Error: data.Error, ErrorCode: data.ErrorCode, ErrorMessage: data.ErrorMessage}, nil
+	return &utils.Response[TransactionsSummaryResponse]{Data: data.Data, Error: data.Error, ErrorCode: data.ErrorCode, ErrorMessage: data.ErrorMessage, Meta: meta.Finish()}, nil
 }
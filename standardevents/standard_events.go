@@ -0,0 +1,238 @@
+// Package standardevents recognizes the Transfer and approval events of ERC-20, ERC-721 and ERC-1155 tokens in log
+// events and decodes them into typed structs.
+package standardevents
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+	"strings"
+
+	"github.com/covalenthq/covalent-api-sdk-go/abi"
+	"github.com/covalenthq/covalent-api-sdk-go/genericmodels"
+	"github.com/covalenthq/covalent-api-sdk-go/utils"
+)
+
+// ErrNotStandard is returned when a log event is not one of the standard token events.
+var ErrNotStandard = errors.New("standardevents: log event is not a standard token event")
+
+// Kind identifies a standard token event.
+type Kind string
+
+const (
+	Unknown                   Kind = ""
+	ERC20TransferKind         Kind = "erc20_transfer"
+	ERC20ApprovalKind         Kind = "erc20_approval"
+	ERC721TransferKind        Kind = "erc721_transfer"
+	ERC721ApprovalKind        Kind = "erc721_approval"
+	ApprovalForAllKind        Kind = "approval_for_all"
+	ERC1155TransferSingleKind Kind = "erc1155_transfer_single"
+	ERC1155TransferBatchKind  Kind = "erc1155_transfer_batch"
+)
+
+// The ERC-20 and ERC-721 events share their signatures and so their topics: ERC-721 indexes the token id, so its
+// logs have one more topic.
+var standardABI = abi.MustParse(`[
+	{"type":"event","name":"Transfer","inputs":[
+		{"name":"from","type":"address","indexed":true},
+		{"name":"to","type":"address","indexed":true},
+		{"name":"value","type":"uint256","indexed":false}]},
+	{"type":"event","name":"Approval","inputs":[
+		{"name":"owner","type":"address","indexed":true},
+		{"name":"spender","type":"address","indexed":true},
+		{"name":"value","type":"uint256","indexed":false}]},
+	{"type":"event","name":"Transfer","inputs":[
+		{"name":"from","type":"address","indexed":true},
+		{"name":"to","type":"address","indexed":true},
+		{"name":"tokenId","type":"uint256","indexed":true}]},
+	{"type":"event","name":"Approval","inputs":[
+		{"name":"owner","type":"address","indexed":true},
+		{"name":"approved","type":"address","indexed":true},
+		{"name":"tokenId","type":"uint256","indexed":true}]},
+	{"type":"event","name":"ApprovalForAll","inputs":[
+		{"name":"owner","type":"address","indexed":true},
+		{"name":"operator","type":"address","indexed":true},
+		{"name":"approved","type":"bool","indexed":false}]},
+	{"type":"event","name":"TransferSingle","inputs":[
+		{"name":"operator","type":"address","indexed":true},
+		{"name":"from","type":"address","indexed":true},
+		{"name":"to","type":"address","indexed":true},
+		{"name":"id","type":"uint256","indexed":false},
+		{"name":"value","type":"uint256","indexed":false}]},
+	{"type":"event","name":"TransferBatch","inputs":[
+		{"name":"operator","type":"address","indexed":true},
+		{"name":"from","type":"address","indexed":true},
+		{"name":"to","type":"address","indexed":true},
+		{"name":"ids","type":"uint256[]","indexed":false},
+		{"name":"values","type":"uint256[]","indexed":false}]}
+]`)
+
+var eventsByKind = map[Kind]abi.Event{
+	ERC20TransferKind:         standardABI.Events[0],
+	ERC20ApprovalKind:         standardABI.Events[1],
+	ERC721TransferKind:        standardABI.Events[2],
+	ERC721ApprovalKind:        standardABI.Events[3],
+	ApprovalForAllKind:        standardABI.Events[4],
+	ERC1155TransferSingleKind: standardABI.Events[5],
+	ERC1155TransferBatchKind:  standardABI.Events[6],
+}
+
+// The topics standard token events log first, as lowercase `0x`-prefixed hex.
+var (
+	TransferTopic       = standardABI.Events[0].Topic()
+	ApprovalTopic       = standardABI.Events[1].Topic()
+	ApprovalForAllTopic = standardABI.Events[4].Topic()
+	TransferSingleTopic = standardABI.Events[5].Topic()
+	TransferBatchTopic  = standardABI.Events[6].Topic()
+)
+
+// ERC20Transfer is an ERC-20 `Transfer(address,address,uint256)` event.
+type ERC20Transfer struct {
+	From  utils.Address `abi:"from"`
+	To    utils.Address `abi:"to"`
+	Value *big.Int      `abi:"value"`
+}
+
+// ERC20Approval is an ERC-20 `Approval(address,address,uint256)` event.
+type ERC20Approval struct {
+	Owner   utils.Address `abi:"owner"`
+	Spender utils.Address `abi:"spender"`
+	Value   *big.Int      `abi:"value"`
+}
+
+// ERC721Transfer is an ERC-721 `Transfer(address,address,uint256)` event, with the token id indexed.
+type ERC721Transfer struct {
+	From    utils.Address `abi:"from"`
+	To      utils.Address `abi:"to"`
+	TokenId *big.Int      `abi:"tokenId"`
+}
+
+// ERC721Approval is an ERC-721 `Approval(address,address,uint256)` event, with the token id indexed.
+type ERC721Approval struct {
+	Owner    utils.Address `abi:"owner"`
+	Approved utils.Address `abi:"approved"`
+	TokenId  *big.Int      `abi:"tokenId"`
+}
+
+// ApprovalForAll is an ERC-721 or ERC-1155 `ApprovalForAll(address,address,bool)` event.
+type ApprovalForAll struct {
+	Owner    utils.Address `abi:"owner"`
+	Operator utils.Address `abi:"operator"`
+	Approved bool          `abi:"approved"`
+}
+
+// ERC1155TransferSingle is an ERC-1155 `TransferSingle(address,address,address,uint256,uint256)` event.
+type ERC1155TransferSingle struct {
+	Operator utils.Address `abi:"operator"`
+	From     utils.Address `abi:"from"`
+	To       utils.Address `abi:"to"`
+	Id       *big.Int      `abi:"id"`
+	Value    *big.Int      `abi:"value"`
+}
+
+// ERC1155TransferBatch is an ERC-1155 `TransferBatch(address,address,address,uint256[],uint256[])` event.
+type ERC1155TransferBatch struct {
+	Operator utils.Address `abi:"operator"`
+	From     utils.Address `abi:"from"`
+	To       utils.Address `abi:"to"`
+	Ids      []*big.Int    `abi:"ids"`
+	Values   []*big.Int    `abi:"values"`
+}
+
+// Classify returns the kind of standard token event log is, or Unknown. It reads the raw topics when the log has
+// them, and the name and params of Decoded otherwise.
+func Classify(log genericmodels.LogEvent) Kind {
+	if log.RawLogTopics != nil && len(*log.RawLogTopics) > 0 {
+		topics := *log.RawLogTopics
+		switch strings.ToLower(topics[0]) {
+		case TransferTopic:
+			return byTopicCount(len(topics), ERC20TransferKind, ERC721TransferKind)
+		case ApprovalTopic:
+			return byTopicCount(len(topics), ERC20ApprovalKind, ERC721ApprovalKind)
+		case ApprovalForAllTopic:
+			return ApprovalForAllKind
+		case TransferSingleTopic:
+			return ERC1155TransferSingleKind
+		case TransferBatchTopic:
+			return ERC1155TransferBatchKind
+		}
+		return Unknown
+	}
+
+	if log.Decoded == nil || log.Decoded.Name == nil || log.Decoded.Params == nil {
+		return Unknown
+	}
+	params := *log.Decoded.Params
+	var kind Kind
+	switch *log.Decoded.Name {
+	case "Transfer":
+		kind = ERC20TransferKind
+		if len(params) == 3 && params[2].Indexed != nil && *params[2].Indexed {
+			kind = ERC721TransferKind
+		}
+	case "Approval":
+		kind = ERC20ApprovalKind
+		if len(params) == 3 && params[2].Indexed != nil && *params[2].Indexed {
+			kind = ERC721ApprovalKind
+		}
+	case "ApprovalForAll":
+		kind = ApprovalForAllKind
+	case "TransferSingle":
+		kind = ERC1155TransferSingleKind
+	case "TransferBatch":
+		kind = ERC1155TransferBatchKind
+	default:
+		return Unknown
+	}
+	if len(params) != len(eventsByKind[kind].Inputs) {
+		return Unknown
+	}
+	return kind
+}
+
+func byTopicCount(topics int, erc20 Kind, erc721 Kind) Kind {
+	switch topics {
+	case 3:
+		return erc20
+	case 4:
+		return erc721
+	}
+	return Unknown
+}
+
+// Decode classifies log and returns it as one of the event structs of this package: *ERC20Transfer, *ERC20Approval,
+// *ERC721Transfer, *ERC721Approval, *ApprovalForAll, *ERC1155TransferSingle or *ERC1155TransferBatch.
+// It returns ErrNotStandard for other log events.
+func Decode(log genericmodels.LogEvent) (interface{}, error) {
+	kind := Classify(log)
+	var event interface{}
+	switch kind {
+	case ERC20TransferKind:
+		event = &ERC20Transfer{}
+	case ERC20ApprovalKind:
+		event = &ERC20Approval{}
+	case ERC721TransferKind:
+		event = &ERC721Transfer{}
+	case ERC721ApprovalKind:
+		event = &ERC721Approval{}
+	case ApprovalForAllKind:
+		event = &ApprovalForAll{}
+	case ERC1155TransferSingleKind:
+		event = &ERC1155TransferSingle{}
+	case ERC1155TransferBatchKind:
+		event = &ERC1155TransferBatch{}
+	default:
+		return nil, ErrNotStandard
+	}
+	if err := decodeInto(log, kind, event); err != nil {
+		return nil, err
+	}
+	return event, nil
+}
+
+func decodeInto(log genericmodels.LogEvent, kind Kind, v interface{}) error {
+	if err := eventsByKind[kind].Bind(log, v); err != nil {
+		return fmt.Errorf("standardevents: %s: %w", kind, err)
+	}
+	return nil
+}
@@ -0,0 +1,62 @@
+package taxreport
+
+import (
+	"encoding/csv"
+	"io"
+	"strconv"
+	"time"
+)
+
+// CSVHeader is the header of WriteCSV: the generic format crypto tax tools import, eg: Koinly and CoinTracking.
+var CSVHeader = []string{
+	"Date", "Sent Amount", "Sent Currency", "Received Amount", "Received Currency", "Fee Amount", "Fee Currency",
+	"Net Worth Amount", "Net Worth Currency", "Label", "Description", "TxHash",
+}
+
+// WriteCSV writes entries to w as CSV with CSVHeader, dates in UTC and amounts in token units. quoteCurrency is the
+// currency of the entry values that have no ValueCurrency, eg: `USD`.
+func WriteCSV(w io.Writer, entries []Entry, quoteCurrency string) error {
+	writer := csv.NewWriter(w)
+	if err := writer.Write(CSVHeader); err != nil {
+		return err
+	}
+	for _, entry := range entries {
+		sentAmount, sentCurrency := amountColumns(entry.Sent)
+		receivedAmount, receivedCurrency := amountColumns(entry.Received)
+		feeAmount, feeCurrency := amountColumns(entry.Fee)
+		value, currency := "", ""
+		if entry.Value != nil {
+			value, currency = strconv.FormatFloat(*entry.Value, 'f', -1, 64), quoteCurrency
+			if entry.ValueCurrency != "" {
+				currency = entry.ValueCurrency
+			}
+		}
+		record := []string{
+			entry.Time.UTC().Format(time.DateTime) + " UTC", sentAmount, sentCurrency, receivedAmount, receivedCurrency,
+			feeAmount, feeCurrency, value, currency, csvLabel(entry), entry.Description, entry.TxHash,
+		}
+		if err := writer.Write(record); err != nil {
+			return err
+		}
+	}
+	writer.Flush()
+	return writer.Error()
+}
+
+// csvLabel returns the label tax tools recognize for entry, if any.
+func csvLabel(entry Entry) string {
+	switch entry.Kind {
+	case Income:
+		return "income"
+	case Fee:
+		return "cost"
+	}
+	return ""
+}
+
+func amountColumns(amount *Amount) (string, string) {
+	if amount == nil {
+		return "", ""
+	}
+	return amount.Amount.String(), amount.Currency
+}
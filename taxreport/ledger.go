@@ -0,0 +1,362 @@
+// Package taxreport turns the transaction history of a wallet into a ledger of acquisitions, disposals, trades, fees
+// and income, and exports it as CSV for crypto tax tools.
+package taxreport
+
+import (
+	"math/big"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/covalenthq/covalent-api-sdk-go/pnl"
+	"github.com/covalenthq/covalent-api-sdk-go/services"
+	"github.com/covalenthq/covalent-api-sdk-go/standardevents"
+	"github.com/covalenthq/covalent-api-sdk-go/utils"
+)
+
+// Kind is the kind of a ledger entry.
+type Kind string
+
+const (
+	// Acquisition is an amount received, eg: a transfer in or an NFT purchase paid in the same entry.
+	Acquisition Kind = "acquisition"
+	// Disposal is an amount sent.
+	Disposal Kind = "disposal"
+	// Trade is an amount sent for an amount received, eg: a swap or an NFT sale.
+	Trade Kind = "trade"
+	// Fee is gas paid by a transaction that has no other entry.
+	Fee Kind = "fee"
+	// Income is an amount received from one of LedgerOpts.IncomeAddresses.
+	Income Kind = "income"
+)
+
+// Amount is an amount of a token or an NFT.
+type Amount struct {
+	Amount utils.TokenAmount
+	// The ticker symbol of the token, or `collection name #token id` for NFTs. The contract address when neither is known.
+	Currency        string
+	ContractAddress string
+}
+
+// Entry is a line of the ledger.
+type Entry struct {
+	Time   time.Time
+	Kind   Kind
+	TxHash string
+	// The amounts sent and received, and the gas paid. Nil when the entry has none.
+	Sent     *Amount
+	Received *Amount
+	Fee      *Amount
+	// The value of the entry in the quote currency: of the amount received, else of the amount sent, else of the fee.
+	// nil when unknown.
+	Value *float64
+	// The currency of Value when it differs from the quote currency of the request: `USD` for the quotes of DexDetails
+	// and NftSaleDetails. Empty otherwise.
+	ValueCurrency string
+	// What the entry comes from: `swap`, `nft_purchase`, `nft_sale`, `transfer` or `gas`.
+	Label       string
+	Description string
+}
+
+type LedgerOpts struct {
+	// Transfers to the wallet from these addresses are income, eg: staking reward distributors.
+	IncomeAddresses []string `json:"incomeAddresses,omitempty"`
+	// Values entries whose models carry no quote, eg: pnl.NewHistoricalPrices. Entries stay unvalued without it.
+	Prices pnl.PriceSource `json:"-"`
+}
+
+// BuildLedger returns the ledger of walletAddress from its transactions, eg: the results of
+// GetAllTransactionsForAddress with log events, sorted by time. Swaps are read from DexDetails, the token the wallet
+// paid being sent: the one with a positive amount when the other is negative, else the one the wallet transferred in
+// the log events, else token 0. NFT trades are read from NftSaleDetails; the token transfers of those transactions are
+// then left out.
+// Other transactions give their native value and the ERC-20 transfers of their log events. The gas of transactions
+// sent by the wallet is the fee of their first entry.
+//
+// Values come from the quote fields of the models and, when they are missing, from LedgerOpts.Prices. The quotes of
+// DexDetails and NftSaleDetails are in USD.
+func BuildLedger(walletAddress string, transactions []services.Transaction, opts ...LedgerOpts) ([]Entry, error) {
+	var options LedgerOpts
+	if len(opts) > 0 {
+		options = opts[0]
+	}
+	builder := &ledgerBuilder{wallet: walletAddress, options: options}
+	for _, tx := range transactions {
+		if err := builder.addTransaction(tx); err != nil {
+			return nil, err
+		}
+	}
+	sort.SliceStable(builder.entries, func(i, j int) bool { return builder.entries[i].Time.Before(builder.entries[j].Time) })
+	return builder.entries, nil
+}
+
+// usd is the currency of the quotes of DexDetails and NftSaleDetails.
+const usd = "USD"
+
+type ledgerBuilder struct {
+	wallet  string
+	options LedgerOpts
+	entries []Entry
+}
+
+func (b *ledgerBuilder) addTransaction(tx services.Transaction) error {
+	base := Entry{Time: timeOf(tx.BlockSignedAt), TxHash: stringOf(tx.TxHash)}
+	var entries []Entry
+	sentByWallet := b.is(tx.FromAddress)
+	successful := tx.Successful == nil || *tx.Successful
+
+	if successful && tx.DexDetails != nil && sentByWallet {
+		for _, swap := range *tx.DexDetails {
+			if swap.Event != nil && !strings.EqualFold(*swap.Event, "swap") {
+				continue
+			}
+			token0, ok0 := reportAmount(swap.Token0Amount, swap.Token0NumDecimals, swap.Token0Ticker, swap.Token0Address)
+			token1, ok1 := reportAmount(swap.Token1Amount, swap.Token1NumDecimals, swap.Token1Ticker, swap.Token1Address)
+			if !ok0 || !ok1 {
+				continue
+			}
+			entry := base
+			entry.Kind, entry.Label = Trade, "swap"
+			entry.Sent, entry.Received = &token0, &token1
+			value := firstOf(swap.Token1UsdQuote, swap.Token0UsdQuote)
+			if b.paidToken1(tx, swap) {
+				entry.Sent, entry.Received = &token1, &token0
+				value = firstOf(swap.Token0UsdQuote, swap.Token1UsdQuote)
+			}
+			entry.Description = stringOf(swap.ProtocolName)
+			if entry.Value = value; entry.Value != nil {
+				entry.ValueCurrency = usd
+			}
+			entries = append(entries, entry)
+		}
+	}
+	if successful && tx.NftSaleDetails != nil {
+		for _, sale := range *tx.NftSaleDetails {
+			entry, ok := b.nftEntry(base, sale)
+			if ok {
+				entries = append(entries, entry)
+			}
+		}
+	}
+
+	if len(entries) == 0 && successful {
+		if amount := tx.ValueAmount(); amount != nil && !amount.IsZero() {
+			native := Amount{Amount: *amount, Currency: nativeSymbol(tx), ContractAddress: pnl.NativeTokenAddress}
+			if entry, ok := b.transferEntry(base, tx.FromAddress, tx.ToAddress, native); ok {
+				entry.Value = tx.ValueQuote
+				entries = append(entries, entry)
+			}
+		}
+		if tx.LogEvents != nil {
+			for _, log := range *tx.LogEvents {
+				event, err := standardevents.Decode(log)
+				transfer, ok := event.(*standardevents.ERC20Transfer)
+				if err != nil || !ok || log.SenderAddress == nil || log.SenderContractDecimals == nil || *log.SenderContractDecimals < 0 {
+					continue
+				}
+				token := Amount{
+					Amount:          utils.NewTokenAmount(transfer.Value, *log.SenderContractDecimals),
+					Currency:        symbolOr(log.SenderContractTickerSymbol, *log.SenderAddress),
+					ContractAddress: strings.ToLower(*log.SenderAddress),
+				}
+				from, to := transfer.From.Lower(), transfer.To.Lower()
+				if entry, ok := b.transferEntry(base, &from, &to, token); ok {
+					entries = append(entries, entry)
+				}
+			}
+		}
+	}
+
+	if fees := tx.FeesPaidAmount(); sentByWallet && fees != nil && !fees.IsZero() {
+		fee := Amount{Amount: *fees, Currency: nativeSymbol(tx), ContractAddress: pnl.NativeTokenAddress}
+		if len(entries) == 0 {
+			entry := base
+			entry.Kind, entry.Label = Fee, "gas"
+			entry.Value = tx.GasQuote
+			entries = append(entries, entry)
+		}
+		entries[0].Fee = &fee
+	}
+
+	for i := range entries {
+		if err := b.value(&entries[i], tx.GasQuote); err != nil {
+			return err
+		}
+	}
+	b.entries = append(b.entries, entries...)
+	return nil
+}
+
+// paidToken1 reports whether the wallet sent token 1 of swap for token 0. An amount below zero left the pool, so it
+// was received. When both amounts are unsigned, the side the wallet transferred in the log events of tx was sent.
+func (b *ledgerBuilder) paidToken1(tx services.Transaction, swap services.DexReport) bool {
+	switch {
+	case strings.HasPrefix(stringOf(swap.Token0Amount), "-"):
+		return true
+	case strings.HasPrefix(stringOf(swap.Token1Amount), "-"):
+		return false
+	case tx.LogEvents == nil:
+		return false
+	}
+	for _, log := range *tx.LogEvents {
+		event, err := standardevents.Decode(log)
+		transfer, ok := event.(*standardevents.ERC20Transfer)
+		if err != nil || !ok || log.SenderAddress == nil || !strings.EqualFold(transfer.From.Lower(), b.wallet) {
+			continue
+		}
+		switch {
+		case strings.EqualFold(*log.SenderAddress, stringOf(swap.Token0Address)):
+			return false
+		case strings.EqualFold(*log.SenderAddress, stringOf(swap.Token1Address)):
+			return true
+		}
+	}
+	return false
+}
+
+func (b *ledgerBuilder) nftEntry(base Entry, sale services.NftSalesReport) (Entry, bool) {
+	buying, selling := b.is(sale.Taker), b.is(sale.Maker)
+	if buying == selling || sale.NftTokenPrice == nil || sale.CollectionAddress == nil {
+		return Entry{}, false
+	}
+	payment, ok := unitsAmount(strconv.FormatFloat(*sale.NftTokenPrice, 'f', -1, 64), sale.NumDecimals)
+	if !ok {
+		return Entry{}, false
+	}
+	nft := Amount{
+		Amount:          utils.NewTokenAmount(big.NewInt(1), 0),
+		Currency:        strings.TrimSpace(symbolOr(sale.CollectionName, *sale.CollectionAddress) + " #" + stringOf(sale.TokenId)),
+		ContractAddress: strings.ToLower(*sale.CollectionAddress),
+	}
+	price := Amount{Amount: payment, Currency: symbolOr(sale.TickerSymbol, stringOf(sale.TokenAddress)), ContractAddress: strings.ToLower(stringOf(sale.TokenAddress))}
+
+	entry := base
+	entry.Kind, entry.Description, entry.Value = Trade, stringOf(sale.ProtocolName), sale.NftTokenPriceUsd
+	if entry.Value != nil {
+		entry.ValueCurrency = usd
+	}
+	if buying {
+		entry.Label, entry.Sent, entry.Received = "nft_purchase", &price, &nft
+	} else {
+		entry.Label, entry.Sent, entry.Received = "nft_sale", &nft, &price
+	}
+	return entry, true
+}
+
+func (b *ledgerBuilder) transferEntry(base Entry, from *string, to *string, amount Amount) (Entry, bool) {
+	entry := base
+	entry.Label = "transfer"
+	switch {
+	case b.is(to) && !b.is(from):
+		entry.Kind, entry.Received = Acquisition, &amount
+		for _, address := range b.options.IncomeAddresses {
+			if from != nil && strings.EqualFold(address, *from) {
+				entry.Kind = Income
+			}
+		}
+	case b.is(from) && !b.is(to):
+		entry.Kind, entry.Sent = Disposal, &amount
+	default:
+		return Entry{}, false
+	}
+	return entry, true
+}
+
+// value sets the value of entry from LedgerOpts.Prices when the models carry none. NFT trades are valued by their
+// payment.
+func (b *ledgerBuilder) value(entry *Entry, gasQuote *float64) error {
+	if entry.Value != nil {
+		return nil
+	}
+	var amount *Amount
+	switch {
+	case entry.Label == "nft_purchase":
+		amount = entry.Sent
+	case entry.Received != nil:
+		amount = entry.Received
+	case entry.Sent != nil:
+		amount = entry.Sent
+	default:
+		if entry.Value = gasQuote; entry.Value != nil {
+			return nil
+		}
+		amount = entry.Fee
+	}
+	if amount == nil || amount.ContractAddress == "" || b.options.Prices == nil {
+		return nil
+	}
+	price, ok, err := b.options.Prices.Price(amount.ContractAddress, entry.Time)
+	if err != nil || !ok {
+		return err
+	}
+	value, _ := amount.Amount.MulFloat(price).Float64()
+	entry.Value = &value
+	return nil
+}
+
+func (b *ledgerBuilder) is(address *string) bool {
+	return address != nil && strings.EqualFold(*address, b.wallet)
+}
+
+// reportAmount reads an amount of a DEX report in token units, eg: `1.5`, without its sign.
+func reportAmount(amount *string, decimals *int64, ticker *string, address *string) (Amount, bool) {
+	if amount == nil || address == nil {
+		return Amount{}, false
+	}
+	parsed, ok := unitsAmount(strings.TrimPrefix(*amount, "-"), decimals)
+	if !ok {
+		return Amount{}, false
+	}
+	return Amount{Amount: parsed, Currency: symbolOr(ticker, *address), ContractAddress: strings.ToLower(*address)}, true
+}
+
+// unitsAmount reads an amount in token units with the given decimals, or with as many as it has when it has more.
+func unitsAmount(s string, decimals *int64) (utils.TokenAmount, bool) {
+	places := 0
+	if decimals != nil && *decimals > 0 {
+		places = int(*decimals)
+	}
+	if dot := strings.Index(s, "."); dot >= 0 && len(s)-dot-1 > places {
+		places = len(s) - dot - 1
+	}
+	amount, err := utils.ParseTokenAmount(s, places)
+	return amount, err == nil
+}
+
+func nativeSymbol(tx services.Transaction) string {
+	if tx.GasMetadata != nil && tx.GasMetadata.ContractTickerSymbol != nil {
+		return *tx.GasMetadata.ContractTickerSymbol
+	}
+	return pnl.NativeTokenAddress
+}
+
+func firstOf(values ...*float64) *float64 {
+	for _, value := range values {
+		if value != nil {
+			return value
+		}
+	}
+	return nil
+}
+
+func symbolOr(symbol *string, fallback string) string {
+	if symbol != nil && *symbol != "" {
+		return *symbol
+	}
+	return fallback
+}
+
+func timeOf(t *time.Time) time.Time {
+	if t == nil {
+		return time.Time{}
+	}
+	return *t
+}
+
+func stringOf(s *string) string {
+	if s == nil {
+		return ""
+	}
+	return *s
+}
@@ -0,0 +1,220 @@
+package tests
+
+import (
+	"encoding/hex"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/covalenthq/covalent-api-sdk-go/abi"
+	"github.com/covalenthq/covalent-api-sdk-go/genericmodels"
+)
+
+const testABI = `[
+	{"type":"function","name":"transfer","inputs":[{"name":"to","type":"address"},{"name":"value","type":"uint256"}]},
+	{"type":"event","name":"Transfer","anonymous":false,"inputs":[
+		{"name":"from","type":"address","indexed":true},
+		{"name":"to","type":"address","indexed":true},
+		{"name":"value","type":"uint256","indexed":false}]},
+	{"type":"event","name":"Complex","anonymous":false,"inputs":[
+		{"name":"who","type":"address","indexed":true},
+		{"name":"tag","type":"string","indexed":true},
+		{"name":"delta","type":"int8","indexed":true},
+		{"name":"amounts","type":"uint256[]","indexed":false},
+		{"name":"pairs","type":"tuple[]","indexed":false,"components":[
+			{"name":"owner","type":"address"},
+			{"name":"amount","type":"uint256"}]},
+		{"name":"note","type":"string","indexed":false},
+		{"name":"data","type":"bytes","indexed":false},
+		{"name":"id","type":"bytes4","indexed":false},
+		{"name":"flag","type":"bool","indexed":false},
+		{"name":"fixed","type":"uint16[2]","indexed":false}]}
+]`
+
+func paramValues(t *testing.T, decoded *genericmodels.DecodedItem) map[string]interface{} {
+	t.Helper()
+	values := map[string]interface{}{}
+	for _, param := range *decoded.Params {
+		values[*param.Name] = *param.Value
+	}
+	return values
+}
+
+func TestABI_DecodeTransferLog(t *testing.T) {
+	contract, err := abi.Parse([]byte(testABI))
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if len(contract.Events) != 2 {
+		t.Fatalf("Expected 2 events, got %d", len(contract.Events))
+	}
+
+	topics := []string{
+		"0xddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef",
+		"0x000000000000000000000000a9d1e08c7793af67e9d92fe308d5697fb81d3e43",
+		"0x00000000000000000000000077696bb39917c91a0c3908d577d5e322095425ca",
+	}
+	data := "0x00000000000000000000000000000000000000000000000000000000017d7840"
+	log := genericmodels.LogEvent{RawLogTopics: &topics, RawLogData: &data}
+
+	decoded, err := contract.DecodeLog(log)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if *decoded.Name != "Transfer" || *decoded.Signature != "Transfer(address,address,uint256)" {
+		t.Errorf("Unexpected event: %s %s", *decoded.Name, *decoded.Signature)
+	}
+	values := paramValues(t, decoded)
+	if values["from"] != "0xa9d1e08c7793af67e9d92fe308d5697fb81d3e43" || values["to"] != "0x77696bb39917c91a0c3908d577d5e322095425ca" || values["value"] != "25000000" {
+		t.Errorf("Unexpected values: %v", values)
+	}
+	if !*(*decoded.Params)[0].Indexed || *(*decoded.Params)[2].Indexed || !*(*decoded.Params)[2].Decoded {
+		t.Errorf("Unexpected param flags")
+	}
+
+	if err := contract.FillDecoded(&log); err != nil || log.Decoded == nil {
+		t.Errorf("Expected FillDecoded to set Decoded, got %v", err)
+	}
+
+	unknown := []string{"0x" + strings.Repeat("ab", 32)}
+	if _, err := contract.DecodeLog(genericmodels.LogEvent{RawLogTopics: &unknown}); !errors.Is(err, abi.ErrUnknownEvent) {
+		t.Errorf("Expected ErrUnknownEvent, got %v", err)
+	}
+	if _, err := contract.DecodeLog(genericmodels.LogEvent{}); !errors.Is(err, abi.ErrNoTopics) {
+		t.Errorf("Expected ErrNoTopics, got %v", err)
+	}
+	short := "0x01"
+	if _, err := contract.DecodeLog(genericmodels.LogEvent{RawLogTopics: &topics, RawLogData: &short}); err == nil {
+		t.Errorf("Expected an error for truncated data")
+	}
+}
+
+func TestABI_DecodeSpecExample(t *testing.T) {
+	// The encoding of f(0x123, [0x456, 0x789], "1234567890", "Hello, world!") given in the Solidity ABI specification.
+	event, err := abi.ParseEvent("f(uint256 a, uint32[] b, bytes10 c, bytes d)")
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	data := "0x" + strings.Join([]string{
+		"0000000000000000000000000000000000000000000000000000000000000123",
+		"0000000000000000000000000000000000000000000000000000000000000080",
+		"3132333435363738393000000000000000000000000000000000000000000000",
+		"00000000000000000000000000000000000000000000000000000000000000e0",
+		"0000000000000000000000000000000000000000000000000000000000000002",
+		"0000000000000000000000000000000000000000000000000000000000000456",
+		"0000000000000000000000000000000000000000000000000000000000000789",
+		"000000000000000000000000000000000000000000000000000000000000000d",
+		"48656c6c6f2c20776f726c642100000000000000000000000000000000000000",
+	}, "")
+	decoded, err := event.Decode([]string{event.Topic()}, data)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	values := paramValues(t, decoded)
+	b := values["b"].([]interface{})
+	if values["a"] != "291" || len(b) != 2 || b[0] != "1110" || b[1] != "1929" {
+		t.Errorf("Unexpected values: %v", values)
+	}
+	if values["c"] != "0x"+hex.EncodeToString([]byte("1234567890")) || values["d"] != "0x"+hex.EncodeToString([]byte("Hello, world!")) {
+		t.Errorf("Unexpected bytes: %v %v", values["c"], values["d"])
+	}
+}
+
+func TestABI_DecodeDynamicTypes(t *testing.T) {
+	contract := abi.MustParse(testABI)
+	event, ok := contract.EventByName("Complex")
+	if !ok {
+		t.Fatalf("Expected the Complex event")
+	}
+	if event.Signature() != "Complex(address,string,int8,uint256[],(address,uint256)[],string,bytes,bytes4,bool,uint16[2])" {
+		t.Errorf("Unexpected signature: %s", event.Signature())
+	}
+
+	topics := []string{
+		"0x34a51b7bc25a6204acf130a066a28fa839219d675f36e9295cd445bad3aed957",
+		// who, the hash of "hello", and -3
+		"0x0000000000000000000000005aaeb6053f3e94c9b9a09f33669435e7ef1beaed",
+		"0x1c8aff950685c2ed4bc3174f3472287b56d9517b9c948127319a09a7a36deac8",
+		"0xfffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffd",
+	}
+	data := "0x" + strings.Join([]string{
+		// Offsets of amounts, pairs, note and data, then id, flag and fixed in place.
+		"0000000000000000000000000000000000000000000000000000000000000100",
+		"0000000000000000000000000000000000000000000000000000000000000160",
+		"0000000000000000000000000000000000000000000000000000000000000200",
+		"0000000000000000000000000000000000000000000000000000000000000260",
+		"0102030400000000000000000000000000000000000000000000000000000000",
+		"0000000000000000000000000000000000000000000000000000000000000001",
+		"0000000000000000000000000000000000000000000000000000000000000001",
+		"000000000000000000000000000000000000000000000000000000000000ffff",
+		// amounts: [1, 2^256-1]
+		"0000000000000000000000000000000000000000000000000000000000000002",
+		"0000000000000000000000000000000000000000000000000000000000000001",
+		"ffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffff",
+		// pairs: [(0x5aae…, 7), (0xfb69…, 8)]
+		"0000000000000000000000000000000000000000000000000000000000000002",
+		"0000000000000000000000005aaeb6053f3e94c9b9a09f33669435e7ef1beaed",
+		"0000000000000000000000000000000000000000000000000000000000000007",
+		"000000000000000000000000fb6916095ca1df60bb79ce92ce3ea74c37c5d359",
+		"0000000000000000000000000000000000000000000000000000000000000008",
+		// note
+		"000000000000000000000000000000000000000000000000000000000000002b",
+		"61206e6f74652074686174206973206c6f6e676572207468616e207468697274",
+		"792d74776f206279746573000000000000000000000000000000000000000000",
+		// data
+		"0000000000000000000000000000000000000000000000000000000000000004",
+		"deadbeef00000000000000000000000000000000000000000000000000000000",
+	}, "")
+
+	decoded, err := contract.DecodeLog(genericmodels.LogEvent{RawLogTopics: &topics, RawLogData: &data})
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	values := paramValues(t, decoded)
+	if values["who"] != "0x5aaeb6053f3e94c9b9a09f33669435e7ef1beaed" || values["delta"] != "-3" {
+		t.Errorf("Unexpected indexed values: %v", values)
+	}
+	tag := (*decoded.Params)[1]
+	if *tag.Decoded || values["tag"] != "0x1c8aff950685c2ed4bc3174f3472287b56d9517b9c948127319a09a7a36deac8" {
+		t.Errorf("Expected the string topic to be the undecoded hash, got %v", values["tag"])
+	}
+	amounts := values["amounts"].([]interface{})
+	if len(amounts) != 2 || amounts[1] != "115792089237316195423570985008687907853269984665640564039457584007913129639935" {
+		t.Errorf("Unexpected amounts: %v", amounts)
+	}
+	decodedPairs := values["pairs"].([]interface{})
+	second := decodedPairs[1].([]genericmodels.Param)
+	if *second[0].Name != "owner" || *second[0].Value != "0xfb6916095ca1df60bb79ce92ce3ea74c37c5d359" || *second[1].Value != "8" {
+		t.Errorf("Unexpected pair: %v", second)
+	}
+	if values["note"] != "a note that is longer than thirty-two bytes" || values["data"] != "0xdeadbeef" || values["id"] != "0x01020304" || values["flag"] != true {
+		t.Errorf("Unexpected values: %v", values)
+	}
+	if fixed := values["fixed"].([]interface{}); fixed[0] != "1" || fixed[1] != "65535" {
+		t.Errorf("Unexpected fixed array: %v", fixed)
+	}
+}
+
+func TestABI_ParseArtifactAndTypes(t *testing.T) {
+	contract, err := abi.Parse([]byte(`{"contractName":"Token","abi":` + testABI + `}`))
+	if err != nil || len(contract.Events) != 2 {
+		t.Fatalf("Expected the artifact ABI to parse, got %v", err)
+	}
+	for _, invalid := range []string{"uint7", "bytes33", "fixed128x18", "uint256[0]", "tuple"} {
+		if _, err := abi.ParseType(invalid); err == nil {
+			t.Errorf("Expected %s to be rejected", invalid)
+		}
+	}
+	function, err := abi.ParseType("function")
+	if err != nil || function.String() != "function" || function.Kind != abi.FixedBytesKind || function.Size != 24 {
+		t.Errorf("Expected function to keep its name and encode as bytes24, got %v %v", function, err)
+	}
+	callback, _ := abi.ParseEvent("Callback(function fn)")
+	if callback.Signature() != "Callback(function)" {
+		t.Errorf("Unexpected signature: %s", callback.Signature())
+	}
+	nested, err := abi.ParseType("uint[2][]")
+	if err != nil || nested.String() != "uint256[2][]" || !nested.IsDynamic() || nested.Elem.IsDynamic() {
+		t.Errorf("Unexpected nested type: %v %v", nested, err)
+	}
+}
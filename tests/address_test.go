@@ -0,0 +1,141 @@
+package tests
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/covalenthq/covalent-api-sdk-go/chains"
+	"github.com/covalenthq/covalent-api-sdk-go/covalentclient"
+	"github.com/covalenthq/covalent-api-sdk-go/testutil"
+	"github.com/covalenthq/covalent-api-sdk-go/utils"
+)
+
+var checksumAddresses = []string{
+	"0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAed",
+	"0xfB6916095ca1df60bB79Ce92cE3Ea74c37c5d359",
+	"0xdbF03B407c01E7cD3CBea99509d93f8DDDC8C6FB",
+	"0xD1220A0cf47c7B9Be7A2E6BA89F429762e7b9aDb",
+}
+
+func TestAddress_Checksum(t *testing.T) {
+	for _, checksummed := range checksumAddresses {
+		address, err := utils.ParseAddress(strings.ToLower(checksummed))
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		if address.Hex() != checksummed {
+			t.Errorf("Expected %s, got %s", checksummed, address.Hex())
+		}
+		if address.Lower() != strings.ToLower(checksummed) {
+			t.Errorf("Unexpected lowercase form: %s", address.Lower())
+		}
+		if !utils.IsChecksumAddress(checksummed) || utils.IsChecksumAddress(strings.ToLower(checksummed)) {
+			t.Errorf("Unexpected checksum detection for %s", checksummed)
+		}
+	}
+}
+
+func TestAddress_Parse(t *testing.T) {
+	valid := []string{
+		"0x5aaeb6053f3e94c9b9a09f33669435e7ef1beaed",
+		"0x5AAEB6053F3E94C9B9A09F33669435E7EF1BEAED",
+		"0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAed",
+	}
+	for _, s := range valid {
+		if !utils.IsValidAddress(s) {
+			t.Errorf("Expected %s to be valid", s)
+		}
+	}
+
+	invalid := []string{
+		"",
+		"5aaeb6053f3e94c9b9a09f33669435e7ef1beaed",
+		"0x5aaeb6053f3e94c9b9a09f33669435e7ef1bea",
+		"0x5aaeb6053f3e94c9b9a09f33669435e7ef1beaedaa",
+		"0x5aaeb6053f3e94c9b9a09f33669435e7ef1beaeg",
+		"0x5AAeb6053F3E94C9b9A09f33669435E7Ef1BeAed",
+		"demo.eth",
+	}
+	for _, s := range invalid {
+		if utils.IsValidAddress(s) {
+			t.Errorf("Expected %s to be invalid", s)
+		}
+	}
+
+	if !utils.SameAddress(valid[0], valid[2]) || utils.SameAddress(valid[0], checksumAddresses[1]) {
+		t.Errorf("Unexpected case-insensitive comparison")
+	}
+}
+
+func TestAddress_MapKeyAndJSON(t *testing.T) {
+	balances := map[utils.Address]int{utils.MustParseAddress("0x5aaeb6053f3e94c9b9a09f33669435e7ef1beaed"): 1}
+	if balances[utils.MustParseAddress(checksumAddresses[0])] != 1 {
+		t.Errorf("Expected lookups to ignore case")
+	}
+
+	type holder struct {
+		Owner   utils.Address  `json:"owner"`
+		Spender *utils.Address `json:"spender"`
+	}
+	var decoded holder
+	if err := json.Unmarshal([]byte(`{"owner":"0x5aaeb6053f3e94c9b9a09f33669435e7ef1beaed","spender":null}`), &decoded); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if decoded.Spender != nil || decoded.Owner.Hex() != checksumAddresses[0] {
+		t.Errorf("Unexpected decoded value: %+v", decoded)
+	}
+	encoded, err := json.Marshal(decoded)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if string(encoded) != `{"owner":"0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAed","spender":null}` {
+		t.Errorf("Unexpected encoding: %s", encoded)
+	}
+	if err := json.Unmarshal([]byte(`{"owner":"0x5AAeb6053F3E94C9b9A09f33669435E7Ef1BeAed"}`), &decoded); err == nil {
+		t.Errorf("Expected a checksum error")
+	}
+
+	keyed, err := json.Marshal(balances)
+	if err != nil || string(keyed) != `{"0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAed":1}` {
+		t.Errorf("Unexpected map encoding: %s %v", keyed, err)
+	}
+}
+
+func TestKeccak256(t *testing.T) {
+	cases := map[string]string{
+		"":                                  "c5d2460186f7233c927e7db2dcc703c0e500b653ca82273b7bfad8045d85a470",
+		"Transfer(address,address,uint256)": "ddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef",
+		strings.Repeat("a", 300):            "",
+	}
+	for input, expected := range cases {
+		digest := hex.EncodeToString(utils.Keccak256([]byte(input)))
+		split := hex.EncodeToString(utils.Keccak256([]byte(input[:len(input)/3]), []byte(input[len(input)/3:])))
+		if digest != split {
+			t.Errorf("Expected split input to hash the same, got %s and %s", digest, split)
+		}
+		if expected != "" && digest != expected {
+			t.Errorf("Expected %s, got %s", expected, digest)
+		}
+	}
+}
+
+func TestAddress_ServiceArgument(t *testing.T) {
+	var requested []string
+	testutil.UseMockTransport(t, func(w http.ResponseWriter, r *http.Request) {
+		requested = append(requested, r.URL.Path)
+		fmt.Fprint(w, `{"data":{"items":[]},"error":false,"error_code":null,"error_message":null}`)
+	})
+
+	client := covalentclient.CovalentClient(testutil.MockAPIKey)
+	address := utils.MustParseAddress(strings.ToLower(checksumAddresses[0]))
+	if _, err := client.BalanceService.GetTokenBalancesForWalletAddress(chains.EthMainnet, address.String()); err != nil {
+		t.Errorf("Unexpected error: %v", err)
+	}
+	if expected := "/v1/eth-mainnet/address/" + checksumAddresses[0] + "/balances_v2/"; len(requested) != 1 || requested[0] != expected {
+		t.Errorf("Unexpected requests: %v", requested)
+	}
+}
@@ -0,0 +1,225 @@
+package tests
+
+import (
+	"encoding/json"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/covalenthq/covalent-api-sdk-go/abi"
+	"github.com/covalenthq/covalent-api-sdk-go/approvals"
+	"github.com/covalenthq/covalent-api-sdk-go/services"
+)
+
+const (
+	approvalSpender = "0x1111111254EEB25477B68FB85ED929F73A960582"
+	approvalToken   = "0xa0b86991c6218b36c1d19d4a2e9eb0ce3606eb48"
+)
+
+func TestApprovals_RevokeCalldata(t *testing.T) {
+	if selector := abi.MustParseFunction("function approve(address spender, uint amount) external returns (bool)").Selector(); string(selector) != "\x09\x5e\xa7\xb3" {
+		t.Errorf("Unexpected approve selector: %x", selector)
+	}
+	if _, err := abi.ParseFunction("approve"); err == nil {
+		t.Errorf("Expected an invalid signature to be rejected")
+	}
+
+	revoke, err := approvals.RevokeAllowance(approvalToken, approvalSpender)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	expected := "0x095ea7b3" + "0000000000000000000000001111111254eeb25477b68fb85ed929f73a960582" + "0000000000000000000000000000000000000000000000000000000000000000"
+	if revoke.To != approvalToken || revoke.Data != expected {
+		t.Errorf("Unexpected revoke: %+v", revoke)
+	}
+
+	revoke, err = approvals.RevokeApprovalForAll("0xBC4CA0EDA7647A8AB7C2061C2E118A18A936F13D", approvalSpender)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	expected = "0xa22cb465" + "0000000000000000000000001111111254eeb25477b68fb85ed929f73a960582" + "0000000000000000000000000000000000000000000000000000000000000000"
+	if revoke.To != "0xbc4ca0eda7647a8ab7c2061c2e118a18a936f13d" || revoke.Data != expected {
+		t.Errorf("Unexpected revoke: %+v", revoke)
+	}
+
+	revoke, _ = approvals.RevokeTokenApproval("0xbc4ca0eda7647a8ab7c2061c2e118a18a936f13d", big.NewInt(42))
+	if revoke.Data != "0x095ea7b3"+"0000000000000000000000000000000000000000000000000000000000000000"+"000000000000000000000000000000000000000000000000000000000000002a" {
+		t.Errorf("Unexpected revoke: %+v", revoke)
+	}
+}
+
+func TestApprovals_Report(t *testing.T) {
+	var tokens services.ApprovalsResponse
+	if err := json.Unmarshal([]byte(`{"address":"0xwallet","chain_name":"eth-mainnet","items":[{"token_address":"0xA0B86991C6218B36C1D19D4A2E9EB0CE3606EB48","ticker_symbol":"USDC","contract_decimals":6,"spenders":[
+		{"block_signed_at":"2021-01-01T00:00:00Z","tx_hash":"0xa","spender_address":"0x1111111254EEB25477B68FB85ED929F73A960582","spender_address_label":"1inch","allowance":"UNLIMITED","value_at_risk_quote":100,"risk_factor":"CONSIDER REVOKING"},
+		{"block_signed_at":"2023-05-01T00:00:00Z","tx_hash":"0xb","spender_address":"0x2222222222222222222222222222222222222222","allowance":"50","value_at_risk_quote":50},
+		{"block_signed_at":"2023-05-01T00:00:00Z","tx_hash":"0xc","spender_address":"0x3333333333333333333333333333333333333333","spender_address_label":"Uniswap","allowance":"115792089237316195423570985008687907853269984665640564039457584007913129639935"}]}]}`), &tokens); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	var nfts services.NftApprovalsResponse
+	if err := json.Unmarshal([]byte(`{"address":"0xwallet","chain_name":"eth-mainnet","items":[{"contract_address":"0xBC4CA0EDA7647A8AB7C2061C2E118A18A936F13D","contract_ticker_symbol":"BAYC","spenders":[
+		{"block_signed_at":"2023-05-01T00:00:00Z","spender_address":"0x4444444444444444444444444444444444444444","spender_address_label":"OpenSea","token_ids_approved":"ALL","allowance":"true"},
+		{"block_signed_at":"2023-05-01T00:00:00Z","spender_address":"0x5555555555555555555555555555555555555555","spender_address_label":"Blur","token_ids_approved":"1, 7"}]}]}`), &nfts); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	now := time.Date(2023, 6, 1, 0, 0, 0, 0, time.UTC)
+	report, err := approvals.BuildReport(&tokens, &nfts, approvals.ReportOpts{Now: &now})
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if len(report.Approvals) != 5 || report.TotalValueAtRiskQuote != 150 || report.Flagged != 4 || report.Address != "0xwallet" {
+		t.Fatalf("Unexpected report: %+v", report)
+	}
+
+	oneInch, unlabeled := report.Approvals[0], report.Approvals[1]
+	if oneInch.SpenderLabel != "1inch" || !oneInch.HasRisk(approvals.UnlimitedAllowance) || !oneInch.HasRisk(approvals.StaleApproval) || oneInch.HasRisk(approvals.UnlabeledSpender) {
+		t.Errorf("Unexpected approval: %+v", oneInch)
+	}
+	if len(unlabeled.Risks) != 1 || !unlabeled.HasRisk(approvals.UnlabeledSpender) || unlabeled.Unlimited {
+		t.Errorf("Unexpected approval: %+v", unlabeled)
+	}
+
+	byContract := map[string]approvals.Approval{}
+	for _, approval := range report.Approvals[2:] {
+		byContract[approval.SpenderAddress] = approval
+	}
+	if uniswap := byContract["0x3333333333333333333333333333333333333333"]; !uniswap.Unlimited || uniswap.Kind != approvals.TokenAllowance {
+		t.Errorf("Expected the largest uint256 to be unlimited: %+v", uniswap)
+	}
+	if openSea := byContract["0x4444444444444444444444444444444444444444"]; !openSea.Unlimited || len(openSea.Revoke) != 1 || openSea.Revoke[0].Data[:10] != "0xa22cb465" {
+		t.Errorf("Unexpected collection approval: %+v", openSea)
+	}
+	blur := byContract["0x5555555555555555555555555555555555555555"]
+	if blur.Unlimited || blur.Flagged() || len(blur.TokenIds) != 2 || len(blur.Revoke) != 2 || blur.Revoke[1].Description != "approve(0x0000000000000000000000000000000000000000, 7)" {
+		t.Errorf("Unexpected token approval: %+v", blur)
+	}
+}
+
+func TestApprovals_ReportNotGranted(t *testing.T) {
+	var nfts services.NftApprovalsResponse
+	if err := json.Unmarshal([]byte(`{"address":"0xwallet","chain_name":"eth-mainnet","items":[{"contract_address":"0xBC4CA0EDA7647A8AB7C2061C2E118A18A936F13D","spenders":[
+		{"block_signed_at":"2020-05-01T00:00:00Z","spender_address":"0x4444444444444444444444444444444444444444","token_ids_approved":"ALL","allowance":"false"}]}]}`), &nfts); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	report, err := approvals.BuildReport(nil, &nfts)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if len(report.Approvals) != 1 || report.Flagged != 0 {
+		t.Fatalf("Unexpected report: %+v", report)
+	}
+	if approval := report.Approvals[0]; approval.Unlimited || approval.Flagged() || len(approval.Revoke) != 0 {
+		t.Errorf("Expected an approval of false to grant nothing, got %+v", approval)
+	}
+}
+
+func approvalsSnapshot(t *testing.T, takenAt string, tokens string, nfts string) approvals.Snapshot {
+	snapshot := approvals.Snapshot{Tokens: &services.ApprovalsResponse{}, Nfts: &services.NftApprovalsResponse{}}
+	snapshot.TakenAt, _ = time.Parse(time.RFC3339, takenAt)
+	if err := json.Unmarshal([]byte(`{"address":"0xwallet","chain_name":"eth-mainnet","items":[{"token_address":"0xa0b86991c6218b36c1d19d4a2e9eb0ce3606eb48","ticker_symbol":"USDC","contract_decimals":6,"spenders":[`+tokens+`]}]}`), snapshot.Tokens); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if err := json.Unmarshal([]byte(`{"address":"0xwallet","chain_name":"eth-mainnet","items":[{"contract_address":"0xbc4ca0eda7647a8ab7c2061c2e118a18a936f13d","spenders":[`+nfts+`]}]}`), snapshot.Nfts); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	return snapshot
+}
+
+func TestApprovals_Diff(t *testing.T) {
+	before := approvalsSnapshot(t, "2023-05-01T00:00:00Z",
+		`{"spender_address":"0x0000000000000000000000000000000000000001","allowance":"100","value_at_risk_quote":100},
+		{"spender_address":"0x0000000000000000000000000000000000000002","allowance":"100","value_at_risk_quote":100},
+		{"spender_address":"0x0000000000000000000000000000000000000003","allowance":"100","value_at_risk_quote":100},
+		{"spender_address":"0x0000000000000000000000000000000000000004","allowance":"100","value_at_risk_quote":100}`,
+		`{"spender_address":"0x000000000000000000000000000000000000000a","token_ids_approved":"1"},
+		{"spender_address":"0x000000000000000000000000000000000000000b","token_ids_approved":"ALL"},
+		{"spender_address":"0x000000000000000000000000000000000000000d","token_ids_approved":"ALL","allowance":"true"}`)
+	after := approvalsSnapshot(t, "2023-05-02T00:00:00Z",
+		`{"spender_address":"0x0000000000000000000000000000000000000001","allowance":"UNLIMITED","value_at_risk_quote":250},
+		{"spender_address":"0x0000000000000000000000000000000000000002","allowance":"40","value_at_risk_quote":40},
+		{"spender_address":"0x0000000000000000000000000000000000000003","allowance":"0"},
+		{"spender_address":"0x0000000000000000000000000000000000000005","allowance":"10","value_at_risk_quote":10}`,
+		`{"spender_address":"0x000000000000000000000000000000000000000a","token_ids_approved":"1, 2"},
+		{"spender_address":"0x000000000000000000000000000000000000000c","token_ids_approved":"ALL"},
+		{"spender_address":"0x000000000000000000000000000000000000000d","token_ids_approved":"ALL","allowance":"false"}`)
+
+	minChange := 100.0
+	changes, err := approvals.DiffSnapshots(before, after, approvals.DiffOpts{MinValueAtRiskChange: &minChange})
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	var got []string
+	for _, change := range changes {
+		// The last two hex digits of the spender identify it.
+		got = append(got, change.Approval().SpenderAddress[40:]+" "+string(change.Kind))
+	}
+	expected := []string{
+		"01 allowance_increased", "01 value_at_risk_changed", "02 allowance_decreased", "03 revoked",
+		"04 revoked", "05 new_spender", "0a allowance_increased", "0b revoked", "0c new_spender", "0d revoked",
+	}
+	if len(got) != len(expected) {
+		t.Fatalf("Expected changes %v, got %v", expected, got)
+	}
+	for i := range expected {
+		if got[i] != expected[i] {
+			t.Errorf("Expected change %d to be %q, got %q", i, expected[i], got[i])
+		}
+	}
+	if delta := changes[1].ValueAtRiskDelta(); delta != 150 {
+		t.Errorf("Expected a value at risk delta of 150, got %v", delta)
+	}
+	if changes[4].After != nil || changes[5].Before != nil {
+		t.Errorf("Unexpected revoked or new change: %+v %+v", changes[4], changes[5])
+	}
+}
+
+func TestApprovals_Record(t *testing.T) {
+	first := approvalsSnapshot(t, "2023-05-01T00:00:00Z", `{"spender_address":"0x0000000000000000000000000000000000000001","allowance":"100"}`, ``)
+	second := approvalsSnapshot(t, "2023-05-02T00:00:00Z", `{"spender_address":"0x0000000000000000000000000000000000000001","allowance":"200"}`, ``)
+	key := approvals.SnapshotKey("eth-mainnet", "0xWallet")
+
+	for _, store := range []approvals.SnapshotStore{approvals.NewMemoryStore(), approvals.NewFileStore(t.TempDir())} {
+		if changes, err := approvals.Record(store, key, first); err != nil || len(changes) != 0 {
+			t.Fatalf("Expected no change on the first record, got %v, %v", changes, err)
+		}
+		changes, err := approvals.Record(store, key, second)
+		if err != nil || len(changes) != 1 || changes[0].Kind != approvals.AllowanceIncreased {
+			t.Fatalf("Expected an allowance increase, got %+v, %v", changes, err)
+		}
+		saved, err := store.Load(key)
+		if err != nil || saved == nil || !saved.TakenAt.Equal(second.TakenAt) || *(*saved.Tokens.Items[0].Spenders)[0].Allowance != "200" {
+			t.Errorf("Unexpected saved snapshot: %+v, %v", saved, err)
+		}
+		if missing, err := store.Load("eth-mainnet:0xother"); missing != nil || err != nil {
+			t.Errorf("Expected no snapshot, got %+v, %v", missing, err)
+		}
+	}
+}
+
+func TestApprovals_RecordPartialSnapshot(t *testing.T) {
+	first := approvalsSnapshot(t, "2023-05-01T00:00:00Z", `{"spender_address":"0x0000000000000000000000000000000000000001","allowance":"100"}`, ``)
+	partial := approvalsSnapshot(t, "2023-05-02T00:00:00Z", ``, ``)
+	partial.Tokens = nil
+	third := approvalsSnapshot(t, "2023-05-03T00:00:00Z",
+		`{"spender_address":"0x0000000000000000000000000000000000000001","allowance":"100"},
+		{"spender_address":"0x0000000000000000000000000000000000000002","allowance":"50"}`, ``)
+	key := approvals.SnapshotKey("eth-mainnet", "0xWallet")
+
+	for _, store := range []approvals.SnapshotStore{approvals.NewMemoryStore(), approvals.NewFileStore(t.TempDir())} {
+		if _, err := approvals.Record(store, key, first); err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		if changes, err := approvals.Record(store, key, partial); err != nil || len(changes) != 0 {
+			t.Fatalf("Expected no change for a snapshot without tokens, got %+v, %v", changes, err)
+		}
+		saved, err := store.Load(key)
+		if err != nil || saved.Tokens == nil || !saved.TakenAt.Equal(partial.TakenAt) {
+			t.Fatalf("Expected the token approvals to be carried over, got %+v, %v", saved, err)
+		}
+		changes, err := approvals.Record(store, key, third)
+		if err != nil || len(changes) != 1 || changes[0].Kind != approvals.NewSpender || changes[0].Approval().SpenderAddress[40:] != "02" {
+			t.Errorf("Expected only the new spender, got %+v, %v", changes, err)
+		}
+	}
+}
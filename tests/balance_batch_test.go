@@ -0,0 +1,139 @@
+package tests
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/covalenthq/covalent-api-sdk-go/chains"
+	"github.com/covalenthq/covalent-api-sdk-go/covalentclient"
+	"github.com/covalenthq/covalent-api-sdk-go/services"
+	"github.com/covalenthq/covalent-api-sdk-go/testutil"
+)
+
+func TestGetTokenBalancesForWalletAddresses(t *testing.T) {
+	var mu sync.Mutex
+	attempts := map[string]int{}
+	testutil.UseMockTransport(t, func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		attempts[r.URL.Path]++
+		attempt := attempts[r.URL.Path]
+		mu.Unlock()
+
+		switch {
+		case strings.Contains(r.URL.Path, "/0xbad/"):
+			w.WriteHeader(http.StatusBadRequest)
+			fmt.Fprint(w, `{"data":null,"error":true,"error_code":400,"error_message":"Malformed address provided: 0xbad"}`)
+		case strings.Contains(r.URL.Path, "/0xflaky/") && attempt == 1:
+			w.WriteHeader(http.StatusInternalServerError)
+			fmt.Fprint(w, `{"data":null,"error":true,"error_code":500,"error_message":"Internal error"}`)
+		default:
+			fmt.Fprint(w, `{"data":{"address":"0x1","chain_name":"eth-mainnet","items":[{"contract_ticker_symbol":"ETH","balance":"100"}]},"error":false,"error_code":null,"error_message":null}`)
+		}
+	})
+
+	client := covalentclient.CovalentClient(testutil.MockAPIKey)
+	requests := []services.WalletBalanceRequest{
+		{ChainName: chains.EthMainnet, WalletAddress: "0x1"},
+		{ChainName: chains.MaticMainnet, WalletAddress: "0x1"},
+		{ChainName: chains.EthMainnet, WalletAddress: "0xflaky"},
+		{ChainName: chains.EthMainnet, WalletAddress: "0xbad"},
+	}
+
+	maxRetries := 1
+	var progress []int
+	var retries []int
+	var results []services.WalletBalanceBatchResult[services.BalancesResponse]
+	for result := range client.BalanceService.GetTokenBalancesForWalletAddresses(requests, services.WalletBalanceBatchOpts{
+		MaxRetries: &maxRetries,
+		RetryDelay: func(retry int) time.Duration {
+			mu.Lock()
+			defer mu.Unlock()
+			retries = append(retries, retry)
+			return 0
+		},
+		OnProgress: func(completed int, total int) { progress = append(progress, completed) },
+	}) {
+		results = append(results, result)
+	}
+
+	if len(results) != len(requests) {
+		t.Fatalf("Expected %d results, got %d", len(requests), len(results))
+	}
+	if len(progress) != len(requests) || progress[len(progress)-1] != len(requests) {
+		t.Errorf("Unexpected progress reports: %v", progress)
+	}
+	for _, result := range results {
+		switch result.Request.WalletAddress {
+		case "0xbad":
+			if result.Err == nil || result.Attempts != 1 {
+				t.Errorf("Expected a single failed attempt for 0xbad, got %d attempts and error %v", result.Attempts, result.Err)
+			}
+		case "0xflaky":
+			if result.Err != nil || result.Attempts != 2 {
+				t.Errorf("Expected 0xflaky to succeed on retry, got %d attempts and error %v", result.Attempts, result.Err)
+			}
+		default:
+			if result.Err != nil || len(result.Response.Data.Items) != 1 {
+				t.Errorf("Unexpected result for %v: %v", result.Request, result.Err)
+			}
+		}
+	}
+
+	if len(retries) != 1 || retries[0] != 1 {
+		t.Errorf("Expected a single first retry, got %v", retries)
+	}
+
+	failed := services.FailedWalletBalanceRequests(results)
+	if len(failed) != 1 || failed[0].WalletAddress != "0xbad" {
+		t.Errorf("Expected only 0xbad to be reported as failed, got %v", failed)
+	}
+}
+
+func TestDefaultBatchRetryDelay(t *testing.T) {
+	for retry, expected := range map[int]time.Duration{1: time.Second, 2: 2 * time.Second, 3: 4 * time.Second} {
+		if delay := services.DefaultBatchRetryDelay(retry); delay != expected {
+			t.Errorf("Expected retry %d to wait %v, got %v", retry, expected, delay)
+		}
+	}
+}
+
+func TestGetNativeTokenBalances_Cancel(t *testing.T) {
+	testutil.UseMockTransport(t, func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"data":{"address":"0x1","chain_name":"eth-mainnet","items":[{"contract_ticker_symbol":"ETH","balance":"100"}]},"error":false,"error_code":null,"error_message":null}`)
+	})
+
+	client := covalentclient.CovalentClient(testutil.MockAPIKey)
+	var requests []services.WalletBalanceRequest
+	for i := 0; i < 20; i++ {
+		requests = append(requests, services.WalletBalanceRequest{ChainName: chains.EthMainnet, WalletAddress: fmt.Sprintf("0x%d", i)})
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	results := client.BalanceService.GetNativeTokenBalances(requests, services.WalletBalanceBatchOpts{Context: ctx})
+	<-results
+	// The consumer stops reading: the workers blocked on sending must give up and close the channel.
+	cancel()
+	time.Sleep(50 * time.Millisecond)
+
+	done := make(chan int)
+	go func() {
+		n := 0
+		for range results {
+			n++
+		}
+		done <- n
+	}()
+	select {
+	case n := <-done:
+		if n >= len(requests)-1 {
+			t.Errorf("Expected the batch to stop early, got %d more results", n)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatalf("Expected the result channel to be closed after cancellation")
+	}
+}
@@ -1,6 +1,7 @@
 package tests
 
 import (
+	"encoding/json"
 	"math/big"
 	"reflect"
 	"testing"
@@ -50,3 +51,53 @@ func TestBigInt_UnmarshalJSON_NilInt(t *testing.T) {
 		t.Errorf("Unexpected value. Got: %v, Expected: %v", b.Int, expected)
 	}
 }
+
+func TestBigInt_UnmarshalJSONNumbersAndNull(t *testing.T) {
+	cases := map[string]string{
+		`123456789`: "123456789",
+		`-42`:       "-42",
+		`1e18`:      "1000000000000000000",
+		`"340282366920938463463374607431768211455"`: "340282366920938463463374607431768211455",
+	}
+	for input, expected := range cases {
+		b := &utils.BigInt{}
+		if err := b.UnmarshalJSON([]byte(input)); err != nil {
+			t.Errorf("Unexpected error for %s: %v", input, err)
+			continue
+		}
+		if b.String() != expected {
+			t.Errorf("Unexpected value for %s. Got: %v, Expected: %v", input, b, expected)
+		}
+	}
+
+	b := &utils.BigInt{Int: big.NewInt(1)}
+	if err := b.UnmarshalJSON([]byte("null")); err != nil || b.Int != nil {
+		t.Errorf("Expected null to reset the value, got %v (%v)", b.Int, err)
+	}
+	if err := b.UnmarshalJSON([]byte("1.5")); err == nil {
+		t.Errorf("Expected error for a fractional number, got nil")
+	}
+}
+
+func TestBigInt_MarshalJSONRoundTrip(t *testing.T) {
+	type holder struct {
+		Balance *utils.BigInt `json:"balance,omitempty"`
+		Fee     utils.BigInt  `json:"fee"`
+	}
+	value, _ := new(big.Int).SetString("340282366920938463463374607431768211455", 10)
+	encoded, err := json.Marshal(holder{Balance: &utils.BigInt{Int: value}})
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if string(encoded) != `{"balance":"340282366920938463463374607431768211455","fee":null}` {
+		t.Errorf("Unexpected encoding: %s", encoded)
+	}
+
+	var decoded holder
+	if err := json.Unmarshal(encoded, &decoded); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if decoded.Balance.Cmp(value) != 0 || decoded.Fee.Int != nil {
+		t.Errorf("Unexpected round trip: %+v", decoded)
+	}
+}
@@ -1,6 +1,7 @@
 package tests
 
 import (
+	"encoding/json"
 	"testing"
 	"time"
 
@@ -50,3 +51,33 @@ func TestCustomTime_UnmarshalJSON(t *testing.T) {
 		t.Errorf("Expected error, got nil")
 	}
 }
+
+func TestCustomTime_RFC3339AndRoundTrip(t *testing.T) {
+	ct := &utils.CustomTime{}
+	if err := ct.UnmarshalJSON([]byte(`"2023-11-23T10:20:30.5Z"`)); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	expected := time.Date(2023, 11, 23, 10, 20, 30, 500000000, time.UTC)
+	if !ct.Time.Equal(expected) || ct.IsDateOnly() {
+		t.Errorf("Unexpected value. Got: %v, Expected: %v", ct.Time, expected)
+	}
+
+	for _, input := range []string{`"2023-11-23"`, `"2023-11-23T10:20:30.5Z"`, `"2023-11-23T10:20:30+02:00"`, `null`} {
+		var parsed utils.CustomTime
+		if err := json.Unmarshal([]byte(input), &parsed); err != nil {
+			t.Fatalf("Unexpected error for %s: %v", input, err)
+		}
+		encoded, err := json.Marshal(parsed)
+		if err != nil {
+			t.Fatalf("Unexpected error for %s: %v", input, err)
+		}
+		if string(encoded) != input {
+			t.Errorf("Expected %s to round trip, got %s", input, encoded)
+		}
+	}
+
+	encoded, _ := json.Marshal(utils.NewDate(time.Date(2024, 2, 29, 0, 0, 0, 0, time.UTC)))
+	if string(encoded) != `"2024-02-29"` {
+		t.Errorf("Unexpected date encoding: %s", encoded)
+	}
+}
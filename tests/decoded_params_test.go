@@ -0,0 +1,160 @@
+package tests
+
+import (
+	"encoding/json"
+	"errors"
+	"math/big"
+	"strings"
+	"testing"
+
+	"github.com/covalenthq/covalent-api-sdk-go/abi"
+	"github.com/covalenthq/covalent-api-sdk-go/genericmodels"
+	"github.com/covalenthq/covalent-api-sdk-go/utils"
+)
+
+const apiDecodedTransfer = `{
+	"name": "Transfer",
+	"signature": "Transfer(indexed address from, indexed address to, uint256 value)",
+	"params": [
+		{"name": "from", "type": "address", "indexed": true, "decoded": true, "value": "0xa9d1e08c7793af67e9d92fe308d5697fb81d3e43"},
+		{"name": "to", "type": "address", "indexed": true, "decoded": true, "value": "0x77696bb39917c91a0c3908d577d5e322095425ca"},
+		{"name": "value", "type": "uint256", "indexed": false, "decoded": true, "value": "25000000"}
+	]
+}`
+
+func TestDecodedItem_TypedGetters(t *testing.T) {
+	var decoded genericmodels.DecodedItem
+	if err := json.Unmarshal([]byte(apiDecodedTransfer), &decoded); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	from, err := decoded.ParamAddress("from")
+	if err != nil || from != utils.MustParseAddress("0xa9d1e08c7793af67e9d92fe308d5697fb81d3e43") {
+		t.Errorf("Unexpected from: %v %v", from, err)
+	}
+	value, err := decoded.ParamBigInt("value")
+	if err != nil || value.Cmp(big.NewInt(25000000)) != 0 {
+		t.Errorf("Unexpected value: %v %v", value, err)
+	}
+
+	if _, err := decoded.ParamBigInt("from"); err == nil || err.Error() != `param "from": expected uint256, param is address, got string 0xa9d1e08c7793af67e9d92fe308d5697fb81d3e43` {
+		t.Errorf("Unexpected error: %v", err)
+	}
+	var paramErr *genericmodels.ParamError
+	if _, err := decoded.ParamBool("value"); !errors.As(err, &paramErr) || paramErr.Param != "value" || paramErr.Expected != "bool" {
+		t.Errorf("Expected a ParamError, got %v", err)
+	}
+	if _, err := decoded.ParamString("amount"); !errors.Is(err, genericmodels.ErrParamNotFound) {
+		t.Errorf("Expected ErrParamNotFound, got %v", err)
+	}
+}
+
+func TestDecodedItem_GettersOnUntypedAPIValues(t *testing.T) {
+	var decoded genericmodels.DecodedItem
+	if err := json.Unmarshal([]byte(`{"params": [
+		{"name": "amount", "value": 42},
+		{"name": "fraction", "value": 1.5},
+		{"name": "payload", "value": "AQID"}
+	]}`), &decoded); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if amount, err := decoded.ParamBigInt("amount"); err != nil || amount.Int64() != 42 {
+		t.Errorf("Unexpected amount: %v %v", amount, err)
+	}
+	if _, err := decoded.ParamBigInt("fraction"); err == nil || !strings.Contains(err.Error(), `param "fraction": expected uint256`) {
+		t.Errorf("Unexpected error: %v", err)
+	}
+	if payload, err := decoded.ParamBytes("payload"); err != nil || string(payload) != "\x01\x02\x03" {
+		t.Errorf("Unexpected payload: %v %v", payload, err)
+	}
+}
+
+type complexEvent struct {
+	Who     utils.Address `abi:"who"`
+	Delta   int8          `abi:"delta"`
+	Amounts []*big.Int    `abi:"amounts"`
+	Pairs   []struct {
+		Owner  utils.Address
+		Amount utils.BigInt
+	} `abi:"pairs"`
+	Note  string
+	Data  []byte
+	ID    [4]byte
+	Flag  bool
+	Fixed [2]uint16
+	Tag   interface{}
+}
+
+func TestDecodedItem_BindABIDecodedLog(t *testing.T) {
+	contract := abi.MustParse(testABI)
+	event, _ := contract.EventByName("Complex")
+	topics := []string{
+		event.Topic(),
+		"0x0000000000000000000000005aaeb6053f3e94c9b9a09f33669435e7ef1beaed",
+		"0x1c8aff950685c2ed4bc3174f3472287b56d9517b9c948127319a09a7a36deac8",
+		"0xfffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffd",
+	}
+	data := "0x" + strings.Join([]string{
+		"0000000000000000000000000000000000000000000000000000000000000100",
+		"0000000000000000000000000000000000000000000000000000000000000160",
+		"00000000000000000000000000000000000000000000000000000000000001c0",
+		"0000000000000000000000000000000000000000000000000000000000000200",
+		"0102030400000000000000000000000000000000000000000000000000000000",
+		"0000000000000000000000000000000000000000000000000000000000000001",
+		"0000000000000000000000000000000000000000000000000000000000000001",
+		"000000000000000000000000000000000000000000000000000000000000ffff",
+		"0000000000000000000000000000000000000000000000000000000000000002",
+		"0000000000000000000000000000000000000000000000000000000000000001",
+		"0000000000000000000000000000000000000000000000000000000000000002",
+		"0000000000000000000000000000000000000000000000000000000000000001",
+		"000000000000000000000000fb6916095ca1df60bb79ce92ce3ea74c37c5d359",
+		"0000000000000000000000000000000000000000000000000000000000000008",
+		"0000000000000000000000000000000000000000000000000000000000000004",
+		"6e6f746500000000000000000000000000000000000000000000000000000000",
+		"0000000000000000000000000000000000000000000000000000000000000004",
+		"deadbeef00000000000000000000000000000000000000000000000000000000",
+	}, "")
+	decoded, err := event.Decode(topics, data)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	var bound complexEvent
+	if err := decoded.Bind(&bound); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if bound.Who != utils.MustParseAddress("0x5aaeb6053f3e94c9b9a09f33669435e7ef1beaed") || bound.Delta != -3 {
+		t.Errorf("Unexpected indexed fields: %+v", bound)
+	}
+	if len(bound.Amounts) != 2 || bound.Amounts[1].Int64() != 2 {
+		t.Errorf("Unexpected amounts: %v", bound.Amounts)
+	}
+	if len(bound.Pairs) != 1 || bound.Pairs[0].Owner != utils.MustParseAddress("0xfb6916095ca1df60bb79ce92ce3ea74c37c5d359") || bound.Pairs[0].Amount.Int64() != 8 {
+		t.Errorf("Unexpected pairs: %+v", bound.Pairs)
+	}
+	if bound.Note != "note" || string(bound.Data) != "\xde\xad\xbe\xef" || bound.ID != [4]byte{1, 2, 3, 4} || !bound.Flag || bound.Fixed != [2]uint16{1, 65535} {
+		t.Errorf("Unexpected fields: %+v", bound)
+	}
+	if bound.Tag != topics[2] {
+		t.Errorf("Expected the raw topic of the indexed string, got %v", bound.Tag)
+	}
+
+	if _, err := decoded.ParamString("tag"); !errors.Is(err, genericmodels.ErrParamNotDecoded) {
+		t.Errorf("Expected ErrParamNotDecoded, got %v", err)
+	}
+	var overflow struct {
+		Fixed [2]int8 `abi:"fixed"`
+	}
+	if err := decoded.Bind(&overflow); err == nil || !strings.Contains(err.Error(), `param "fixed[1]": expected uint16`) {
+		t.Errorf("Unexpected error: %v", err)
+	}
+	var missing struct {
+		Value *big.Int `abi:"value"`
+	}
+	if err := decoded.Bind(&missing); !errors.Is(err, genericmodels.ErrParamNotFound) {
+		t.Errorf("Expected ErrParamNotFound, got %v", err)
+	}
+	if err := decoded.Bind(bound); err == nil {
+		t.Errorf("Expected an error for a non-pointer target")
+	}
+}
@@ -0,0 +1,122 @@
+package tests
+
+import (
+	"encoding/json"
+	"errors"
+	"math/big"
+	"strings"
+	"testing"
+
+	"github.com/covalenthq/covalent-api-sdk-go/dexevents"
+	"github.com/covalenthq/covalent-api-sdk-go/services"
+)
+
+func TestDexEvents_V2Swap(t *testing.T) {
+	var pool services.Pool
+	if err := json.Unmarshal([]byte(`{"token_0":{"contract_decimals":18},"token_1":{"contract_decimals":6}}`), &pool); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	pair, err := dexevents.PairOfPool(pool)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	// Swap(address indexed sender, uint amount0In, uint amount1In, uint amount0Out, uint amount1Out, address indexed to)
+	log := rawLogEvent([]string{
+		"0xd78ad95fa46c994b6551d0da85fc275fe613ce37657fb8d5e3d130840159d822",
+		"0x0000000000000000000000007a250d5630b4cf539739df2c5dacb4c659f2488d",
+		"0x0000000000000000000000005aaeb6053f3e94c9b9a09f33669435e7ef1beaed",
+	}, "0x"+strings.Join([]string{
+		"0000000000000000000000000000000000000000000000000de0b6b3a7640000",
+		"0000000000000000000000000000000000000000000000000000000000000000",
+		"0000000000000000000000000000000000000000000000000000000000000000",
+		"0000000000000000000000000000000000000000000000000000000077359400",
+	}, ""))
+	event, err := dexevents.Decode(log)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	v2, ok := event.(*dexevents.V2Swap)
+	if !ok {
+		t.Fatalf("Expected a V2 swap, got %T", event)
+	}
+	swap := v2.Swap(pair)
+	if !swap.ZeroForOne || swap.AmountIn.String() != "1" || swap.AmountOut.String() != "2000" || swap.Amount1.String() != "-2000" {
+		t.Errorf("Unexpected swap: %+v", swap)
+	}
+	if swap.Price.Cmp(big.NewRat(2000, 1)) != 0 || swap.PoolPrice != nil {
+		t.Errorf("Unexpected price: %v %v", swap.Price, swap.PoolPrice)
+	}
+
+	// Sync(uint112 reserve0, uint112 reserve1)
+	sync := rawLogEvent([]string{"0x1c411e9a96e071241c2f21f7726b17ae89e3cab4c78be50e062b03a9fffbbad1"}, "0x"+strings.Join([]string{
+		"00000000000000000000000000000000000000000000001b1ae4d6e2ef500000",
+		"000000000000000000000000000000000000000000000000000000e8d4a51000",
+	}, ""))
+	event, err = dexevents.Decode(sync)
+	if s, ok := event.(*dexevents.V2Sync); err != nil || !ok || s.Price(pair).Cmp(big.NewRat(2000, 1)) != 0 {
+		t.Errorf("Unexpected sync: %#v %v", event, err)
+	}
+
+	if _, err := dexevents.PairOfPool(services.Pool{}); err == nil {
+		t.Errorf("Expected an error for a pool without tokens")
+	}
+	if _, err := dexevents.Decode(rawLogEvent([]string{transferTopic}, "0x")); !errors.Is(err, dexevents.ErrNotDexEvent) {
+		t.Errorf("Expected ErrNotDexEvent, got %v", err)
+	}
+}
+
+func TestDexEvents_V3SwapAndLiquidity(t *testing.T) {
+	pair := dexevents.Pair{Decimals0: 6, Decimals1: 18}
+	// Swap(address indexed sender, address indexed recipient, int256 amount0, int256 amount1, uint160 sqrtPriceX96, uint128 liquidity, int24 tick)
+	log := rawLogEvent([]string{
+		"0xc42079f94a6350d7e6235f29174924f928cc2ac818eb64fed8004e115fbcca67",
+		"0x000000000000000000000000e592427a0aece92de3edee1f18e0157c05861564",
+		"0x0000000000000000000000005aaeb6053f3e94c9b9a09f33669435e7ef1beaed",
+	}, "0x"+strings.Join([]string{
+		"ffffffffffffffffffffffffffffffffffffffffffffffffffffffff4d2fa200",
+		"0000000000000000000000000000000000000000000000000de0b6b3a7640000",
+		"0000000000000000000000000000000000000002000000000000000000000000",
+		"00000000000000000000000000000000000000000000000000000000075bcd15",
+		"fffffffffffffffffffffffffffffffffffffffffffffffffffffffffffd0648",
+	}, ""))
+	event, err := dexevents.Decode(log)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	v3, ok := event.(*dexevents.V3Swap)
+	if !ok || v3.Tick != -195000 {
+		t.Fatalf("Unexpected V3 swap: %#v", event)
+	}
+	swap := v3.Swap(pair)
+	if swap.ZeroForOne || swap.AmountIn.String() != "1" || swap.AmountOut.String() != "3000" {
+		t.Errorf("Unexpected swap: %+v", swap)
+	}
+	if swap.Price.Cmp(big.NewRat(1, 3000)) != 0 {
+		t.Errorf("Unexpected effective price: %v", swap.Price)
+	}
+	if expected := new(big.Rat).SetFrac(big.NewInt(4), big.NewInt(1000000000000)); swap.PoolPrice.Cmp(expected) != 0 {
+		t.Errorf("Unexpected pool price: %v", swap.PoolPrice.FloatString(15))
+	}
+
+	// Mint(address sender, address indexed owner, int24 indexed tickLower, int24 indexed tickUpper, uint128 amount, uint256 amount0, uint256 amount1)
+	mint := rawLogEvent([]string{
+		"0x7a53080ba414158be7ec69b987b5fb7d07dee101fe85488f0853ae16239d0bde",
+		"0x000000000000000000000000c36442b4a4522e871399cd717abdd847ab11fe88",
+		"0xfffffffffffffffffffffffffffffffffffffffffffffffffffffffffff2764c",
+		"0x00000000000000000000000000000000000000000000000000000000000d89b4",
+	}, "0x"+strings.Join([]string{
+		"000000000000000000000000c36442b4a4522e871399cd717abdd847ab11fe88",
+		"00000000000000000000000000000000000000000000000000000000000003e8",
+		"00000000000000000000000000000000000000000000000000000000002625a0",
+		"00000000000000000000000000000000000000000000000000038d7ea4c68000",
+	}, ""))
+	event, err = dexevents.Decode(mint)
+	m, ok := event.(*dexevents.V3Mint)
+	if err != nil || !ok || m.TickLower != -887220 {
+		t.Fatalf("Unexpected mint: %#v %v", event, err)
+	}
+	if amount0, amount1 := m.Amounts(pair); amount0.String() != "2.5" || amount1.String() != "0.001" {
+		t.Errorf("Unexpected amounts: %s %s", amount0, amount1)
+	}
+}
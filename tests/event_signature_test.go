@@ -0,0 +1,108 @@
+package tests
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/covalenthq/covalent-api-sdk-go/abi"
+	"github.com/covalenthq/covalent-api-sdk-go/chains"
+	"github.com/covalenthq/covalent-api-sdk-go/covalentclient"
+	"github.com/covalenthq/covalent-api-sdk-go/genericmodels"
+	"github.com/covalenthq/covalent-api-sdk-go/services"
+	"github.com/covalenthq/covalent-api-sdk-go/testutil"
+)
+
+const transferTopic = "0xddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef"
+
+func TestTopicHash_SignatureForms(t *testing.T) {
+	for _, signature := range []string{
+		"Transfer(address,address,uint256)",
+		"Transfer(address,address,uint)",
+		"event Transfer(address indexed from, address indexed to, uint256 value);",
+		" Transfer( address from , address to , uint value ) ",
+	} {
+		if topic, err := abi.TopicHash(signature); err != nil || topic != transferTopic {
+			t.Errorf("Unexpected topic for %q: %s %v", signature, topic, err)
+		}
+	}
+
+	event, err := abi.ParseEvent("Settled((address owner, uint amount)[] indexed legs, bytes32 id)")
+	if err != nil || event.Signature() != "Settled((address,uint256)[],bytes32)" || !event.Inputs[0].Indexed || event.Inputs[1].Name != "id" {
+		t.Errorf("Unexpected event: %+v %v", event, err)
+	}
+
+	for _, invalid := range []string{"Transfer", "Transfer(address,address", "Transfer(uint7)", "(address)", "Transfer(address from to)"} {
+		if _, err := abi.TopicHash(invalid); err == nil {
+			t.Errorf("Expected %q to be rejected", invalid)
+		}
+	}
+
+	topics, err := abi.ResolveTopics(transferTopic + ", Approval(address,address,uint256)")
+	if err != nil || topics != transferTopic+",0x8c5be1e5ebec7d5bd14f71427d1e84f3dd0314c0f7b2291e5b200ac8c7c3b925" {
+		t.Errorf("Unexpected topics: %s %v", topics, err)
+	}
+}
+
+func TestRegistry_LabelsRawLogs(t *testing.T) {
+	if signatures, ok := abi.DefaultRegistry.Lookup(strings.ToUpper(transferTopic[2:])); ok {
+		t.Errorf("Expected a topic without 0x not to match, got %v", signatures)
+	}
+	if signatures, ok := abi.DefaultRegistry.Lookup("0xDDF252AD1BE2C89B69C2B068FC378DAA952BA7F163C4A11628F55A4DF523B3EF"); !ok || signatures[0] != "Transfer(address,address,uint256)" {
+		t.Errorf("Unexpected signatures: %v", signatures)
+	}
+
+	registry, err := abi.NewRegistry("event Rebased(uint256 indexed epoch, uint256 totalSupply)")
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	topic, _ := abi.TopicHash("Rebased(uint256,uint256)")
+	topics := []string{topic}
+	if label, ok := registry.Label(genericmodels.LogEvent{RawLogTopics: &topics}); !ok || label != "Rebased(uint256,uint256)" {
+		t.Errorf("Unexpected label: %q", label)
+	}
+	if _, ok := registry.Lookup(transferTopic); ok {
+		t.Errorf("Expected a new registry not to hold the default signatures")
+	}
+	registry.RegisterABI(abi.MustParse(testABI))
+	if _, ok := registry.Lookup(transferTopic); !ok {
+		t.Errorf("Expected RegisterABI to add the Transfer event")
+	}
+	if err := registry.Register("not a signature"); err == nil {
+		t.Errorf("Expected an invalid signature to be rejected")
+	}
+}
+
+func TestGetLogEventsByTopicHash_AcceptsSignature(t *testing.T) {
+	testutil.UseMockTransport(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/v1/eth-mainnet/events/" {
+			if topics := r.URL.Query().Get("topics"); topics != transferTopic {
+				t.Errorf("Unexpected topics: %s", topics)
+			}
+		} else if r.URL.Path != "/v1/eth-mainnet/events/topics/"+transferTopic+"/" {
+			t.Errorf("Unexpected path: %s", r.URL.Path)
+		}
+		fmt.Fprint(w, `{"data":{"items":[],"pagination":{"has_more":false}},"error":false,"error_code":null,"error_message":null}`)
+	})
+
+	client, err := covalentclient.NewClient(covalentclient.WithAPIKey(testutil.MockAPIKey))
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	for result := range client.BaseService.GetLogEventsByTopicHash(chains.EthMainnet, "Transfer(address,address,uint256)") {
+		if result.Err != nil {
+			t.Errorf("Unexpected error: %v", result.Err)
+		}
+	}
+	if _, err := client.BaseService.GetLogEventsByTopicHashByPage(chains.EthMainnet, "Transfer(address indexed from, address indexed to, uint value)"); err != nil {
+		t.Errorf("Unexpected error: %v", err)
+	}
+	signature := "Transfer(address,address,uint256)"
+	if _, err := client.BaseService.GetLogs(chains.EthMainnet, services.GetLogsQueryParamOpts{Topics: &signature}); err != nil {
+		t.Errorf("Unexpected error: %v", err)
+	}
+	if resp, err := client.BaseService.GetLogEventsByTopicHashByPage(chains.EthMainnet, "Transfer(address"); err == nil || resp == nil || !resp.Error || *resp.ErrorCode != 400 {
+		t.Errorf("Expected an invalid signature to be rejected with an error response, got %v", err)
+	}
+}
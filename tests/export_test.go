@@ -0,0 +1,171 @@
+package tests
+
+import (
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/covalenthq/covalent-api-sdk-go/chains"
+	"github.com/covalenthq/covalent-api-sdk-go/covalentclient"
+	"github.com/covalenthq/covalent-api-sdk-go/export"
+	"github.com/covalenthq/covalent-api-sdk-go/services"
+	"github.com/covalenthq/covalent-api-sdk-go/testutil"
+)
+
+func exportTransactions(results ...services.TransactionResult) <-chan services.TransactionResult {
+	ch := make(chan services.TransactionResult, len(results))
+	for _, result := range results {
+		ch <- result
+	}
+	close(ch)
+	return ch
+}
+
+func exportTransaction(t *testing.T, document string) services.TransactionResult {
+	var tx services.Transaction
+	if err := json.Unmarshal([]byte(document), &tx); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	return services.TransactionResult{Transaction: tx}
+}
+
+func TestExport_CSV(t *testing.T) {
+	tx := exportTransaction(t, `{"block_signed_at":"2023-01-01T12:00:00Z","tx_hash":"0x1","successful":true,"value":"123456789012345678901234567890","fees_paid":"21000","gas_metadata":{"contract_decimals":18,"contract_ticker_symbol":"ETH","supports_erc":["erc20"]}}`)
+	var buf bytes.Buffer
+	exporter, err := export.NewExporter(&buf, export.CSV)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	count, err := export.Channel(exporter, exportTransactions(tx, services.TransactionResult{}))
+	if err != nil || count != 2 {
+		t.Fatalf("Unexpected count %d or error: %v", count, err)
+	}
+
+	records, err := csv.NewReader(&buf).ReadAll()
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if len(records) != 3 {
+		t.Fatalf("Expected a header and 2 rows, got %v", records)
+	}
+	row := map[string]string{}
+	for i, name := range records[0] {
+		row[name] = records[1][i]
+	}
+	expected := map[string]string{
+		"block_signed_at":                     "2023-01-01T12:00:00Z",
+		"tx_hash":                             "0x1",
+		"successful":                          "true",
+		"value":                               "123456789012345678901234567890",
+		"gas_metadata.contract_decimals":      "18",
+		"gas_metadata.contract_ticker_symbol": "ETH",
+		"gas_metadata.supports_erc":           `["erc20"]`,
+		"value_quote":                         "",
+	}
+	for name, value := range expected {
+		if got, ok := row[name]; !ok || got != value {
+			t.Errorf("Expected column %s to be %q, got %q (present: %v)", name, value, got, ok)
+		}
+	}
+	if strings.Join(records[2], "") != "" {
+		t.Errorf("Expected an empty transaction to give empty cells, got %v", records[2])
+	}
+}
+
+func TestExport_NDJSONFormatsLikeCSV(t *testing.T) {
+	tx := exportTransaction(t, `{"block_signed_at":"2023-01-01T12:00:00Z","tx_hash":"0x1","value":"123456789012345678901234567890","gas_metadata":{"contract_decimals":18}}`)
+	var buf bytes.Buffer
+	layout := "2006-01-02"
+	exporter, _ := export.NewExporter(&buf, export.NDJSON, export.ExporterOpts{TimeLayout: &layout})
+	if count, err := export.Channel(exporter, exportTransactions(tx)); err != nil || count != 1 {
+		t.Fatalf("Unexpected count %d or error: %v", count, err)
+	}
+	var line map[string]interface{}
+	if err := json.Unmarshal(buf.Bytes(), &line); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if line["block_signed_at"] != "2023-01-01" || line["value"] != "123456789012345678901234567890" || line["gas_metadata.contract_decimals"] != float64(18) {
+		t.Errorf("Unexpected line: %s", buf.String())
+	}
+	if len(line) != len(exporter.Columns()) {
+		t.Errorf("Expected a key per column, got %d keys for %d columns", len(line), len(exporter.Columns()))
+	}
+}
+
+func TestExport_ColumnsAndErrorPolicies(t *testing.T) {
+	tx := exportTransaction(t, `{"block_signed_at":"2023-01-01T12:00:00Z","tx_hash":"0x1","value":"5","gas_metadata":{"contract_decimals":18,"contract_ticker_symbol":"ETH"}}`)
+	failed := services.TransactionResult{Err: errors.New("page 2 failed")}
+
+	var buf bytes.Buffer
+	layout, rows := "2006-01-02", export.ErrorRows
+	exporter, _ := export.NewExporter(&buf, export.NDJSON, export.ExporterOpts{
+		Columns:    []string{"tx_hash", "block_signed_at", "value", "gas_metadata"},
+		OnError:    &rows,
+		TimeLayout: &layout,
+	})
+	if count, err := export.Channel(exporter, exportTransactions(tx, failed, tx)); err != nil || count != 2 {
+		t.Fatalf("Unexpected count %d or error: %v", count, err)
+	}
+	lines := strings.Split(strings.TrimSpace(buf.String()), "\n")
+	if len(lines) != 3 || lines[1] != `{"error":"page 2 failed"}` || lines[0] != lines[2] {
+		t.Fatalf("Unexpected lines: %v", lines)
+	}
+	if columns := exporter.Columns(); len(columns) < 4 || columns[3] != "gas_metadata.contract_decimals" {
+		t.Errorf("Unexpected columns: %v", columns)
+	}
+	if !strings.HasPrefix(lines[0], `{"tx_hash":"0x1","block_signed_at":"2023-01-01","value":"5","gas_metadata.contract_decimals":18,`) {
+		t.Errorf("Unexpected line: %s", lines[0])
+	}
+
+	buf.Reset()
+	exporter, _ = export.NewExporter(&buf, export.CSV, export.ExporterOpts{Columns: []string{"tx_hash"}})
+	count, err := export.Channel(exporter, exportTransactions(tx, failed, tx))
+	if err == nil || err.Error() != "page 2 failed" || count != 1 || buf.String() != "tx_hash\n0x1\n" {
+		t.Errorf("Expected the export to abort after 1 row, got %d rows, error %v and %q", count, err, buf.String())
+	}
+
+	exporter, _ = export.NewExporter(&buf, export.CSV, export.ExporterOpts{Columns: []string{"missing"}})
+	var unknown *export.UnknownColumnError
+	if _, err := export.Channel(exporter, exportTransactions(tx)); !errors.As(err, &unknown) || unknown.Column != "missing" {
+		t.Errorf("Expected an unknown column error, got %v", err)
+	}
+	if _, err := export.NewExporter(&buf, "xml"); err == nil {
+		t.Errorf("Expected an unknown format to be rejected")
+	}
+	skip := export.ErrorPolicy("skip")
+	if _, err := export.NewExporter(&buf, export.CSV, export.ExporterOpts{OnError: &skip}); err == nil {
+		t.Errorf("Expected an unknown error policy to be rejected")
+	}
+}
+
+func TestExport_Pages(t *testing.T) {
+	testutil.UseMockTransport(t, func(w http.ResponseWriter, r *http.Request) {
+		if strings.HasSuffix(r.URL.Path, "/page/1/") {
+			fmt.Fprint(w, `{"data":{"items":[{"tx_hash":"0x3"}],"links":{"prev":null,"next":null}},"error":false,"error_code":null,"error_message":null}`)
+			return
+		}
+		fmt.Fprint(w, `{"data":{"items":[{"tx_hash":"0x1"},{"tx_hash":"0x2"}],"links":{"prev":null,"next":"https://api.covalenthq.com/v1/eth-mainnet/address/demo.eth/transactions_v3/page/1/"}},"error":false,"error_code":null,"error_message":null}`)
+	})
+	client, err := covalentclient.NewClient(covalentclient.WithAPIKey(testutil.MockAPIKey))
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	first, err := client.TransactionService.GetAllTransactionsForAddressByPage(chains.EthMainnet, "demo.eth")
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	var buf bytes.Buffer
+	exporter, _ := export.NewExporter(&buf, export.CSV, export.ExporterOpts{Columns: []string{"tx_hash"}})
+	if count, err := export.Pages(exporter, first); err != nil || count != 3 {
+		t.Fatalf("Unexpected count %d or error: %v", count, err)
+	}
+	if buf.String() != "tx_hash\n0x1\n0x2\n0x3\n" {
+		t.Errorf("Unexpected CSV: %q", buf.String())
+	}
+}
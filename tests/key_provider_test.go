@@ -0,0 +1,116 @@
+package tests
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/covalenthq/covalent-api-sdk-go/chains"
+	"github.com/covalenthq/covalent-api-sdk-go/covalentclient"
+	"github.com/covalenthq/covalent-api-sdk-go/testutil"
+	"github.com/covalenthq/covalent-api-sdk-go/utils"
+)
+
+const otherMockAPIKey = "cqt_wFbcdfghjkmpqrtvwxyBCDFGHJKM"
+
+func TestKeyPool_RoundRobinAndBench(t *testing.T) {
+	pool, err := utils.NewKeyPool([]string{testutil.MockAPIKey, otherMockAPIKey}, time.Hour)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	first, _ := pool.Key()
+	second, _ := pool.Key()
+	if first == second {
+		t.Errorf("Expected keys to rotate, got %s twice", first)
+	}
+
+	pool.Report(first, http.StatusTooManyRequests)
+	for i := 0; i < 3; i++ {
+		key, err := pool.Key()
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		if key == first {
+			t.Errorf("Expected benched key %s to be skipped", first)
+		}
+	}
+
+	pool.Report(second, http.StatusPaymentRequired)
+	if _, err := pool.Key(); err == nil {
+		t.Errorf("Expected error when every key is benched, got nil")
+	}
+}
+
+func TestKeyPool_IgnoresForeignKeys(t *testing.T) {
+	pool, err := utils.NewKeyPool([]string{testutil.MockAPIKey}, time.Hour)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	// A key from a context override is not benched, so it is usable once added to the pool.
+	pool.Report(otherMockAPIKey, http.StatusTooManyRequests)
+	if err := pool.SetKeys([]string{otherMockAPIKey}); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if key, err := pool.Key(); err != nil || key != otherMockAPIKey {
+		t.Errorf("Expected the foreign key not to have been benched, got %q, %v", key, err)
+	}
+}
+
+func TestKeyPool_RejectsMalformedKey(t *testing.T) {
+	if _, err := utils.NewKeyPool([]string{testutil.MockAPIKey, "not-a-key"}, time.Minute); err == nil {
+		t.Errorf("Expected error for malformed key, got nil")
+	}
+}
+
+func TestKeyPool_Reload(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "keys")
+	if err := os.WriteFile(path, []byte("# primary\n"+testutil.MockAPIKey+"\n"), 0600); err != nil {
+		t.Fatal(err)
+	}
+	pool, err := utils.NewKeyPoolFromFile(path, time.Minute)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	if err := os.WriteFile(path, []byte(otherMockAPIKey+"\n"), 0600); err != nil {
+		t.Fatal(err)
+	}
+	later := time.Now().Add(time.Second)
+	os.Chtimes(path, later, later)
+	if err := pool.Reload(); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if keys := pool.Keys(); len(keys) != 1 || keys[0] != otherMockAPIKey {
+		t.Errorf("Expected reloaded keys, got %v", keys)
+	}
+}
+
+func TestKeyProvider_ClientRequests(t *testing.T) {
+	var seen []string
+	testutil.UseMockTransport(t, func(w http.ResponseWriter, r *http.Request) {
+		seen = append(seen, r.Header.Get("Authorization"))
+		fmt.Fprint(w, `{"data":{"address":"0x1","items":[]},"error":false,"error_code":null,"error_message":null}`)
+	})
+
+	pool, err := utils.NewKeyPool([]string{testutil.MockAPIKey, otherMockAPIKey}, time.Minute)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	client := covalentclient.CovalentClient("", covalentclient.CovalentClientSettings{KeyProvider: pool})
+	client.BalanceService.GetTokenBalancesForWalletAddress(chains.EthMainnet, "0x1")
+	client.BalanceService.GetTokenBalancesForWalletAddress(chains.EthMainnet, "0x1")
+
+	overrideKey := "cqt_rQyyyyyyyyyyyyyyyyyyyyyyyyyy"
+	ctx := utils.WithAPIKey(context.Background(), overrideKey)
+	client.WithContext(ctx).BalanceService.GetTokenBalancesForWalletAddress(chains.EthMainnet, "0x1")
+
+	expected := []string{"Bearer " + testutil.MockAPIKey, "Bearer " + otherMockAPIKey, "Bearer " + overrideKey}
+	if fmt.Sprint(seen) != fmt.Sprint(expected) {
+		t.Errorf("Unexpected Authorization headers. Got: %v, Expected: %v", seen, expected)
+	}
+}
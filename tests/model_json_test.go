@@ -0,0 +1,43 @@
+package tests
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+
+	"github.com/covalenthq/covalent-api-sdk-go/services"
+)
+
+func TestModelJSONRoundTrip(t *testing.T) {
+	var balances services.BalancesResponse
+	input := `{"address":"0x1","chain_id":1,"updated_at":"2023-11-23T10:20:30Z","items":[{"contract_decimals":18,"balance":"1000000000000000000000000","balance_24h":null}]}`
+	if err := json.Unmarshal([]byte(input), &balances); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	encoded, err := json.Marshal(balances)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	var reread services.BalancesResponse
+	if err := json.Unmarshal(encoded, &reread); err != nil {
+		t.Fatalf("Expected marshalled balances to be readable, got: %v (%s)", err, encoded)
+	}
+	if !reflect.DeepEqual(balances, reread) {
+		t.Errorf("Expected %+v, got %+v", balances, reread)
+	}
+	if reread.Items[0].Balance.String() != "1000000000000000000000000" {
+		t.Errorf("Unexpected balance: %s", reread.Items[0].Balance)
+	}
+
+	var prices []services.Price
+	if err := json.Unmarshal([]byte(`[{"date":"2023-11-23","price":1.5},{"date":null}]`), &prices); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	encoded, err = json.Marshal(prices)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if string(encoded) != `[{"date":"2023-11-23","price":1.5},{}]` {
+		t.Errorf("Unexpected encoding: %s", encoded)
+	}
+}
@@ -0,0 +1,81 @@
+package tests
+
+import (
+	"fmt"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/covalenthq/covalent-api-sdk-go/chains"
+	"github.com/covalenthq/covalent-api-sdk-go/covalentclient"
+	"github.com/covalenthq/covalent-api-sdk-go/services"
+	"github.com/covalenthq/covalent-api-sdk-go/utils"
+)
+
+type activityBaseService struct {
+	services.BaseService
+	chainNames []string
+}
+
+func (s activityBaseService) GetAddressActivity(walletAddress string, queryParamOpts ...services.GetAddressActivityQueryParamOpts) (*utils.Response[services.ChainActivityResponse], error) {
+	items := make([]services.ChainActivityEvent, 0, len(s.chainNames))
+	for i := range s.chainNames {
+		items = append(items, services.ChainActivityEvent{ChainItem: services.ChainItem{Name: &s.chainNames[i]}})
+	}
+	return &utils.Response[services.ChainActivityResponse]{Data: &services.ChainActivityResponse{Address: walletAddress, Items: items}}, nil
+}
+
+func TestForEachChain(t *testing.T) {
+	threadCount := 2
+	client := covalentclient.CovalentClient("API_KEY", covalentclient.CovalentClientSettings{ThreadCount: &threadCount})
+
+	var running, maxRunning int32
+	call := func(chainName chains.Chain, walletAddress string) (string, error) {
+		current := atomic.AddInt32(&running, 1)
+		for {
+			seen := atomic.LoadInt32(&maxRunning)
+			if current <= seen || atomic.CompareAndSwapInt32(&maxRunning, seen, current) {
+				break
+			}
+		}
+		time.Sleep(10 * time.Millisecond)
+		atomic.AddInt32(&running, -1)
+
+		if chainName == chains.BscMainnet {
+			return "", fmt.Errorf("chain unavailable")
+		}
+		return string(chainName) + ":" + walletAddress, nil
+	}
+
+	result, err := covalentclient.ForEachChain(client, "demo.eth", []chains.Chain{chains.EthMainnet, chains.MaticMainnet, chains.BscMainnet, chains.ArbitrumMainnet}, call)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if len(result.Results) != 3 {
+		t.Errorf("Expected 3 results, got %d", len(result.Results))
+	}
+	if result.Results[chains.EthMainnet] != "eth-mainnet:demo.eth" {
+		t.Errorf("Unexpected result for eth-mainnet: %s", result.Results[chains.EthMainnet])
+	}
+	if len(result.Errors) != 1 || result.Errors[chains.BscMainnet] == nil {
+		t.Errorf("Expected a single error for bsc-mainnet, got %v", result.Errors)
+	}
+	if maxRunning > int32(threadCount) {
+		t.Errorf("Expected at most %d concurrent calls, got %d", threadCount, maxRunning)
+	}
+}
+
+func TestForEachChainActiveChains(t *testing.T) {
+	client := covalentclient.CovalentClient("API_KEY")
+	client.BaseService = activityBaseService{chainNames: []string{"eth-mainnet", "base-mainnet"}}
+
+	result, err := covalentclient.ForEachChain(client, "demo.eth", nil, func(chainName chains.Chain, walletAddress string) (bool, error) {
+		return true, nil
+	})
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if len(result.Results) != 2 || !result.Results[chains.EthMainnet] || !result.Results[chains.Chain("base-mainnet")] {
+		t.Errorf("Expected results for the active chains, got %v", result.Results)
+	}
+}
@@ -0,0 +1,141 @@
+package tests
+
+import (
+	"fmt"
+	"net/http"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/covalenthq/covalent-api-sdk-go/chains"
+	"github.com/covalenthq/covalent-api-sdk-go/covalentclient"
+	"github.com/covalenthq/covalent-api-sdk-go/testutil"
+)
+
+func TestNewClient_InvalidKey(t *testing.T) {
+	if _, err := covalentclient.NewClient(covalentclient.WithAPIKey("ckey_invalid")); err == nil {
+		t.Errorf("Expected error for malformed key, got nil")
+	}
+	if _, err := covalentclient.NewClient(); err == nil {
+		t.Errorf("Expected error for missing key, got nil")
+	}
+}
+
+func TestNewClient_InvalidSettings(t *testing.T) {
+	if _, err := covalentclient.NewClient(covalentclient.WithAPIKey(testutil.MockAPIKey), covalentclient.WithBaseURL("api.covalenthq.com")); err == nil {
+		t.Errorf("Expected error for base URL without scheme, got nil")
+	}
+	if _, err := covalentclient.NewClient(covalentclient.WithAPIKey(testutil.MockAPIKey), covalentclient.WithThreadCount(0)); err == nil {
+		t.Errorf("Expected error for zero thread count, got nil")
+	}
+}
+
+func TestNewClient_Env(t *testing.T) {
+	t.Setenv(covalentclient.EnvAPIKey, testutil.MockAPIKey)
+	t.Setenv(covalentclient.EnvThreadCount, "7")
+	t.Setenv(covalentclient.EnvBaseURL, "http://localhost:8080/")
+
+	var requested string
+	testutil.UseMockTransport(t, func(w http.ResponseWriter, r *http.Request) {
+		requested = r.URL.String()
+		fmt.Fprint(w, `{"data":{"items":[]},"error":false,"error_code":null,"error_message":null}`)
+	})
+
+	client, err := covalentclient.NewClient(covalentclient.WithEnv())
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if client.ThreadCount != 7 {
+		t.Errorf("Expected thread count 7, got %d", client.ThreadCount)
+	}
+	if _, err := client.BalanceService.GetTokenBalancesForWalletAddress(chains.EthMainnet, "demo.eth"); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if requested != "http://localhost:8080/v1/eth-mainnet/address/demo.eth/balances_v2/" {
+		t.Errorf("Unexpected request URL: %s", requested)
+	}
+
+	t.Setenv(covalentclient.EnvTimeout, "soon")
+	if _, err := covalentclient.NewClient(covalentclient.WithEnv()); err == nil {
+		t.Errorf("Expected error for malformed timeout, got nil")
+	}
+}
+
+func TestNewClient_ConfigFile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "covalent.json")
+	config := fmt.Sprintf(`{"api_key": %q, "timeout": "2s", "max_retries": 2, "rate_limit": 50, "thread_count": 4}`, testutil.MockAPIKey)
+	if err := os.WriteFile(path, []byte(config), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	requests := 0
+	testutil.UseMockTransport(t, func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		fmt.Fprint(w, `{"data":{"items":[]},"error":false,"error_code":null,"error_message":null}`)
+	})
+
+	// Options after the file override it
+	client, err := covalentclient.NewClient(covalentclient.WithConfigFile(path), covalentclient.WithThreadCount(2))
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if client.ThreadCount != 2 {
+		t.Errorf("Expected thread count 2, got %d", client.ThreadCount)
+	}
+
+	start := time.Now()
+	for i := 0; i < 3; i++ {
+		client.BalanceService.GetTokenBalancesForWalletAddress(chains.EthMainnet, "demo.eth")
+	}
+	if requests != 3 {
+		t.Errorf("Expected 3 requests, got %d", requests)
+	}
+	if elapsed := time.Since(start); elapsed < 40*time.Millisecond {
+		t.Errorf("Expected requests to be rate limited, took %s", elapsed)
+	}
+
+	if err := os.WriteFile(path, []byte(`{"api_keys": "x"}`), 0600); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := covalentclient.NewClient(covalentclient.WithConfigFile(path)); err == nil {
+		t.Errorf("Expected error for unknown config field, got nil")
+	}
+}
+
+func TestNewClient_DisableRetries(t *testing.T) {
+	requests := 0
+	testutil.UseMockTransport(t, func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		w.WriteHeader(http.StatusTooManyRequests)
+		fmt.Fprint(w, `{"data":null,"error":true,"error_code":429,"error_message":"Too many requests"}`)
+	})
+
+	client, err := covalentclient.NewClient(covalentclient.WithAPIKey(testutil.MockAPIKey), covalentclient.WithMaxRetries(0))
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	resp, err := client.BalanceService.GetTokenBalancesForWalletAddress(chains.EthMainnet, "demo.eth")
+	if err == nil || resp == nil || *resp.ErrorCode != http.StatusTooManyRequests || *resp.ErrorMessage != "Too many requests" {
+		t.Errorf("Expected the rate limit response, got %v", err)
+	}
+	if requests != 1 {
+		t.Errorf("Expected a single request with retries disabled, got %d", requests)
+	}
+
+	requests = 0
+	client, err = covalentclient.NewClient(covalentclient.WithAPIKey(testutil.MockAPIKey), covalentclient.WithMaxRetries(1))
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if _, err := client.BalanceService.GetTokenBalancesForWalletAddress(chains.EthMainnet, "demo.eth"); err == nil {
+		t.Errorf("Expected a rate limit error, got nil")
+	}
+	if requests != 2 {
+		t.Errorf("Expected the first attempt and 1 retry, got %d requests", requests)
+	}
+
+	if _, err := covalentclient.NewClient(covalentclient.WithAPIKey(testutil.MockAPIKey), covalentclient.WithMaxRetries(-1)); err == nil {
+		t.Errorf("Expected error for negative max retries, got nil")
+	}
+}
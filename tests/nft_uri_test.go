@@ -0,0 +1,155 @@
+package tests
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/covalenthq/covalent-api-sdk-go/nfturi"
+	"github.com/covalenthq/covalent-api-sdk-go/services"
+)
+
+const (
+	testCID       = "QmYwAPJzv5CZsnA625s3Xf2nemtYgPpHdWEz79ojWnPbdG"
+	testArweaveID = "bNbA3TEQVL60xlgCcqdz4ZPHFZ711cZ3hmkpGttDt_U"
+)
+
+func TestNftURI_Parse(t *testing.T) {
+	cases := []struct {
+		uri       string
+		kind      nfturi.Kind
+		canonical string
+	}{
+		{"ipfs://" + testCID + "/1.png", nfturi.IPFS, "ipfs://" + testCID + "/1.png"},
+		{"ipfs://ipfs/" + testCID, nfturi.IPFS, "ipfs://" + testCID},
+		{testCID + "/1.json", nfturi.IPFS, "ipfs://" + testCID + "/1.json"},
+		{"https://gateway.pinata.cloud/ipfs/" + testCID + "/1.png?filename=1.png", nfturi.IPFS, "ipfs://" + testCID + "/1.png"},
+		{"https://bafybeigdyrzt5sfp7udm7hu76uh7y26nf3efuylqabf3oclgtqy55fbzdi.ipfs.dweb.link/a.gif", nfturi.IPFS, "ipfs://bafybeigdyrzt5sfp7udm7hu76uh7y26nf3efuylqabf3oclgtqy55fbzdi/a.gif"},
+		{"ar://" + testArweaveID, nfturi.Arweave, "ar://" + testArweaveID},
+		{"https://arweave.net/" + testArweaveID + "/2.json", nfturi.Arweave, "ar://" + testArweaveID + "/2.json"},
+		{"https://example.com/ipfs/token/1.png", nfturi.HTTP, "https://example.com/ipfs/token/1.png"},
+		{"data:image/svg+xml;utf8,<svg/>", nfturi.Data, "data:image/svg+xml;utf8,<svg/>"},
+	}
+	for _, c := range cases {
+		uri, err := nfturi.Parse(c.uri)
+		if err != nil {
+			t.Errorf("Unexpected error for %s: %v", c.uri, err)
+			continue
+		}
+		if uri.Kind != c.kind || uri.String() != c.canonical {
+			t.Errorf("Expected %s %s for %s, got %s %s", c.kind, c.canonical, c.uri, uri.Kind, uri.String())
+		}
+	}
+	for _, invalid := range []string{"", "  ", "ftp://example.com/1.png", "ipfs://", "data:no-comma"} {
+		if _, err := nfturi.Parse(invalid); err == nil {
+			t.Errorf("Expected %q to be rejected", invalid)
+		}
+	}
+}
+
+func TestNftURI_Rewrite(t *testing.T) {
+	if got := nfturi.Rewrite("https://gateway.pinata.cloud/ipfs/"+testCID+"/1.png", nfturi.DefaultGateway); got != "https://ipfs.io/ipfs/"+testCID+"/1.png" {
+		t.Errorf("Unexpected rewrite: %s", got)
+	}
+	gateway := nfturi.Gateway{IPFS: "https://{id}.ipfs.nftstorage.link"}
+	if got := nfturi.Rewrite("ipfs://"+testCID+"/1.png", gateway); got != "https://"+testCID+".ipfs.nftstorage.link/1.png" {
+		t.Errorf("Unexpected subdomain rewrite: %s", got)
+	}
+	// Arweave is left empty, so it falls back to the default gateway.
+	if got := nfturi.Rewrite("ar://"+testArweaveID, gateway); got != "https://arweave.net/"+testArweaveID {
+		t.Errorf("Unexpected Arweave rewrite: %s", got)
+	}
+	for _, unchanged := range []string{"https://example.com/1.png", "not a uri"} {
+		if got := nfturi.Rewrite(unchanged, gateway); got != unchanged {
+			t.Errorf("Expected %s unchanged, got %s", unchanged, got)
+		}
+	}
+}
+
+func TestNftURI_MediaType(t *testing.T) {
+	cases := map[string]string{
+		"ipfs://" + testCID + "/1.PNG":             "image/png",
+		"https://example.com/video.mp4?size=large": "video/mp4",
+		"ar://" + testArweaveID + "/model.glb":     "model/gltf-binary",
+		"data:image/svg+xml;base64,PHN2Zy8+":       "image/svg+xml",
+		"data:,hello":                              "text/plain",
+		"ipfs://" + testCID:                        "",
+	}
+	for uri, expected := range cases {
+		if got := nfturi.MediaType(uri); got != expected {
+			t.Errorf("Expected %q for %s, got %q", expected, uri, got)
+		}
+	}
+	if !nfturi.IsImage("image/png") || nfturi.IsVideo("image/png") || !nfturi.IsModel("model/gltf-binary") {
+		t.Errorf("Unexpected media type categories")
+	}
+}
+
+func TestNftURI_DecodeMetadata(t *testing.T) {
+	document := `{"name":"Loot #1","description":"On-chain","image":"data:image/svg+xml;base64,PHN2Zy8+","attributes":[{"trait_type":"Weapon","value":"Katana"}],"edition":1}`
+	metadata, err := nfturi.DecodeMetadata("data:application/json;base64," + base64.StdEncoding.EncodeToString([]byte(document)))
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if metadata.Name != "Loot #1" || len(metadata.Attributes) != 1 || metadata.Raw["edition"] != 1.0 {
+		t.Errorf("Unexpected metadata: %+v", metadata)
+	}
+	image, _ := nfturi.Parse(metadata.Image)
+	if svg, err := image.Decode(); err != nil || string(svg) != "<svg/>" {
+		t.Errorf("Unexpected image: %q, %v", svg, err)
+	}
+
+	// Percent-encoded JSON, with a field of an unexpected type and the image under image_url.
+	metadata, err = nfturi.DecodeMetadata(`data:application/json;utf8,{"name":"Plain%20%231","description":42,"image_url":"ipfs://` + testCID + `"}`)
+	if err != nil || metadata.Name != "Plain #1" || metadata.Description != "" || metadata.Image != "ipfs://"+testCID {
+		t.Errorf("Unexpected metadata: %+v, %v", metadata, err)
+	}
+
+	if _, err := nfturi.DecodeMetadata("ipfs://" + testCID); !errors.Is(err, nfturi.ErrNotData) {
+		t.Errorf("Expected ErrNotData, got %v", err)
+	}
+	if _, err := nfturi.DecodeMetadata("data:image/png;base64,iVBORw0KGgo="); err == nil {
+		t.Errorf("Expected non-JSON data to be rejected")
+	}
+	if _, err := nfturi.DecodeMetadata("data:application/json;base64,!!!"); err == nil {
+		t.Errorf("Expected invalid base64 to be rejected")
+	}
+}
+
+func TestNftURI_Models(t *testing.T) {
+	tokenUrl := "data:application/json;base64," + base64.StdEncoding.EncodeToString([]byte(`{"name":"On-chain #7"}`))
+	var nft services.NftData
+	if err := json.Unmarshal([]byte(`{"token_id":"7","token_url":"`+tokenUrl+`","external_data":{"image":"ipfs://`+testCID+`/7.png","animation_url":"ar://`+testArweaveID+`/7.mp4"}}`), &nft); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if got := nft.ExternalData.ResolvedImage(nfturi.DefaultGateway); got != "https://ipfs.io/ipfs/"+testCID+"/7.png" {
+		t.Errorf("Unexpected image: %s", got)
+	}
+	if got := nft.ExternalData.ResolvedAnimationUrl(nfturi.Gateway{Arweave: "https://ar-io.net"}); got != "https://ar-io.net/"+testArweaveID+"/7.mp4" {
+		t.Errorf("Unexpected animation: %s", got)
+	}
+	if got := nft.ExternalData.ResolvedAssetUrl(nfturi.DefaultGateway); got != "" {
+		t.Errorf("Expected no asset, got %s", got)
+	}
+	if got := nft.ExternalData.MediaType(); got != "video/mp4" {
+		t.Errorf("Expected the animation media type, got %s", got)
+	}
+	if metadata, err := nft.InlineMetadata(); err != nil || metadata.Name != "On-chain #7" {
+		t.Errorf("Unexpected inline metadata: %+v, %v", metadata, err)
+	}
+
+	var balance services.BalanceNftData
+	if err := json.Unmarshal([]byte(`{"token_url":"https://example.com/7.json","external_data":{"image":"https://gateway.pinata.cloud/ipfs/`+testCID+`/7.gif"}}`), &balance); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if got := balance.ExternalData.ResolvedImage(nfturi.DefaultGateway); got != "https://ipfs.io/ipfs/"+testCID+"/7.gif" {
+		t.Errorf("Unexpected image: %s", got)
+	}
+	if got := balance.ExternalData.MediaType(); got != "image/gif" {
+		t.Errorf("Unexpected media type: %s", got)
+	}
+	if _, err := balance.InlineMetadata(); !errors.Is(err, nfturi.ErrNotData) {
+		t.Errorf("Expected ErrNotData, got %v", err)
+	}
+}
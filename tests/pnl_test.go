@@ -0,0 +1,188 @@
+package tests
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"math/big"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/covalenthq/covalent-api-sdk-go/chains"
+	"github.com/covalenthq/covalent-api-sdk-go/covalentclient"
+	"github.com/covalenthq/covalent-api-sdk-go/pnl"
+	"github.com/covalenthq/covalent-api-sdk-go/services"
+	"github.com/covalenthq/covalent-api-sdk-go/testutil"
+	"github.com/covalenthq/covalent-api-sdk-go/utils"
+)
+
+const pnlToken = "0xa0b86991c6218b36c1d19d4a2e9eb0ce3606eb48"
+
+func pnlTrade(day int, amount int64, price float64, fee float64) pnl.Trade {
+	return pnl.Trade{
+		Time:            time.Date(2023, 1, day, 0, 0, 0, 0, time.UTC),
+		ContractAddress: pnlToken,
+		Amount:          utils.NewTokenAmount(big.NewInt(amount), 0),
+		Price:           &price,
+		Fee:             fee,
+	}
+}
+
+func assertClose(t *testing.T, name string, got float64, expected float64) {
+	t.Helper()
+	if math.Abs(got-expected) > 1e-9 {
+		t.Errorf("Expected %s to be %v, got %v", name, expected, got)
+	}
+}
+
+func TestPnL_Methods(t *testing.T) {
+	trades := []pnl.Trade{
+		pnlTrade(3, -10, 4, 0),
+		pnlTrade(1, 10, 1, 1),
+		pnlTrade(2, 10, 3, 0),
+	}
+	reports := pnl.ComputeAll(trades, map[string]float64{"0xA0b86991c6218b36c1d19D4a2e9Eb0cE3606eB48": 5})
+	expected := map[pnl.Method][3]float64{
+		pnl.FIFO:        {29, 20, 30},
+		pnl.LIFO:        {10, 39, 11},
+		pnl.AverageCost: {19.5, 29.5, 20.5},
+	}
+	for method, values := range expected {
+		report := reports[method]
+		if len(report.Tokens) != 1 || report.Tokens[0].Balance.RatString() != "10" {
+			t.Fatalf("Unexpected %s report: %+v", method, report)
+		}
+		token := report.Tokens[0]
+		assertClose(t, string(method)+" realized", token.Realized, values[0])
+		assertClose(t, string(method)+" unrealized", token.Unrealized, values[1])
+		assertClose(t, string(method)+" cost basis", token.CostBasis, values[2])
+		assertClose(t, string(method)+" report realized", report.Realized, values[0])
+	}
+
+	if _, err := pnl.Compute(trades, "hifo", nil); err == nil {
+		t.Errorf("Expected an unknown method to be rejected")
+	}
+}
+
+func TestPnL_UnpricedAndUnmatched(t *testing.T) {
+	transferOut := pnlTrade(2, -4, 0, 0)
+	transferOut.Price = nil
+	report, err := pnl.Compute([]pnl.Trade{pnlTrade(1, 5, 2, 0), transferOut, pnlTrade(3, -3, 3, 0.5)}, pnl.FIFO, nil)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	token := report.Tokens[0]
+	// The transfer out leaves at cost; the sale of 3 matches the 1 unit left and 2 unmatched units at zero cost.
+	assertClose(t, "realized", token.Realized, 9-2-0.5)
+	if token.UnpricedOutflows != 1 || token.Unmatched.RatString() != "2" || token.Balance.Sign() != 0 || token.CurrentPrice != nil {
+		t.Errorf("Unexpected token: %+v", token)
+	}
+}
+
+func TestPnL_TradesFromModels(t *testing.T) {
+	wallet := "0xWallet"
+	var transfers []services.BlockTransactionWithContractTransfers
+	if err := json.Unmarshal([]byte(`[
+		{"block_signed_at":"2023-01-01T00:00:00Z","tx_hash":"0x1","from_address":"0xwallet","fees_paid":"1000000000000000","gas_quote":2,"gas_quote_rate":2000,"gas_metadata":{"contract_decimals":18,"contract_ticker_symbol":"ETH"},"transfers":[
+			{"block_signed_at":"2023-01-01T00:00:00Z","tx_hash":"0x1","contract_address":"0xA0B8","contract_ticker_symbol":"USDC","contract_decimals":6,"transfer_type":"transfer-in","delta":"100000000","quote_rate":1}]},
+		{"block_signed_at":"2023-01-02T00:00:00Z","tx_hash":"0x2","from_address":"0xother","transfers":[
+			{"block_signed_at":"2023-01-02T00:00:00Z","tx_hash":"0x2","contract_address":"0xa0b8","contract_decimals":6,"transfer_type":"transfer-out","delta":"40000000","quote_rate":1.5}]}
+	]`), &transfers); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	var transactions []services.Transaction
+	if err := json.Unmarshal([]byte(`[
+		{"block_signed_at":"2022-12-31T00:00:00Z","tx_hash":"0x0","successful":true,"from_address":"0xexchange","to_address":"0xwallet","value":"10000000000000000","gas_quote_rate":1000,"gas_metadata":{"contract_decimals":18,"contract_ticker_symbol":"ETH"}},
+		{"block_signed_at":"2023-01-01T00:00:00Z","tx_hash":"0x1","successful":true,"from_address":"0xwallet","to_address":"0xrouter","value":"0","fees_paid":"1000000000000000","gas_quote":2,"gas_quote_rate":2000,"gas_metadata":{"contract_decimals":18,"contract_ticker_symbol":"ETH"}}
+	]`), &transactions); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	trades := append(pnl.TradesFromTransfers(wallet, transfers), pnl.TradesFromTransactions(wallet, transactions)...)
+	if len(trades) != 5 {
+		t.Fatalf("Expected 5 trades, got %d: %+v", len(trades), trades)
+	}
+	report, _ := pnl.Compute(trades, pnl.FIFO, pnl.CurrentPrices([]services.BalanceItem{{ContractAddress: &[]string{pnl.NativeTokenAddress}[0], QuoteRate: &[]float64{3000}[0]}}))
+	if report.GasCosts != 2 {
+		t.Errorf("Expected the gas of 0x1 to be counted once, got %v", report.GasCosts)
+	}
+	usdc, native := report.Tokens[0], report.Tokens[1]
+	if usdc.ContractAddress != "0xa0b8" || usdc.Balance.RatString() != "60" {
+		t.Errorf("Unexpected USDC: %+v", usdc)
+	}
+	assertClose(t, "USDC realized", usdc.Realized, 20)
+	if native.Symbol != "ETH" || native.Balance.RatString() != "9/1000" {
+		t.Errorf("Unexpected native token: %+v", native)
+	}
+	// 0.001 ETH bought at 1000 is spent on gas: the cost of 1 is realized as a loss.
+	assertClose(t, "native realized", native.Realized, -1)
+	assertClose(t, "native unrealized", native.Unrealized, 27-9)
+}
+
+func TestPnL_HistoricalPrices(t *testing.T) {
+	requests := 0
+	testutil.UseMockTransport(t, func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		if from := r.URL.Query().Get("from"); from != "2023-01-01" || r.URL.Query().Get("to") != from {
+			t.Errorf("Unexpected range: %s", r.URL.RawQuery)
+		}
+		fmt.Fprint(w, `{"data":[{"contract_address":"0xa0b8","prices":[{"date":"2023-01-01","price":0.99}]}],"error":false,"error_code":null,"error_message":null}`)
+	})
+	client, err := covalentclient.NewClient(covalentclient.WithAPIKey(testutil.MockAPIKey))
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	day := time.Date(2023, 1, 1, 15, 0, 0, 0, time.UTC)
+	trades := []pnl.Trade{
+		{Time: day, ContractAddress: "0xa0b8", Amount: utils.NewTokenAmount(big.NewInt(1), 0)},
+		{Time: day, ContractAddress: "0xA0B8", Amount: utils.NewTokenAmount(big.NewInt(2), 0)},
+		{Time: day, ContractAddress: "0xa0b8", Amount: utils.NewTokenAmount(big.NewInt(-1), 0)},
+	}
+	if err := pnl.PriceInflows(trades, pnl.NewHistoricalPrices(client.PricingService, chains.EthMainnet, "USD")); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if *trades[0].Price != 0.99 || *trades[1].Price != 0.99 || trades[2].Price != nil || requests != 1 {
+		t.Errorf("Unexpected prices or %d requests: %+v", requests, trades)
+	}
+}
+
+func TestPnL_HistoricalPricesFetchWithoutLock(t *testing.T) {
+	slow := make(chan struct{})
+	testutil.UseMockTransport(t, func(w http.ResponseWriter, r *http.Request) {
+		if strings.Contains(r.URL.Path, "/0xslow/") {
+			<-slow
+		}
+		fmt.Fprint(w, `{"data":[{"contract_address":"0xfast","prices":[{"date":"2023-01-01","price":1}]}],"error":false,"error_code":null,"error_message":null}`)
+	})
+	client, err := covalentclient.NewClient(covalentclient.WithAPIKey(testutil.MockAPIKey))
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	prices := pnl.NewHistoricalPrices(client.PricingService, chains.EthMainnet, "USD")
+	day := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	done := make(chan struct{})
+	go func() {
+		prices.Price("0xslow", day)
+		close(done)
+	}()
+	time.Sleep(20 * time.Millisecond)
+	fast := make(chan float64)
+	go func() {
+		price, _, _ := prices.Price("0xfast", day)
+		fast <- price
+	}()
+	select {
+	case price := <-fast:
+		if price != 1 {
+			t.Errorf("Expected a price of 1 for 0xfast, got %v", price)
+		}
+	case <-time.After(5 * time.Second):
+		t.Errorf("Expected 0xfast not to wait for the 0xslow request")
+	}
+	close(slow)
+	<-done
+}
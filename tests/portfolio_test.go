@@ -0,0 +1,101 @@
+package tests
+
+import (
+	"encoding/json"
+	"math"
+	"testing"
+
+	"github.com/covalenthq/covalent-api-sdk-go/portfolio"
+	"github.com/covalenthq/covalent-api-sdk-go/services"
+)
+
+func balancesFixture(t *testing.T, document string) services.BalancesResponse {
+	t.Helper()
+	var response services.BalancesResponse
+	if err := json.Unmarshal([]byte(document), &response); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	return response
+}
+
+func TestAggregate_MergesWalletsAndChains(t *testing.T) {
+	responses := []services.BalancesResponse{
+		balancesFixture(t, `{"address":"0xAAA","chain_id":1,"chain_name":"eth-mainnet","quote_currency":"USD","items":[
+			{"contract_ticker_symbol":"ETH","contract_name":"Ether","contract_address":"0xeeee","contract_decimals":18,"balance":"2000000000000000000","quote":6000,"quote_24h":5000,"type":"cryptocurrency","native_token":true},
+			{"contract_ticker_symbol":"USDC","contract_name":"USD Coin","contract_address":"0xa0b8","contract_decimals":6,"balance":"1000000000","quote":1000,"quote_24h":1000,"type":"stablecoin"},
+			{"contract_ticker_symbol":"SCAM","contract_address":"0x5ca1","contract_decimals":18,"balance":"1","quote":50,"is_spam":true,"type":"cryptocurrency"},
+			{"contract_ticker_symbol":"DUST","contract_address":"0xd057","contract_decimals":18,"balance":"1","quote":0.01,"type":"dust"}]}`),
+		balancesFixture(t, `{"address":"0xbbb","chain_id":43114,"chain_name":"avalanche-mainnet","quote_currency":"USD","items":[
+			{"contract_ticker_symbol":"USDC.e","contract_name":"Bridged USDC","contract_address":"0xa7d7","contract_decimals":6,"balance":"3000000000","quote":3000,"quote_24h":2500,"type":"stablecoin"},
+			{"contract_address":"0x0001","contract_decimals":0,"balance":"5","quote":0,"type":"cryptocurrency"}]}`),
+	}
+	excludeSpam, excludeDust := true, true
+	report, err := portfolio.Aggregate(responses, portfolio.AggregateOpts{ExcludeSpam: &excludeSpam, ExcludeDust: &excludeDust})
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	if report.QuoteCurrency != "USD" || report.TotalQuote != 10000 || report.TotalQuote24h != 8500 || report.Excluded != 2 {
+		t.Errorf("Unexpected totals: %+v", report)
+	}
+	if report.Change24h != 1500 || report.Change24hPercent == nil || math.Abs(*report.Change24hPercent-17.647) > 0.001 {
+		t.Errorf("Unexpected 24h change: %v %v", report.Change24h, report.Change24hPercent)
+	}
+	if len(report.Wallets) != 2 || report.Wallets[0] != "0xaaa" || len(report.Chains) != 2 {
+		t.Errorf("Unexpected wallets or chains: %v %v", report.Wallets, report.Chains)
+	}
+	if len(report.Assets) != 3 {
+		t.Fatalf("Expected 3 assets, got %+v", report.Assets)
+	}
+
+	eth, usdc := report.Assets[0], report.Assets[1]
+	if eth.Key != "ETH" || eth.Allocation != 60 || eth.Balance.RatString() != "2" || *eth.Change24hPercent != 20 {
+		t.Errorf("Unexpected ETH asset: %+v", eth)
+	}
+	if usdc.Key != "USDC" || len(usdc.Holdings) != 2 || usdc.Quote != 4000 || usdc.Balance.RatString() != "4000" || usdc.Allocation != 40 {
+		t.Errorf("Unexpected USDC asset: %+v", usdc)
+	}
+	if unnamed := report.Assets[2]; unnamed.Key != "avalanche-mainnet:0x0001" || unnamed.Change24hPercent != nil {
+		t.Errorf("Unexpected asset without symbol: %+v", unnamed)
+	}
+
+	minQuote := 2000.0
+	report, _ = portfolio.Aggregate(responses, portfolio.AggregateOpts{MinQuote: &minQuote, Aliases: map[string]string{}})
+	if len(report.Assets) != 2 || report.Assets[0].Key != "ETH" || report.Assets[1].Key != "avalanche-mainnet:0xa7d7" {
+		t.Errorf("Unexpected assets without aliases: %+v", report.Assets)
+	}
+
+	euros := balancesFixture(t, `{"address":"0xccc","chain_name":"eth-mainnet","quote_currency":"EUR","items":[]}`)
+	if _, err := portfolio.Aggregate(append(responses, euros)); err == nil {
+		t.Errorf("Expected an error for mixed quote currencies")
+	}
+}
+
+func TestAggregate_GroupsByContract(t *testing.T) {
+	responses := []services.BalancesResponse{
+		balancesFixture(t, `{"address":"0xaaa","chain_name":"eth-mainnet","quote_currency":"USD","items":[
+			{"contract_ticker_symbol":"USDC","contract_address":"0xa0b8","contract_decimals":6,"balance":"1000000","quote":1},
+			{"contract_ticker_symbol":"USDC","contract_address":"0xfake","contract_decimals":6,"balance":"5000000","quote":5,"is_spam":true},
+			{"contract_ticker_symbol":"PEPE","contract_address":"0x6982","contract_decimals":18,"balance":"1","quote":2}]}`),
+		balancesFixture(t, `{"address":"0xaaa","chain_name":"base-mainnet","quote_currency":"USD","items":[
+			{"contract_ticker_symbol":"PEPE","contract_address":"0xcopy","contract_decimals":18,"balance":"1","quote":3}]}`),
+	}
+	report, err := portfolio.Aggregate(responses)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	keys := map[string]float64{}
+	for _, asset := range report.Assets {
+		keys[asset.Key] = asset.Quote
+	}
+	if len(keys) != 4 || keys["USDC"] != 1 || keys["eth-mainnet:0xfake"] != 5 || keys["eth-mainnet:0x6982"] != 2 || keys["base-mainnet:0xcopy"] != 3 {
+		t.Errorf("Expected spam and unlisted tokens to stay apart, got %v", keys)
+	}
+
+	report, _ = portfolio.Aggregate(responses, portfolio.AggregateOpts{Contracts: map[string]string{"eth-mainnet:0x6982": "PEPE", "base-mainnet:0xcopy": "PEPE"}})
+	for _, asset := range report.Assets {
+		if asset.Key == "PEPE" && (asset.Quote != 5 || len(asset.Holdings) != 2) {
+			t.Errorf("Expected listed contracts to be grouped, got %+v", asset)
+		}
+	}
+}
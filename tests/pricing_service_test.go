@@ -1,10 +1,15 @@
 package tests
 
 import (
+	"fmt"
+	"net/http"
 	"testing"
 
 	"github.com/covalenthq/covalent-api-sdk-go/chains"
+	"github.com/covalenthq/covalent-api-sdk-go/covalentclient"
+	"github.com/covalenthq/covalent-api-sdk-go/services"
 	"github.com/covalenthq/covalent-api-sdk-go/testutil"
+	"github.com/covalenthq/covalent-api-sdk-go/utils"
 )
 
 func TestGetTokenPrices(t *testing.T) {
@@ -18,3 +23,37 @@ func TestGetTokenPrices(t *testing.T) {
 		}
 	}
 }
+
+func TestGetTokenPrices_MultipleContracts(t *testing.T) {
+	testutil.UseMockTransport(t, func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"data":[
+			{"contract_address":"0xb8c77482e45f1f44de1745f52c74426c631bdd52","prices":[{"date":"2023-11-23","price":230.5}]},
+			{"contract_address":"0xa0b86991c6218b36c1d19d4a2e9eb0ce3606eb48","prices":[{"date":"2023-11-23","price":1.0}]}
+		],"error":false,"error_code":null,"error_message":null}`)
+	})
+
+	client, err := covalentclient.NewClient(covalentclient.WithAPIKey(testutil.MockAPIKey))
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	var resp *utils.Response[services.TokenPricesList]
+	resp, err = client.PricingService.GetTokenPrices(chains.EthMainnet, "USD", "0xb8c77482e45f1f44de1745f52c74426c631bdd52,0xA0b86991c6218b36c1d19D4a2e9Eb0cE3606eB48")
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if len(*resp.Data) != 2 {
+		t.Fatalf("Expected 2 entries, got %d", len(*resp.Data))
+	}
+
+	usdc := resp.Data.ForContract("0xA0b86991c6218b36c1d19D4a2e9Eb0cE3606eB48")
+	if usdc == nil || *usdc.Prices[0].Price != 1.0 || usdc.Prices[0].Date.Format("2006-01-02") != "2023-11-23" {
+		t.Errorf("Unexpected USDC prices: %+v", usdc)
+	}
+	if resp.Data.ForContract("0x0000000000000000000000000000000000000000") != nil {
+		t.Errorf("Expected nil for a contract that was not requested")
+	}
+	byContract := resp.Data.ByContract()
+	if _, ok := byContract[utils.MustParseAddress("0xB8c77482e45F1F44dE1745F52C74426C631bDD52")]; !ok || len(byContract) != 2 {
+		t.Errorf("Unexpected prices by contract: %v", byContract)
+	}
+}
@@ -0,0 +1,141 @@
+package tests
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/covalenthq/covalent-api-sdk-go/rarity"
+	"github.com/covalenthq/covalent-api-sdk-go/services"
+)
+
+func rarityTokens(t *testing.T) []rarity.Token {
+	results := make(chan services.NftTokenContractResult, 5)
+	for _, document := range []string{
+		`{"nft_data":{"token_id":"2","external_data":{"attributes":[{"trait_type":"Hat","value":"Cap"},{"trait_type":"Eyes","value":"Blue"}]}}}`,
+		`{"nft_data":{"token_id":"1","external_data":{"attributes":[{"trait_type":"Hat","value":"Cap"},{"trait_type":"Eyes","value":"Blue"}]}}}`,
+		`{"nft_data":{"token_id":"3","external_data":{"attributes":[{"trait_type":"Hat","value":"Crown"},{"trait_type":"Eyes","value":"Blue"}]}}}`,
+		`{"nft_data":{"token_id":"10","external_data":{"attributes":[{"trait_type":"Eyes","value":"Laser"},{"trait_type":"Hat","value":null}]}}}`,
+		`{"contract_name":"no token"}`,
+	} {
+		var token services.NftTokenContract
+		if err := json.Unmarshal([]byte(document), &token); err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		results <- services.NftTokenContractResult{NftTokenContract: token}
+	}
+	close(results)
+	tokens, err := rarity.Collect(results)
+	if err != nil || len(tokens) != 4 {
+		t.Fatalf("Expected 4 tokens, got %d, %v", len(tokens), err)
+	}
+	return tokens
+}
+
+func TestRarity_Rank(t *testing.T) {
+	tokens := rarityTokens(t)
+	scores, err := rarity.Rank(tokens, nil, rarity.TraitRarity)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	// Hat: Cap 2, Crown 1, None 1 of 4. Eyes: Blue 3, Laser 1. Trait count: 2 traits 3, 1 trait 1.
+	expected := []struct {
+		tokenId string
+		score   float64
+		rank    int
+	}{{"10", 12, 1}, {"3", 4 + 4.0/3 + 4.0/3, 2}, {"1", 2 + 4.0/3 + 4.0/3, 3}, {"2", 2 + 4.0/3 + 4.0/3, 3}}
+	for i, e := range expected {
+		if scores[i].TokenId != e.tokenId || scores[i].Rank != e.rank {
+			t.Errorf("Expected token %s at rank %d, got %+v", e.tokenId, e.rank, scores[i])
+		}
+		assertClose(t, "score of "+e.tokenId, scores[i].Score, e.score)
+	}
+	laser := scores[0].Traits
+	if len(laser) != 3 || laser[1].Trait != "Hat" || laser[1].Value != rarity.NoneValue || laser[2].Trait != rarity.TraitCount || laser[2].Value != "1" {
+		t.Errorf("Unexpected trait scores: %+v", laser)
+	}
+
+	statistical, _ := rarity.Rank(tokens, nil, rarity.Statistical)
+	assertClose(t, "statistical score", statistical[0].Score, 64)
+
+	exclude := true
+	information, _ := rarity.Rank(tokens, nil, rarity.InformationContent, rarity.ScoreOpts{ExcludeTraitCount: &exclude, ExcludeMissing: &exclude})
+	// Without None and trait count, token 10 carries only Laser: 2 bits, over an entropy of 1.5 + 0.811.
+	if information[0].TokenId != "3" || len(information[0].Traits) != 2 {
+		t.Errorf("Unexpected information content ranking: %+v", information)
+	}
+
+	if _, err := rarity.Rank(tokens, nil, "openrarity"); err == nil {
+		t.Errorf("Expected an unknown method to be rejected")
+	}
+	if _, err := rarity.NewTable(0).Score(tokens[0], rarity.TraitRarity); !errors.Is(err, rarity.ErrNoTotal) {
+		t.Errorf("Expected ErrNoTotal, got %v", err)
+	}
+}
+
+func TestRarity_InformationContentTies(t *testing.T) {
+	tokens := rarityTokens(t)
+	table := rarity.TableFromTokens(tokens)
+	for i := 0; i < 50; i++ {
+		scores, err := rarity.Rank(tokens, table, rarity.InformationContent)
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		// Tokens 1 and 2 have the same traits, so they must tie whatever the order of the maps of the table.
+		if scores[2].TokenId != "1" || scores[3].TokenId != "2" || scores[2].Score != scores[3].Score || scores[2].Rank != scores[3].Rank {
+			t.Fatalf("Expected tokens 1 and 2 to tie, got %+v and %+v", scores[2], scores[3])
+		}
+		alone, _ := table.Score(tokens[0], rarity.InformationContent)
+		if alone.Score != scores[3].Score {
+			t.Fatalf("Expected Score and Rank to agree, got %v and %v", alone.Score, scores[3].Score)
+		}
+	}
+}
+
+func TestRarity_Summaries(t *testing.T) {
+	var summary services.NftCollectionTraitSummaryResponse
+	if err := json.Unmarshal([]byte(`{"items":[{"name":"Hat","value_type":"string","attributes":[{"trait_type":"Hat","values":[{"value":"Cap","count":600},{"value":"Crown","count":10}]}]}]}`), &summary); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	var attributes services.NftCollectionAttributesForTraitResponse
+	if err := json.Unmarshal([]byte(`{"items":[{"trait_type":"Eyes","values":[{"value":"Blue","count":900},{"value":"Laser","count":100}],"unique_values":2}]}`), &attributes); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	table := rarity.NewTable(1000)
+	table.AddSummary(summary)
+	table.AddAttributes(attributes)
+	if table.Count("hat", "crown") != 10 || len(table.Traits()) != 2 {
+		t.Fatalf("Unexpected table: %v", table.Traits())
+	}
+
+	tokens := rarityTokens(t)
+	scores, err := rarity.Rank(tokens, table, rarity.TraitRarity)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	// Token 3 has a Crown, 1 in 100 tokens; token 10 has no hat, 390 in 1000, and Laser eyes, 1 in 10.
+	if scores[0].TokenId != "3" || scores[0].Traits[1].Count != 10 || scores[1].TokenId != "10" || scores[1].Traits[1].Count != 390 {
+		t.Errorf("Unexpected ranking: %+v", scores)
+	}
+}
+
+func TestRarity_RankKeepsTable(t *testing.T) {
+	var attributes services.NftCollectionAttributesForTraitResponse
+	if err := json.Unmarshal([]byte(`{"items":[{"trait_type":"Eyes","values":[{"value":"Blue","count":3},{"value":"Laser","count":1}],"unique_values":2}]}`), &attributes); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	table := rarity.NewTable(0)
+	table.AddAttributes(attributes)
+
+	tokens := rarityTokens(t)
+	scores, err := rarity.Rank(tokens, table, rarity.TraitRarity)
+	if err != nil || len(scores) != 4 || scores[0].Traits[len(scores[0].Traits)-1].Trait != rarity.TraitCount {
+		t.Fatalf("Expected the tokens to be ranked with their trait counts, got %+v, %v", scores, err)
+	}
+	if table.Total != 0 {
+		t.Errorf("Expected the table to be left unchanged, got a total of %d", table.Total)
+	}
+	if _, err := table.Score(tokens[0], rarity.TraitRarity); !errors.Is(err, rarity.ErrNoTotal) {
+		t.Errorf("Expected ErrNoTotal, got %v", err)
+	}
+}
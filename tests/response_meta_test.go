@@ -0,0 +1,87 @@
+package tests
+
+import (
+	"fmt"
+	"net/http"
+	"sync"
+	"testing"
+
+	"github.com/covalenthq/covalent-api-sdk-go/chains"
+	"github.com/covalenthq/covalent-api-sdk-go/covalentclient"
+	"github.com/covalenthq/covalent-api-sdk-go/services"
+	"github.com/covalenthq/covalent-api-sdk-go/testutil"
+	"github.com/covalenthq/covalent-api-sdk-go/utils"
+)
+
+func TestResponseMeta(t *testing.T) {
+	body := `{"data":{"address":"0x1","items":[]},"error":false,"error_code":null,"error_message":null}`
+	testutil.UseMockTransport(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("X-Request-Id", "req-123")
+		w.Header().Set("X-RateLimit-Remaining", "42")
+		fmt.Fprint(w, body)
+	})
+
+	var hooked []*utils.ResponseMeta
+	client, err := covalentclient.NewClient(covalentclient.WithAPIKey(testutil.MockAPIKey), covalentclient.WithResponseHook(func(meta *utils.ResponseMeta) {
+		hooked = append(hooked, meta)
+	}))
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	resp, err := client.BalanceService.GetTokenBalancesForWalletAddress(chains.EthMainnet, "0x1")
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	meta := resp.Meta
+	if meta == nil {
+		t.Fatalf("Expected response metadata, got nil")
+	}
+	if meta.StatusCode != http.StatusOK || meta.Attempts != 1 {
+		t.Errorf("Unexpected status %d or attempts %d", meta.StatusCode, meta.Attempts)
+	}
+	if meta.RequestID() != "req-123" {
+		t.Errorf("Unexpected request ID: %s", meta.RequestID())
+	}
+	if remaining, ok := meta.RateLimitRemaining(); !ok || remaining != 42 {
+		t.Errorf("Unexpected rate limit remaining: %d", remaining)
+	}
+	if meta.Bytes != int64(len(body)) {
+		t.Errorf("Expected %d bytes, got %d", len(body), meta.Bytes)
+	}
+	if meta.URL != "https://api.covalenthq.com/v1/eth-mainnet/address/0x1/balances_v2/" {
+		t.Errorf("Unexpected URL: %s", meta.URL)
+	}
+	if len(hooked) != 1 || hooked[0] != meta {
+		t.Errorf("Expected the hook to receive the response metadata once, got %d calls", len(hooked))
+	}
+}
+
+func TestResponseMeta_PaginatedHook(t *testing.T) {
+	testutil.UseMockTransport(t, func(w http.ResponseWriter, r *http.Request) {
+		hasMore := r.URL.Query().Get("page-number") == "0"
+		fmt.Fprintf(w, `{"data":{"items":[{"address":"0x1"}],"pagination":{"has_more":%v}},"error":false,"error_code":null,"error_message":null}`, hasMore)
+	})
+
+	var mu sync.Mutex
+	var urls []string
+	client, err := covalentclient.NewClient(covalentclient.WithAPIKey(testutil.MockAPIKey), covalentclient.WithResponseHook(func(meta *utils.ResponseMeta) {
+		mu.Lock()
+		defer mu.Unlock()
+		urls = append(urls, meta.URL)
+	}))
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	var holders []services.TokenHolderResult
+	for result := range client.BalanceService.GetTokenHoldersV2ForTokenAddress(chains.EthMainnet, "0xtoken") {
+		holders = append(holders, result)
+	}
+	if len(holders) != 2 {
+		t.Errorf("Expected 2 holders, got %d", len(holders))
+	}
+	if len(urls) != 2 {
+		t.Errorf("Expected the hook to be called for each page, got %v", urls)
+	}
+}
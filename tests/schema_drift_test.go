@@ -0,0 +1,89 @@
+package tests
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/covalenthq/covalent-api-sdk-go/chains"
+	"github.com/covalenthq/covalent-api-sdk-go/covalentclient"
+	"github.com/covalenthq/covalent-api-sdk-go/testutil"
+	"github.com/covalenthq/covalent-api-sdk-go/utils"
+)
+
+const driftedBalancesBody = `{"data":{"address":"0x1","chain_id":1,"new_field":"x","items":[
+	{"contract_decimals":18,"supports_erc":"erc20","brand_new":{"a":1}},
+	{"contract_decimals":6,"supports_erc":["erc20"]}
+]},"error":false,"error_code":null,"error_message":null}`
+
+func TestStrictDecoding_ReportsDrift(t *testing.T) {
+	testutil.UseMockTransport(t, func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, driftedBalancesBody)
+	})
+
+	recorder := utils.NewSchemaDriftRecorder()
+	client, err := covalentclient.NewClient(covalentclient.WithAPIKey(testutil.MockAPIKey), covalentclient.WithStrictDecoding(recorder.Record, false))
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	resp, err := client.BalanceService.GetTokenBalancesForWalletAddress(chains.EthMainnet, "0x1")
+	if err != nil {
+		t.Fatalf("Expected drift not to fail the call, got: %v", err)
+	}
+	if resp.Data == nil || len(resp.Data.Items) != 2 || *resp.Data.Items[1].ContractDecimals != 6 {
+		t.Fatalf("Expected the response to still be decoded")
+	}
+
+	drifts := recorder.Drifts()["GetTokenBalancesForWalletAddress"]
+	expected := []utils.SchemaDrift{
+		{Endpoint: "GetTokenBalancesForWalletAddress", Path: "data.items[].brand_new", Kind: utils.SchemaDriftUnknownField, JSONType: "object"},
+		{Endpoint: "GetTokenBalancesForWalletAddress", Path: "data.items[].supports_erc", Kind: utils.SchemaDriftMistypedField, JSONType: "string", GoType: "[]string"},
+		{Endpoint: "GetTokenBalancesForWalletAddress", Path: "data.new_field", Kind: utils.SchemaDriftUnknownField, JSONType: "string"},
+	}
+	if len(drifts) != len(expected) {
+		t.Fatalf("Expected %d drifts, got %v", len(expected), drifts)
+	}
+	for i := range expected {
+		if drifts[i] != expected[i] {
+			t.Errorf("Expected %+v, got %+v", expected[i], drifts[i])
+		}
+	}
+}
+
+func TestStrictDecoding_FailOnDrift(t *testing.T) {
+	testutil.UseMockTransport(t, func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, driftedBalancesBody)
+	})
+
+	client, err := covalentclient.NewClient(covalentclient.WithAPIKey(testutil.MockAPIKey), covalentclient.WithStrictDecoding(nil, true))
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	_, err = client.BalanceService.GetTokenBalancesForWalletAddress(chains.EthMainnet, "0x1")
+	var driftErr *utils.SchemaDriftError
+	if !errors.As(err, &driftErr) {
+		t.Fatalf("Expected a SchemaDriftError, got: %v", err)
+	}
+	if driftErr.Endpoint != "GetTokenBalancesForWalletAddress" || len(driftErr.Drifts) != 3 {
+		t.Errorf("Unexpected drift error: %v", driftErr)
+	}
+}
+
+func TestStrictDecoding_Off(t *testing.T) {
+	testutil.UseMockTransport(t, func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"data":{"address":"0x1","new_field":"x","items":[]},"error":false,"error_code":null,"error_message":null}`)
+	})
+
+	client, err := covalentclient.NewClient(covalentclient.WithAPIKey(testutil.MockAPIKey))
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	resp, err := client.BalanceService.GetTokenBalancesForWalletAddress(chains.EthMainnet, "0x1")
+	if err != nil || resp.Data == nil {
+		t.Fatalf("Expected unknown fields to be ignored, got: %v", err)
+	}
+}
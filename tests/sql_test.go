@@ -0,0 +1,128 @@
+package tests
+
+import (
+	"database/sql/driver"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/covalenthq/covalent-api-sdk-go/testutil"
+	"github.com/covalenthq/covalent-api-sdk-go/utils"
+)
+
+func TestSQL_RoundTrip(t *testing.T) {
+	db, err := testutil.OpenFakeSQL()
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	defer db.Close()
+
+	balance, _ := new(big.Int).SetString("340282366920938463463374607431768211455", 10)
+	date := time.Date(2023, 11, 23, 0, 0, 0, 0, time.UTC)
+	amount, _ := utils.ParseTokenAmount("1.5", 6)
+	address := utils.MustParseAddress("0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAed")
+
+	_, err = db.Exec("INSERT INTO sql_round_trip", utils.BigInt{Int: balance}, utils.NewDate(date), amount, address, utils.BigInt{}, utils.CustomTime{}, nil)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	var (
+		readBalance utils.BigInt
+		readDate    utils.CustomTime
+		readAmount  utils.TokenAmount
+		readAddress utils.Address
+		nullBalance = utils.BigInt{Int: big.NewInt(1)}
+		nullDate    = utils.NewDate(date)
+		nullAmount  = amount
+	)
+	err = db.QueryRow("SELECT FROM sql_round_trip").Scan(&readBalance, &readDate, &readAmount, &readAddress, &nullBalance, &nullDate, &nullAmount)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if readBalance.Cmp(balance) != 0 {
+		t.Errorf("Unexpected balance: %s", readBalance)
+	}
+	if readDate.Time == nil || !readDate.Time.Equal(date) || !readDate.IsDateOnly() {
+		t.Errorf("Unexpected date: %v", readDate.Time)
+	}
+	if readAmount.Cmp(amount) != 0 || readAmount.Decimals() != 6 {
+		t.Errorf("Unexpected amount: %s with %d decimals", readAmount, readAmount.Decimals())
+	}
+	if readAddress != address {
+		t.Errorf("Unexpected address: %s", readAddress)
+	}
+	if nullBalance.Int != nil || nullDate.Time != nil || !nullAmount.IsZero() {
+		t.Errorf("Expected NULLs to reset the values, got %v, %v and %s", nullBalance.Int, nullDate.Time, nullAmount)
+	}
+}
+
+func TestSQL_Values(t *testing.T) {
+	balance, _ := new(big.Int).SetString("-12345678901234567890", 10)
+	amount, _ := utils.ParseTokenAmount("1.5", 6)
+	values := []struct {
+		valuer   driver.Valuer
+		expected driver.Value
+	}{
+		{utils.BigInt{Int: balance}, "-12345678901234567890"},
+		{utils.BigInt{}, nil},
+		{amount, "1.500000"},
+		{utils.MustParseAddress("0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAed"), "0x5aaeb6053f3e94c9b9a09f33669435e7ef1beaed"},
+		{utils.CustomTime{}, nil},
+		{utils.NewDate(time.Date(2023, 11, 23, 15, 0, 0, 0, time.FixedZone("", 3600))), time.Date(2023, 11, 23, 0, 0, 0, 0, time.UTC)},
+	}
+	for _, v := range values {
+		value, err := v.valuer.Value()
+		if err != nil || value != v.expected {
+			t.Errorf("Expected %v, got %v (%v)", v.expected, value, err)
+		}
+	}
+}
+
+func TestSQL_ScanDriverTypes(t *testing.T) {
+	var b utils.BigInt
+	for src, expected := range map[interface{}]string{int64(42): "42", float64(1e15): "1000000000000000", "7.000": "7"} {
+		if err := b.Scan(src); err != nil || b.String() != expected {
+			t.Errorf("Expected %s from %v, got %s (%v)", expected, src, b, err)
+		}
+	}
+	for _, src := range []interface{}{[]byte("1.5"), float64(1.5), float64(1e20)} {
+		if err := b.Scan(src); err == nil {
+			t.Errorf("Expected an error for %v, which is not an exact integer", src)
+		}
+	}
+
+	var ct utils.CustomTime
+	for _, src := range []interface{}{"2023-11-23 10:20:30", []byte("2023-11-23T10:20:30Z"), time.Date(2023, 11, 23, 10, 20, 30, 0, time.UTC)} {
+		if err := ct.Scan(src); err != nil || !ct.Time.Equal(time.Date(2023, 11, 23, 10, 20, 30, 0, time.UTC)) {
+			t.Errorf("Unexpected time from %v: %v (%v)", src, ct.Time, err)
+		}
+	}
+	if ct.IsDateOnly() {
+		t.Errorf("Expected a timestamp, got a date")
+	}
+	for _, src := range []interface{}{"2023-11-23", time.Date(2023, 11, 23, 0, 0, 0, 0, time.UTC)} {
+		if err := ct.Scan(src); err != nil || !ct.IsDateOnly() {
+			t.Errorf("Expected a date from %v, got %v (%v)", src, ct.Time, err)
+		}
+	}
+
+	var amount utils.TokenAmount
+	for src, expected := range map[float64]string{1.5: "1.5", 0.1: "0.1", -2: "-2", 123456.789: "123456.789"} {
+		if err := amount.Scan(src); err != nil || amount.String() != expected {
+			t.Errorf("Expected %s from %v, got %s (%v)", expected, src, amount, err)
+		}
+	}
+	for _, src := range []float64{0.30000000000000004, 1e20} {
+		if err := amount.Scan(src); err == nil {
+			t.Errorf("Expected an error for %v, which is not an exact amount", src)
+		}
+	}
+
+	var address utils.Address
+	raw := make([]byte, utils.AddressLength)
+	raw[19] = 1
+	if err := address.Scan(raw); err != nil || address.Lower() != "0x0000000000000000000000000000000000000001" {
+		t.Errorf("Unexpected address from raw bytes: %s (%v)", address, err)
+	}
+}
@@ -0,0 +1,91 @@
+package tests
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/covalenthq/covalent-api-sdk-go/genericmodels"
+	"github.com/covalenthq/covalent-api-sdk-go/standardevents"
+	"github.com/covalenthq/covalent-api-sdk-go/utils"
+)
+
+func rawLogEvent(topics []string, data string) genericmodels.LogEvent {
+	return genericmodels.LogEvent{RawLogTopics: &topics, RawLogData: &data}
+}
+
+func TestStandardEvents_TopicsAndTransfers(t *testing.T) {
+	if standardevents.TransferTopic != "0xddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef" {
+		t.Errorf("Unexpected Transfer topic: %s", standardevents.TransferTopic)
+	}
+
+	from := "0x000000000000000000000000a9d1e08c7793af67e9d92fe308d5697fb81d3e43"
+	to := "0x00000000000000000000000077696bb39917c91a0c3908d577d5e322095425ca"
+	erc20 := rawLogEvent([]string{standardevents.TransferTopic, from, to}, "0x00000000000000000000000000000000000000000000000000000000017d7840")
+	event, err := standardevents.Decode(erc20)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	transfer, ok := event.(*standardevents.ERC20Transfer)
+	if !ok || transfer.From != utils.MustParseAddress("0xa9d1e08c7793af67e9d92fe308d5697fb81d3e43") || transfer.Value.Int64() != 25000000 {
+		t.Errorf("Unexpected ERC-20 transfer: %#v", event)
+	}
+
+	tokenId := "0x0000000000000000000000000000000000000000000000000000000000000539"
+	erc721 := rawLogEvent([]string{standardevents.TransferTopic, from, to, tokenId}, "0x")
+	if kind := standardevents.Classify(erc721); kind != standardevents.ERC721TransferKind {
+		t.Errorf("Expected an ERC-721 transfer, got %q", kind)
+	}
+	event, err = standardevents.Decode(erc721)
+	if nft, ok := event.(*standardevents.ERC721Transfer); err != nil || !ok || nft.TokenId.Int64() != 1337 {
+		t.Errorf("Unexpected ERC-721 transfer: %#v %v", event, err)
+	}
+
+	other := rawLogEvent([]string{"0x1c411e9a96e071241c2f21f7726b17ae89e3cab4c78be50e062b03a9fffbbad1"}, "0x")
+	if _, err := standardevents.Decode(other); !errors.Is(err, standardevents.ErrNotStandard) {
+		t.Errorf("Expected ErrNotStandard, got %v", err)
+	}
+}
+
+func TestStandardEvents_ERC1155TransferBatch(t *testing.T) {
+	operator := "0x0000000000000000000000005aaeb6053f3e94c9b9a09f33669435e7ef1beaed"
+	zero := "0x0000000000000000000000000000000000000000000000000000000000000000"
+	data := "0x" +
+		"0000000000000000000000000000000000000000000000000000000000000040" +
+		"00000000000000000000000000000000000000000000000000000000000000a0" +
+		"0000000000000000000000000000000000000000000000000000000000000002" +
+		"0000000000000000000000000000000000000000000000000000000000000001" +
+		"0000000000000000000000000000000000000000000000000000000000000002" +
+		"0000000000000000000000000000000000000000000000000000000000000002" +
+		"000000000000000000000000000000000000000000000000000000000000000a" +
+		"0000000000000000000000000000000000000000000000000000000000000014"
+	event, err := standardevents.Decode(rawLogEvent([]string{standardevents.TransferBatchTopic, operator, zero, operator}, data))
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	batch, ok := event.(*standardevents.ERC1155TransferBatch)
+	if !ok || !batch.From.IsZero() || len(batch.Ids) != 2 || batch.Ids[1].Int64() != 2 || batch.Values[1].Int64() != 20 {
+		t.Errorf("Unexpected batch: %#v", event)
+	}
+}
+
+func TestStandardEvents_FromDecoded(t *testing.T) {
+	var log genericmodels.LogEvent
+	if err := json.Unmarshal([]byte(`{"decoded": {"name": "Transfer", "signature": "Transfer(indexed address src, indexed address dst, uint256 wad)", "params": [
+		{"name": "src", "type": "address", "indexed": true, "decoded": true, "value": "0xa9d1e08c7793af67e9d92fe308d5697fb81d3e43"},
+		{"name": "dst", "type": "address", "indexed": true, "decoded": true, "value": "0x77696bb39917c91a0c3908d577d5e322095425ca"},
+		{"name": "wad", "type": "uint256", "indexed": false, "decoded": true, "value": "1000"}
+	]}}`), &log); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	event, err := standardevents.Decode(log)
+	transfer, ok := event.(*standardevents.ERC20Transfer)
+	if err != nil || !ok || transfer.To != utils.MustParseAddress("0x77696bb39917c91a0c3908d577d5e322095425ca") || transfer.Value.Int64() != 1000 {
+		t.Errorf("Unexpected transfer: %#v %v", event, err)
+	}
+
+	(*log.Decoded.Params)[2].Indexed = &[]bool{true}[0]
+	if kind := standardevents.Classify(log); kind != standardevents.ERC721TransferKind {
+		t.Errorf("Expected an ERC-721 transfer, got %q", kind)
+	}
+}
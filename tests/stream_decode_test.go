@@ -0,0 +1,153 @@
+package tests
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/covalenthq/covalent-api-sdk-go/chains"
+	"github.com/covalenthq/covalent-api-sdk-go/covalentclient"
+	"github.com/covalenthq/covalent-api-sdk-go/services"
+	"github.com/covalenthq/covalent-api-sdk-go/testutil"
+	"github.com/covalenthq/covalent-api-sdk-go/utils"
+)
+
+func TestDecodeItems_EmitsBeforePageIsRead(t *testing.T) {
+	reader, writer := io.Pipe()
+	emitted := make(chan services.NftTokenContract)
+	done := make(chan error, 1)
+
+	var data utils.Response[services.NftMetadataResponse]
+	go func() {
+		done <- utils.DecodeItems(utils.NewRequestSettings(testutil.MockAPIKey), reader, &data, "GetTokenIdsForContractWithMetadata", func(item services.NftTokenContract) {
+			emitted <- item
+		})
+	}()
+
+	// The API writes data first and the error fields last.
+	go fmt.Fprint(writer, `{"data":{"updated_at":"2023-01-01T00:00:00Z","items":[{"contract_name":"first"},`)
+	select {
+	case item := <-emitted:
+		if item.ContractName == nil || *item.ContractName != "first" {
+			t.Errorf("Unexpected first item: %+v", item)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatalf("Expected the first item before the rest of the page was written")
+	}
+
+	go func() {
+		fmt.Fprint(writer, `{"contract_name":"second"}],"pagination":{"has_more":true,"page_number":0}},"error":false,"error_code":null,"error_message":null}`)
+		writer.Close()
+	}()
+	if item := <-emitted; *item.ContractName != "second" {
+		t.Errorf("Unexpected second item: %+v", item)
+	}
+	if err := <-done; err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if data.Error || data.Data == nil || data.Data.Pagination.HasMore == nil || !*data.Data.Pagination.HasMore {
+		t.Errorf("Expected the pagination to be decoded, got %+v", data.Data)
+	}
+	if len(data.Data.Items) != 0 {
+		t.Errorf("Expected items not to be collected, got %d", len(data.Data.Items))
+	}
+}
+
+func TestDecodeItems_ErrorAfterItems(t *testing.T) {
+	decode := func(body string) ([]string, utils.Response[services.NftMetadataResponse], error) {
+		var names []string
+		var data utils.Response[services.NftMetadataResponse]
+		err := utils.DecodeItems(utils.NewRequestSettings(testutil.MockAPIKey), strings.NewReader(body), &data, "GetTokenIdsForContractWithMetadata", func(item services.NftTokenContract) {
+			names = append(names, *item.ContractName)
+		})
+		return names, data, err
+	}
+
+	names, data, err := decode(`{"data":{"items":[{"contract_name":"partial"}]},"error":true,"error_code":500,"error_message":"Internal error"}`)
+	if err != nil || fmt.Sprint(names) != "[partial]" || !data.Error || *data.ErrorMessage != "Internal error" {
+		t.Errorf("Expected the items then the error of the response, got %v, %+v, %v", names, data, err)
+	}
+
+	names, data, err = decode(`{"Data":{"Items":[{"contract_name":"a"},{"contract_name":"b"}],"Pagination":{"has_more":false}},"Error":false,"error_message":null}`)
+	if err != nil || fmt.Sprint(names) != "[a b]" || data.Data == nil || data.Data.Pagination.HasMore == nil {
+		t.Errorf("Expected keys to match case-insensitively, got %v, %+v, %v", names, data.Data, err)
+	}
+}
+
+func TestDecodeItems_Pages(t *testing.T) {
+	testutil.UseMockTransport(t, func(w http.ResponseWriter, r *http.Request) {
+		page := r.URL.Query().Get("page-number")
+		hasMore := page == "0"
+		fmt.Fprintf(w, `{"data":{"items":[{"contract_name":"page-%s-a"},{"contract_name":"page-%s-b"}],"pagination":{"has_more":%v}},"error":false,"error_code":null,"error_message":null}`, page, page, hasMore)
+	})
+
+	client, err := covalentclient.NewClient(covalentclient.WithAPIKey(testutil.MockAPIKey))
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	var names []string
+	for result := range client.NftService.GetTokenIdsForContractWithMetadata(chains.EthMainnet, "0x1") {
+		if result.Err != nil {
+			t.Fatalf("Unexpected error: %v", result.Err)
+		}
+		names = append(names, *result.NftTokenContract.ContractName)
+	}
+	expected := []string{"page-0-a", "page-0-b", "page-1-a", "page-1-b"}
+	if fmt.Sprint(names) != fmt.Sprint(expected) {
+		t.Errorf("Expected %v, got %v", expected, names)
+	}
+}
+
+func TestDecodeItems_ErrorAfterItemsOnChannel(t *testing.T) {
+	testutil.UseMockTransport(t, func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"data":{"items":[{"contract_name":"a"}],"pagination":{"has_more":true}},"error":true,"error_code":500,"error_message":"Internal error"}`)
+	})
+
+	client, err := covalentclient.NewClient(covalentclient.WithAPIKey(testutil.MockAPIKey))
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	var results []services.NftTokenContractResult
+	for result := range client.NftService.GetTokenIdsForContractWithMetadata(chains.EthMainnet, "0x1") {
+		results = append(results, result)
+	}
+	if len(results) != 2 || results[0].Err != nil || results[1].Err == nil || !strings.Contains(results[1].Err.Error(), "Internal error") {
+		t.Errorf("Expected the item then the error of the page, got %+v", results)
+	}
+}
+
+func TestDecodeItems_StrictDecoding(t *testing.T) {
+	testutil.UseMockTransport(t, func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"data":{"extra":1,"items":[{"contract_name":"a","surprise":true},{"contract_name":5}],"pagination":{"has_more":false}},"error":false,"error_code":null,"error_message":null}`)
+	})
+
+	recorder := utils.NewSchemaDriftRecorder()
+	client, err := covalentclient.NewClient(covalentclient.WithAPIKey(testutil.MockAPIKey), covalentclient.WithStrictDecoding(recorder.Record, false))
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	count := 0
+	for result := range client.NftService.GetTokenIdsForContractWithMetadata(chains.EthMainnet, "0x1") {
+		if result.Err != nil {
+			t.Fatalf("Unexpected error: %v", result.Err)
+		}
+		count++
+	}
+	if count != 2 {
+		t.Errorf("Expected 2 items, got %d", count)
+	}
+
+	var paths []string
+	for _, drift := range recorder.Drifts()["GetTokenIdsForContractWithMetadata"] {
+		paths = append(paths, drift.Kind+" "+drift.Path)
+	}
+	expected := []string{"unknown_field data.extra", "mistyped_field data.items[].contract_name", "unknown_field data.items[].surprise"}
+	if fmt.Sprint(paths) != fmt.Sprint(expected) {
+		t.Errorf("Expected %v, got %v", expected, paths)
+	}
+}
@@ -0,0 +1,121 @@
+package tests
+
+import (
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/covalenthq/covalent-api-sdk-go/services"
+	"github.com/covalenthq/covalent-api-sdk-go/taxreport"
+)
+
+type fixedPrices map[string]float64
+
+func (p fixedPrices) Price(contractAddress string, date time.Time) (float64, bool, error) {
+	price, ok := p[contractAddress]
+	return price, ok, nil
+}
+
+func TestTaxReport_Ledger(t *testing.T) {
+	wallet := "0xa9d1e08c7793af67e9d92fe308d5697fb81d3e43"
+	var transactions []services.Transaction
+	if err := json.Unmarshal([]byte(`[
+		{"block_signed_at":"2023-01-03T00:00:00Z","tx_hash":"0x3","successful":true,"from_address":"0xA9D1E08C7793AF67E9D92FE308D5697FB81D3E43","to_address":"0xrouter","value":"0","fees_paid":"2000000000000000","gas_quote":3,"gas_metadata":{"contract_decimals":18,"contract_ticker_symbol":"ETH"},
+			"dex_details":[{"protocol_name":"uniswap_v2","event":"swap","token_0_address":"0xC02A","token_0_ticker":"WETH","token_0_num_decimals":18,"token_0_amount":"1.5","token_1_address":"0xa0b8","token_1_ticker":"USDC","token_1_num_decimals":6,"token_1_amount":"2500","token_1_usd_quote":2500}],
+			"log_events":[{"sender_address":"0xa0b8","sender_contract_decimals":6,"raw_log_topics":["0xddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef","0x00000000000000000000000077696bb39917c91a0c3908d577d5e322095425ca","0x000000000000000000000000a9d1e08c7793af67e9d92fe308d5697fb81d3e43"],"raw_log_data":"0x0000000000000000000000000000000000000000000000000000000095079ce0"}]},
+		{"block_signed_at":"2023-01-02T00:00:00Z","tx_hash":"0x2","successful":true,"from_address":"0xdistributor","to_address":"0xtoken","value":"0",
+			"log_events":[{"sender_address":"0xA0B8","sender_contract_ticker_symbol":"USDC","sender_contract_decimals":6,"raw_log_topics":["0xddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef","0x00000000000000000000000077696bb39917c91a0c3908d577d5e322095425ca","0x000000000000000000000000a9d1e08c7793af67e9d92fe308d5697fb81d3e43"],"raw_log_data":"0x00000000000000000000000000000000000000000000000000000000017d7840"}]},
+		{"block_signed_at":"2023-01-01T00:00:00Z","tx_hash":"0x1","successful":true,"from_address":"0xexchange","to_address":"0xa9d1e08c7793af67e9d92fe308d5697fb81d3e43","value":"2000000000000000000","value_quote":2400,"gas_metadata":{"contract_decimals":18,"contract_ticker_symbol":"ETH"}},
+		{"block_signed_at":"2023-01-04T00:00:00Z","tx_hash":"0x4","successful":true,"from_address":"0xa9d1e08c7793af67e9d92fe308d5697fb81d3e43","to_address":"0xmarket","value":"0","fees_paid":"1000000000000000","gas_metadata":{"contract_decimals":18,"contract_ticker_symbol":"ETH"},
+			"nft_sale_details":[{"maker":"0xseller","taker":"0xa9d1e08c7793af67e9d92fe308d5697fb81d3e43","token_id":"42","collection_address":"0xBAYC","collection_name":"BAYC","token_address":"0xc02a","ticker_symbol":"WETH","num_decimals":18,"nft_token_price":0.25}]},
+		{"block_signed_at":"2023-01-05T00:00:00Z","tx_hash":"0x5","successful":false,"from_address":"0xa9d1e08c7793af67e9d92fe308d5697fb81d3e43","to_address":"0xrouter","value":"0","fees_paid":"1000000000000000","gas_quote":1.5,"gas_metadata":{"contract_decimals":18,"contract_ticker_symbol":"ETH"}}
+	]`), &transactions); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	entries, err := taxreport.BuildLedger(wallet, transactions, taxreport.LedgerOpts{
+		IncomeAddresses: []string{"0x77696BB39917C91A0C3908D577D5E322095425CA"},
+		Prices:          fixedPrices{"0xc02a": 1600},
+	})
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if len(entries) != 5 {
+		t.Fatalf("Expected 5 entries, got %d: %+v", len(entries), entries)
+	}
+
+	deposit, reward, swap, purchase, failed := entries[0], entries[1], entries[2], entries[3], entries[4]
+	if deposit.Kind != taxreport.Acquisition || deposit.Received.Amount.String() != "2" || deposit.Received.Currency != "ETH" || *deposit.Value != 2400 || deposit.Fee != nil {
+		t.Errorf("Unexpected deposit: %+v", deposit)
+	}
+	if reward.Kind != taxreport.Income || reward.Received.Amount.String() != "25" || reward.Received.ContractAddress != "0xa0b8" || reward.Value != nil {
+		t.Errorf("Unexpected reward: %+v", reward)
+	}
+	if swap.Kind != taxreport.Trade || swap.Label != "swap" || swap.Sent.Amount.String() != "1.5" || swap.Sent.Currency != "WETH" ||
+		swap.Received.Amount.String() != "2500" || *swap.Value != 2500 || swap.ValueCurrency != "USD" || swap.Fee.Amount.String() != "0.002" {
+		t.Errorf("Unexpected swap: %+v", swap)
+	}
+	if purchase.Label != "nft_purchase" || purchase.Received.Currency != "BAYC #42" || purchase.Sent.Amount.String() != "0.25" || *purchase.Value != 400 || purchase.ValueCurrency != "" {
+		t.Errorf("Unexpected purchase: %+v", purchase)
+	}
+	if failed.Kind != taxreport.Fee || failed.Sent != nil || failed.Received != nil || failed.Fee.Amount.String() != "0.001" || *failed.Value != 1.5 {
+		t.Errorf("Unexpected failed transaction: %+v", failed)
+	}
+
+	var buf bytes.Buffer
+	if err := taxreport.WriteCSV(&buf, entries, "EUR"); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	records, err := csv.NewReader(&buf).ReadAll()
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if len(records) != 6 || records[0][0] != "Date" {
+		t.Fatalf("Unexpected records: %v", records)
+	}
+	expected := []string{"2023-01-02 00:00:00 UTC", "", "", "25", "USDC", "", "", "", "", "income", "", "0x2"}
+	for i, value := range expected {
+		if records[2][i] != value {
+			t.Errorf("Expected column %s to be %q, got %q", taxreport.CSVHeader[i], value, records[2][i])
+		}
+	}
+	if records[1][8] != "EUR" || records[3][7] != "2500" || records[3][8] != "USD" {
+		t.Errorf("Expected the swap to be valued in USD and the deposit in the quote currency, got %v %v", records[1], records[3])
+	}
+	if records[5][5] != "0.001" || records[5][6] != "ETH" || records[5][7] != "1.5" || records[5][9] != "cost" {
+		t.Errorf("Unexpected fee record: %v", records[5])
+	}
+}
+
+func TestTaxReport_SwapToken1ForToken0(t *testing.T) {
+	wallet := "0xa9d1e08c7793af67e9d92fe308d5697fb81d3e43"
+	var transactions []services.Transaction
+	if err := json.Unmarshal([]byte(`[
+		{"block_signed_at":"2023-01-01T00:00:00Z","tx_hash":"0x1","successful":true,"from_address":"0xa9d1e08c7793af67e9d92fe308d5697fb81d3e43","to_address":"0xrouter","value":"0",
+			"dex_details":[{"event":"swap","token_0_address":"0xC02A","token_0_ticker":"WETH","token_0_num_decimals":18,"token_0_amount":"1.5","token_0_usd_quote":2400,"token_1_address":"0xa0b8","token_1_ticker":"USDC","token_1_num_decimals":6,"token_1_amount":"2500","token_1_usd_quote":2500}],
+			"log_events":[{"sender_address":"0xA0B8","sender_contract_decimals":6,"raw_log_topics":["0xddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef","0x000000000000000000000000a9d1e08c7793af67e9d92fe308d5697fb81d3e43","0x00000000000000000000000077696bb39917c91a0c3908d577d5e322095425ca"],"raw_log_data":"0x0000000000000000000000000000000000000000000000000000000095079ce0"}]},
+		{"block_signed_at":"2023-01-02T00:00:00Z","tx_hash":"0x2","successful":true,"from_address":"0xa9d1e08c7793af67e9d92fe308d5697fb81d3e43","to_address":"0xrouter","value":"0",
+			"dex_details":[{"event":"swap","token_0_address":"0xC02A","token_0_ticker":"WETH","token_0_num_decimals":18,"token_0_amount":"-1.5","token_1_address":"0xa0b8","token_1_ticker":"USDC","token_1_num_decimals":6,"token_1_amount":"2500"}]}
+	]`), &transactions); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	entries, err := taxreport.BuildLedger(wallet, transactions)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if len(entries) != 2 {
+		t.Fatalf("Expected 2 entries, got %d: %+v", len(entries), entries)
+	}
+	for _, entry := range entries {
+		if entry.Kind != taxreport.Trade || entry.Sent.Currency != "USDC" || entry.Sent.Amount.String() != "2500" ||
+			entry.Received.Currency != "WETH" || entry.Received.Amount.String() != "1.5" {
+			t.Errorf("Expected USDC sent for WETH in %s, got %+v sent for %+v", entry.TxHash, entry.Sent, entry.Received)
+		}
+	}
+	if entries[0].Value == nil || *entries[0].Value != 2400 {
+		t.Errorf("Expected the swap to be valued by the WETH received, got %v", entries[0].Value)
+	}
+}
@@ -0,0 +1,123 @@
+package tests
+
+import (
+	"encoding/json"
+	"math/big"
+	"testing"
+
+	"github.com/covalenthq/covalent-api-sdk-go/services"
+	"github.com/covalenthq/covalent-api-sdk-go/utils"
+)
+
+func mustParseTokenAmount(t *testing.T, s string, decimals int) utils.TokenAmount {
+	t.Helper()
+	amount, err := utils.ParseTokenAmount(s, decimals)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	return amount
+}
+
+func TestTokenAmount_Arithmetic(t *testing.T) {
+	usdc := mustParseTokenAmount(t, "1.5", 6)
+	if usdc.Raw().Cmp(big.NewInt(1500000)) != 0 {
+		t.Errorf("Unexpected raw amount: %s", usdc.Raw())
+	}
+
+	eth := mustParseTokenAmount(t, "0.000000000000000001", 18)
+	sum := usdc.Add(eth)
+	if sum.String() != "1.500000000000000001" || sum.Decimals() != 18 {
+		t.Errorf("Unexpected sum: %s with %d decimals", sum, sum.Decimals())
+	}
+	if diff := sum.Sub(usdc); diff.Cmp(eth) != 0 {
+		t.Errorf("Unexpected difference: %s", diff)
+	}
+	if usdc.Cmp(mustParseTokenAmount(t, "1.50", 18)) != 0 {
+		t.Errorf("Expected amounts with different decimals to compare equal")
+	}
+	if usdc.Neg().Sign() != -1 || !usdc.Sub(usdc).IsZero() {
+		t.Errorf("Unexpected sign handling")
+	}
+
+	if _, err := utils.ParseTokenAmount("1.0000001", 6); err == nil {
+		t.Errorf("Expected an error for too many decimals")
+	}
+	if _, err := utils.ParseTokenAmount("abc", 6); err == nil {
+		t.Errorf("Expected an error for an invalid amount")
+	}
+}
+
+func TestTokenAmount_Scale(t *testing.T) {
+	amount := mustParseTokenAmount(t, "1.234567", 6)
+	if scaled := amount.Scale(2); scaled.String() != "1.23" || scaled.Decimals() != 2 {
+		t.Errorf("Unexpected scaled amount: %s", scaled)
+	}
+	if scaled := amount.Neg().Scale(2); scaled.String() != "-1.23" {
+		t.Errorf("Expected truncation toward zero, got %s", scaled)
+	}
+	if amount.Exact(2) || !amount.Exact(6) || !amount.Exact(18) {
+		t.Errorf("Unexpected exactness")
+	}
+	if scaled := amount.Scale(18); scaled.Cmp(amount) != 0 {
+		t.Errorf("Expected scaling up to keep the value, got %s", scaled)
+	}
+	if amount.Text(2) != "1.23" || mustParseTokenAmount(t, "1.235", 3).Text(2) != "1.24" {
+		t.Errorf("Unexpected rounded text")
+	}
+}
+
+func TestTokenAmount_Mul(t *testing.T) {
+	// 0.1 * 3 is not 0.3 in float64 arithmetic.
+	amount := mustParseTokenAmount(t, "3", 18)
+	value := amount.MulFloat(0.1)
+	if value.Cmp(big.NewRat(3, 10)) != 0 {
+		t.Errorf("Expected exactly 0.3, got %s", value.FloatString(20))
+	}
+	price, _ := new(big.Rat).SetString("1234.5678")
+	if got := mustParseTokenAmount(t, "2", 6).Mul(price); got.FloatString(4) != "2469.1356" {
+		t.Errorf("Unexpected value: %s", got.FloatString(4))
+	}
+}
+
+func TestTokenAmount_ModelAccessors(t *testing.T) {
+	var item services.BalanceItem
+	if err := json.Unmarshal([]byte(`{"contract_decimals":6,"balance":"2500000","balance_24h":"1000000"}`), &item); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	balance := item.BalanceAmount()
+	if balance == nil || balance.String() != "2.5" {
+		t.Fatalf("Unexpected balance: %v", balance)
+	}
+	if change := balance.Sub(*item.Balance24hAmount()); change.String() != "1.5" {
+		t.Errorf("Unexpected change: %s", change)
+	}
+
+	var transaction services.Transaction
+	if err := json.Unmarshal([]byte(`{"value":"1000000000000000000","fees_paid":"21000000000000","gas_metadata":{"contract_decimals":18}}`), &transaction); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if value := transaction.ValueAmount(); value == nil || value.String() != "1" {
+		t.Errorf("Unexpected value: %v", value)
+	}
+	if fees := transaction.FeesPaidAmount(); fees == nil || fees.String() != "0.000021" {
+		t.Errorf("Unexpected fees: %v", fees)
+	}
+
+	if (&services.BalanceItem{}).BalanceAmount() != nil {
+		t.Errorf("Expected nil when the balance is missing")
+	}
+}
+
+func TestTokenAmount_NegativeDecimals(t *testing.T) {
+	// Malformed contract decimals must not crash callers.
+	amount := utils.NewTokenAmount(big.NewInt(15), -2)
+	if amount.Decimals() != 0 || amount.String() != "15" {
+		t.Errorf("Expected negative decimals to be taken as 0, got %s with %d decimals", amount, amount.Decimals())
+	}
+	if scaled := mustParseTokenAmount(t, "1.5", 1).Scale(-1); scaled.Decimals() != 0 || scaled.String() != "1" {
+		t.Errorf("Unexpected scaled amount: %s", scaled)
+	}
+	if !amount.Exact(-1) {
+		t.Errorf("Expected a whole amount to be exact at 0 decimals")
+	}
+}
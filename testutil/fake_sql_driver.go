@@ -0,0 +1,132 @@
+package testutil
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"fmt"
+	"io"
+	"strings"
+	"sync"
+)
+
+// FakeSQLDriverName is the name the fake driver is registered under with database/sql.
+const FakeSQLDriverName = "covalent-fake"
+
+func init() {
+	sql.Register(FakeSQLDriverName, &fakeDriver{tables: map[string]*fakeTable{}})
+}
+
+// The fake driver understands two statements: `INSERT INTO <table>` with one placeholder argument per column, and
+// `SELECT FROM <table>`, which returns every inserted row in order. Values are kept exactly as database/sql passes
+// them to a driver, so tests see what a real driver would receive from driver.Valuer implementations.
+type fakeDriver struct {
+	mu     sync.Mutex
+	tables map[string]*fakeTable
+}
+
+type fakeTable struct {
+	rows [][]driver.Value
+}
+
+func (d *fakeDriver) Open(name string) (driver.Conn, error) {
+	return &fakeConn{driver: d}, nil
+}
+
+type fakeConn struct {
+	driver *fakeDriver
+}
+
+func (c *fakeConn) Prepare(query string) (driver.Stmt, error) {
+	fields := strings.Fields(query)
+	if len(fields) != 3 || (fields[0] != "INSERT" && fields[0] != "SELECT") {
+		return nil, fmt.Errorf("fake driver: unsupported statement %q", query)
+	}
+	return &fakeStmt{conn: c, verb: fields[0], table: fields[2]}, nil
+}
+
+func (c *fakeConn) Close() error {
+	return nil
+}
+
+func (c *fakeConn) Begin() (driver.Tx, error) {
+	return nil, fmt.Errorf("fake driver: transactions are not supported")
+}
+
+type fakeStmt struct {
+	conn  *fakeConn
+	verb  string
+	table string
+}
+
+func (s *fakeStmt) Close() error {
+	return nil
+}
+
+func (s *fakeStmt) NumInput() int {
+	return -1
+}
+
+func (s *fakeStmt) Exec(args []driver.Value) (driver.Result, error) {
+	if s.verb != "INSERT" {
+		return nil, fmt.Errorf("fake driver: use Query for %s", s.verb)
+	}
+	d := s.conn.driver
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	table := d.tables[s.table]
+	if table == nil {
+		table = &fakeTable{}
+		d.tables[s.table] = table
+	}
+	table.rows = append(table.rows, append([]driver.Value(nil), args...))
+	return driver.RowsAffected(1), nil
+}
+
+func (s *fakeStmt) Query(args []driver.Value) (driver.Rows, error) {
+	if s.verb != "SELECT" {
+		return nil, fmt.Errorf("fake driver: use Exec for %s", s.verb)
+	}
+	d := s.conn.driver
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	var rows [][]driver.Value
+	if table := d.tables[s.table]; table != nil {
+		rows = append(rows, table.rows...)
+	}
+	return &fakeRows{rows: rows}, nil
+}
+
+type fakeRows struct {
+	rows [][]driver.Value
+	next int
+}
+
+func (r *fakeRows) Columns() []string {
+	if len(r.rows) == 0 {
+		return nil
+	}
+	columns := make([]string, len(r.rows[0]))
+	for i := range columns {
+		columns[i] = fmt.Sprintf("c%d", i)
+	}
+	return columns
+}
+
+func (r *fakeRows) Close() error {
+	return nil
+}
+
+func (r *fakeRows) Next(dest []driver.Value) error {
+	if r.next >= len(r.rows) {
+		return io.EOF
+	}
+	copy(dest, r.rows[r.next])
+	r.next++
+	return nil
+}
+
+// OpenFakeSQL opens a database on the fake driver. Tables are shared by every database opened with the same driver,
+// so tests should use table names of their own.
+func OpenFakeSQL() (*sql.DB, error) {
+	return sql.Open(FakeSQLDriverName, "")
+}
@@ -0,0 +1,32 @@
+package testutil
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+// MockAPIKey is a well-formed API key for offline tests that never reach the Covalent API.
+const MockAPIKey string = "cqt_rQbcdfghjkmpqrtvwxyBCDFGHJKM"
+
+// MockTransport is an http.RoundTripper that answers every request with Handler instead of the network.
+type MockTransport struct {
+	Handler http.HandlerFunc
+}
+
+func (m *MockTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	recorder := httptest.NewRecorder()
+	m.Handler(recorder, req)
+	resp := recorder.Result()
+	resp.Request = req
+	return resp, nil
+}
+
+// UseMockTransport routes requests made through http.DefaultTransport to handler until the test ends.
+func UseMockTransport(t *testing.T, handler http.HandlerFunc) {
+	original := http.DefaultTransport
+	http.DefaultTransport = &MockTransport{Handler: handler}
+	t.Cleanup(func() {
+		http.DefaultTransport = original
+	})
+}
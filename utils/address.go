@@ -0,0 +1,161 @@
+package utils
+
+import (
+	"bytes"
+	"database/sql/driver"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"strings"
+)
+
+// AddressLength is the number of bytes in an address.
+const AddressLength = 20
+
+// Address is a 20-byte wallet or contract address. Addresses compare equal with == regardless of the case they were parsed from.
+// It marshals to its EIP-55 checksummed form.
+type Address [AddressLength]byte
+
+// ParseAddress reads a `0x`-prefixed hex address. All-lowercase and all-uppercase addresses are accepted as is;
+// mixed-case addresses must carry a valid EIP-55 checksum.
+func ParseAddress(s string) (Address, error) {
+	var address Address
+	if len(s) != 2+2*AddressLength || (s[:2] != "0x" && s[:2] != "0X") {
+		return address, fmt.Errorf("invalid address %q: expected 0x followed by 40 hex characters", s)
+	}
+	digits := s[2:]
+	if _, err := hex.Decode(address[:], []byte(digits)); err != nil {
+		return Address{}, fmt.Errorf("invalid address %q: %w", s, err)
+	}
+	if digits != strings.ToLower(digits) && digits != strings.ToUpper(digits) && address.Hex() != "0x"+digits {
+		return Address{}, fmt.Errorf("invalid address %q: bad EIP-55 checksum, expected %s", s, address.Hex())
+	}
+	return address, nil
+}
+
+// MustParseAddress is like ParseAddress but panics on an invalid address. For constants.
+func MustParseAddress(s string) Address {
+	address, err := ParseAddress(s)
+	if err != nil {
+		panic(err)
+	}
+	return address
+}
+
+// IsValidAddress reports whether ParseAddress accepts s.
+func IsValidAddress(s string) bool {
+	_, err := ParseAddress(s)
+	return err == nil
+}
+
+// IsChecksumAddress reports whether s is an address in its exact EIP-55 checksummed form.
+func IsChecksumAddress(s string) bool {
+	address, err := ParseAddress(s)
+	return err == nil && address.Hex() == s
+}
+
+// SameAddress reports whether a and b are the same valid address, ignoring case.
+func SameAddress(a string, b string) bool {
+	x, errA := ParseAddress(a)
+	y, errB := ParseAddress(b)
+	return errA == nil && errB == nil && x == y
+}
+
+// Hex returns the EIP-55 checksummed form of the address, eg: `0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAed`.
+func (a Address) Hex() string {
+	lower := hex.EncodeToString(a[:])
+	hash := Keccak256([]byte(lower))
+	checksummed := []byte(lower)
+	for i, c := range checksummed {
+		if c < 'a' {
+			continue
+		}
+		nibble := hash[i/2] >> 4
+		if i%2 == 1 {
+			nibble = hash[i/2] & 0x0f
+		}
+		if nibble >= 8 {
+			checksummed[i] = c - 'a' + 'A'
+		}
+	}
+	return "0x" + string(checksummed)
+}
+
+// Lower returns the lowercase form of the address, as returned by the Covalent API.
+func (a Address) Lower() string {
+	return "0x" + hex.EncodeToString(a[:])
+}
+
+// String returns the checksummed form of the address. Pass it to service methods that take an address.
+func (a Address) String() string {
+	return a.Hex()
+}
+
+// Bytes returns a copy of the address bytes.
+func (a Address) Bytes() []byte {
+	return append([]byte(nil), a[:]...)
+}
+
+// IsZero reports whether a is the zero address.
+func (a Address) IsZero() bool {
+	return a == Address{}
+}
+
+// Equal reports whether a and b are the same address.
+func (a Address) Equal(b Address) bool {
+	return a == b
+}
+
+// MarshalText implements the encoding.TextMarshaler interface.
+func (a Address) MarshalText() ([]byte, error) {
+	return []byte(a.Hex()), nil
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface.
+func (a *Address) UnmarshalText(text []byte) error {
+	address, err := ParseAddress(string(text))
+	if err != nil {
+		return err
+	}
+	*a = address
+	return nil
+}
+
+// MarshalJSON implements the json.Marshaler interface.
+func (a Address) MarshalJSON() ([]byte, error) {
+	return json.Marshal(a.Hex())
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface. A JSON null leaves the address unchanged.
+func (a *Address) UnmarshalJSON(p []byte) error {
+	if bytes.Equal(p, []byte("null")) {
+		return nil
+	}
+	var s string
+	if err := json.Unmarshal(p, &s); err != nil {
+		return err
+	}
+	return a.UnmarshalText([]byte(s))
+}
+
+// Scan implements the sql.Scanner interface. It reads addresses stored as text or as 20 raw bytes.
+func (a *Address) Scan(src interface{}) error {
+	switch v := src.(type) {
+	case []byte:
+		if len(v) == AddressLength {
+			copy(a[:], v)
+			return nil
+		}
+		return a.UnmarshalText(v)
+	case string:
+		return a.UnmarshalText([]byte(v))
+	default:
+		return fmt.Errorf("cannot scan %T into Address", src)
+	}
+}
+
+// Value implements the driver.Valuer interface. The address is stored in lowercase, as the API returns it,
+// so that text comparisons in SQL match.
+func (a Address) Value() (driver.Value, error) {
+	return a.Lower(), nil
+}
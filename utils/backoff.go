@@ -1,6 +1,7 @@
 package utils
 
 import (
+	"context"
 	"fmt"
 	"math"
 	"net/http"
@@ -16,6 +17,8 @@ type ExponentialBackoff struct {
 	Debug      bool
 	MaxRetries int
 	UserAgent  string
+	// Resolves the API key and context for each attempt when set, instead of APIKey.
+	Settings *RequestSettings
 }
 
 func NewExponentialBackoff(apiKey string, debug bool, maxRetries int, userAgent string) *ExponentialBackoff {
@@ -26,12 +29,38 @@ func NewExponentialBackoff(apiKey string, debug bool, maxRetries int, userAgent
 		APIKey:     apiKey,
 		Debug:      debug,
 		MaxRetries: maxRetries,
-		RetryCount: 1,
 		UserAgent:  userAgent,
 	}
 }
 
+// NewExponentialBackoffWithSettings creates an ExponentialBackoff that takes the API key for each attempt from settings,
+// so a key benched by its KeyProvider is not retried.
+func NewExponentialBackoffWithSettings(settings RequestSettings, debug bool, maxRetries int, userAgent string) *ExponentialBackoff {
+	if maxRetries == 0 {
+		maxRetries = settings.MaxRetries
+	}
+	backoff := NewExponentialBackoff("", debug, maxRetries, userAgent)
+	backoff.Settings = &settings
+	return backoff
+}
+
+// Enabled reports whether retries are enabled. When they are not, the rate limited response is returned as is.
+func (e *ExponentialBackoff) Enabled() bool {
+	return e.MaxRetries >= 0
+}
+
+// BackOff retries url after a failed request, waiting Delay before each retry, until a request succeeds or MaxRetries
+// retries were made. RetryCount holds the number of retries made.
 func (e *ExponentialBackoff) BackOff(url string) (*http.Response, error) {
+	if !e.Enabled() {
+		return nil, fmt.Errorf("rate limited: retries are disabled")
+	}
+	if e.RetryCount >= e.MaxRetries {
+		return nil, fmt.Errorf("max retries exceeded: %d", e.MaxRetries)
+	}
+	e.RetryCount++
+	time.Sleep(e.Delay())
+
 	var startTime time.Time
 	if e.Debug {
 		startTime = time.Now()
@@ -47,14 +76,7 @@ func (e *ExponentialBackoff) BackOff(url string) (*http.Response, error) {
 	// Check for rate limiting or other errors
 	if response.StatusCode == http.StatusTooManyRequests || response.StatusCode < 200 || response.StatusCode >= 300 {
 		response.Body.Close()
-		if e.RetryCount < e.MaxRetries {
-			e.RetryCount++
-			delayMs := time.Duration(math.Pow(2, float64(e.RetryCount)) * float64(BaseDelayMs))
-			time.Sleep(delayMs * time.Millisecond)
-			return e.BackOff(url) // Retry the request
-		} else {
-			return nil, fmt.Errorf("max retries exceeded: %d", e.MaxRetries)
-		}
+		return e.BackOff(url) // Retry the request
 	}
 
 	return response, nil
@@ -62,12 +84,26 @@ func (e *ExponentialBackoff) BackOff(url string) (*http.Response, error) {
 }
 
 func (e *ExponentialBackoff) makeRequest(url string) (*http.Response, error) {
+	ctx := context.Background()
+	apiKey := e.APIKey
+	if e.Settings != nil {
+		ctx = e.Settings.Context
+		key, err := e.Settings.APIKey()
+		if err != nil {
+			return nil, err
+		}
+		apiKey = key
+	}
+
 	client := &http.Client{}
-	req, err := http.NewRequest("GET", url, nil)
+	if e.Settings != nil && e.Settings.HTTPClient != nil {
+		client = e.Settings.HTTPClient
+	}
+	req, err := http.NewRequestWithContext(ctx, "GET", url, nil)
 	if err != nil {
 		return nil, err
 	}
-	req.Header.Add("Authorization", "Bearer "+e.APIKey)
+	req.Header.Add("Authorization", "Bearer "+apiKey)
 	req.Header.Set("X-Requested-With", e.UserAgent)
 
 	response, err := client.Do(req)
@@ -75,9 +111,19 @@ func (e *ExponentialBackoff) makeRequest(url string) (*http.Response, error) {
 		return nil, err
 	}
 
+	if e.Settings != nil {
+		e.Settings.Report(apiKey, response.StatusCode)
+	}
+
 	return response, nil
 }
 
+// Delay returns the time to wait before the retry numbered RetryCount: BaseDelayMs before the first, doubling for each
+// one after it.
+func (e *ExponentialBackoff) Delay() time.Duration {
+	return time.Duration(math.Pow(2, float64(e.RetryCount-1))*float64(BaseDelayMs)) * time.Millisecond
+}
+
 func (e *ExponentialBackoff) SetNumAttempts(retryCount int) {
 	e.RetryCount = retryCount
 }
@@ -1,22 +1,41 @@
 package utils
 
 import (
+	"bytes"
+	"database/sql/driver"
 	"encoding/json"
 	"fmt"
+	"math"
 	"math/big"
 )
 
-// BigInt is a wrapper around math/big.Int that implements json.Unmarshaler.
+// BigInt is a wrapper around math/big.Int that implements json.Unmarshaler, json.Marshaler, sql.Scanner and driver.Valuer.
+// A nil Int stands for a JSON null.
 type BigInt struct {
 	*big.Int
 }
 
-// UnmarshalJSON implements the json.Unmarshaler interface.
+// UnmarshalJSON implements the json.Unmarshaler interface. It accepts a quoted integer as sent by the API,
+// a JSON number, including exponent notation such as `1e18` when the value is whole, and null.
 func (b *BigInt) UnmarshalJSON(p []byte) error {
-	// Unmarshal the JSON number into a string.
+	p = bytes.TrimSpace(p)
+	if bytes.Equal(p, []byte("null")) {
+		b.Int = nil
+		return nil
+	}
+
 	var s string
-	if err := json.Unmarshal(p, &s); err != nil {
-		return err
+	if len(p) > 0 && p[0] == '"' {
+		// Unmarshal the JSON string into a string.
+		if err := json.Unmarshal(p, &s); err != nil {
+			return err
+		}
+	} else {
+		var number json.Number
+		if err := json.Unmarshal(p, &number); err != nil {
+			return err
+		}
+		s = number.String()
 	}
 
 	// Initialize the Int if it's nil.
@@ -25,9 +44,63 @@ func (b *BigInt) UnmarshalJSON(p []byte) error {
 	}
 
 	// Set the value of Int.
-	_, ok := b.Int.SetString(s, 10)
-	if !ok {
+	if _, ok := b.Int.SetString(s, 10); ok {
+		return nil
+	}
+	value, ok := new(big.Rat).SetString(s)
+	if !ok || !value.IsInt() {
+		return fmt.Errorf("cannot set big.Int value: %v", s)
+	}
+	b.Int.Set(value.Num())
+	return nil
+}
+
+// MarshalJSON implements the json.Marshaler interface. The value is written as a quoted integer, like the API sends it,
+// so that it survives JSON decoders that read numbers as float64.
+func (b BigInt) MarshalJSON() ([]byte, error) {
+	if b.Int == nil {
+		return []byte("null"), nil
+	}
+	return json.Marshal(b.Int.String())
+}
+
+// Scan implements the sql.Scanner interface. It reads NUMERIC, integer and text columns; a NULL sets a nil Int.
+// Floats are only read when they are exact integers.
+func (b *BigInt) Scan(src interface{}) error {
+	var s string
+	switch v := src.(type) {
+	case nil:
+		b.Int = nil
+		return nil
+	case int64:
+		b.Int = big.NewInt(v)
+		return nil
+	case float64:
+		// Only integers within ±2^53 are exact in a float64; larger values may already have been rounded.
+		if v != math.Trunc(v) || math.Abs(v) > 1<<53 {
+			return fmt.Errorf("cannot scan %v into BigInt: not an exact integer", v)
+		}
+		b.Int = big.NewInt(int64(v))
+		return nil
+	case []byte:
+		s = string(v)
+	case string:
+		s = v
+	default:
+		return fmt.Errorf("cannot scan %T into BigInt", src)
+	}
+	value, ok := new(big.Rat).SetString(s)
+	if !ok || !value.IsInt() {
 		return fmt.Errorf("cannot set big.Int value: %v", s)
 	}
+	b.Int = new(big.Int).Set(value.Num())
 	return nil
 }
+
+// Value implements the driver.Valuer interface. The value is stored as a decimal string, which NUMERIC columns accept.
+func (b BigInt) Value() (driver.Value, error) {
+	if b.Int == nil {
+		return nil, nil
+	}
+	return b.Int.String(), nil
+}
@@ -1,26 +1,104 @@
 package utils
 
 import (
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
 	"strings"
 	"time"
 )
 
+// CustomTime is a time read from either a date such as `2023-11-23` or an RFC3339 timestamp.
+// A nil Time stands for a JSON null or empty string. It marshals back in the form it was read in.
 type CustomTime struct {
 	*time.Time
+
+	dateOnly bool
+}
+
+// NewDate is a constructor function for a CustomTime that marshals as a date, eg: `2023-11-23`.
+func NewDate(t time.Time) CustomTime {
+	return CustomTime{Time: &t, dateOnly: true}
 }
 
 func (ct *CustomTime) UnmarshalJSON(b []byte) error {
 	// Trim quotes since JSON numbers and booleans come in quotes
 	s := strings.Trim(string(b), "\"")
 	if s == "null" || s == "" {
+		ct.Time = nil
 		return nil
 	}
 	// Parse the string to time.Time using the expected layout
-	// Adjust "2006-01-02" as needed to match your input format
-	t, err := time.Parse("2006-01-02", s)
+	t, err := time.Parse(time.DateOnly, s)
+	if err == nil {
+		ct.Time = &t
+		ct.dateOnly = true
+		return nil
+	}
+	t, err = time.Parse(time.RFC3339Nano, s)
 	if err != nil {
 		return err
 	}
 	ct.Time = &t
+	ct.dateOnly = false
 	return nil
 }
+
+func (ct CustomTime) MarshalJSON() ([]byte, error) {
+	if ct.Time == nil {
+		return []byte("null"), nil
+	}
+	if ct.dateOnly {
+		return json.Marshal(ct.Time.Format(time.DateOnly))
+	}
+	return json.Marshal(ct.Time.Format(time.RFC3339Nano))
+}
+
+// IsDateOnly reports whether the time was read from, and marshals as, a date without a time of day.
+func (ct CustomTime) IsDateOnly() bool {
+	return ct.dateOnly
+}
+
+// Scan implements the sql.Scanner interface. It reads timestamp columns as well as dates and timestamps stored as text,
+// as SQLite does; a NULL sets a nil Time. A time at UTC midnight, as DATE columns are read, is taken as a date.
+func (ct *CustomTime) Scan(src interface{}) error {
+	switch v := src.(type) {
+	case nil:
+		ct.Time = nil
+		return nil
+	case time.Time:
+		ct.Time = &v
+		ct.dateOnly = v.Location() == time.UTC && v.Equal(v.Truncate(24*time.Hour))
+		return nil
+	case []byte:
+		return ct.scanText(string(v))
+	case string:
+		return ct.scanText(v)
+	default:
+		return fmt.Errorf("cannot scan %T into CustomTime", src)
+	}
+}
+
+func (ct *CustomTime) scanText(s string) error {
+	for _, layout := range []string{"2006-01-02 15:04:05.999999999-07:00", "2006-01-02 15:04:05.999999999"} {
+		if t, err := time.Parse(layout, s); err == nil {
+			ct.Time = &t
+			ct.dateOnly = false
+			return nil
+		}
+	}
+	return ct.UnmarshalJSON([]byte(s))
+}
+
+// Value implements the driver.Valuer interface. The time is stored as a timestamp, and a date as a timestamp at UTC
+// midnight so that Scan reads it back as a date.
+func (ct CustomTime) Value() (driver.Value, error) {
+	if ct.Time == nil {
+		return nil, nil
+	}
+	if ct.dateOnly {
+		year, month, day := ct.Time.Date()
+		return time.Date(year, month, day, 0, 0, 0, 0, time.UTC), nil
+	}
+	return *ct.Time, nil
+}
@@ -0,0 +1,274 @@
+package utils
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"net/http"
+	"os"
+	"strings"
+	"sync"
+	"time"
+)
+
+// KeyProvider supplies the API key used for each request.
+type KeyProvider interface {
+	// Key returns the API key to use for the next request.
+	Key() (string, error)
+	// Report records the HTTP status code of a request made with key.
+	Report(key string, statusCode int)
+}
+
+type apiKeyContextKey struct{}
+
+// WithAPIKey returns a copy of ctx that overrides the client's KeyProvider with apiKey for requests made with it.
+func WithAPIKey(ctx context.Context, apiKey string) context.Context {
+	return context.WithValue(ctx, apiKeyContextKey{}, apiKey)
+}
+
+// APIKeyFromContext returns the API key override carried by ctx, if any.
+func APIKeyFromContext(ctx context.Context) (string, bool) {
+	if ctx == nil {
+		return "", false
+	}
+	apiKey, ok := ctx.Value(apiKeyContextKey{}).(string)
+	return apiKey, ok && apiKey != ""
+}
+
+// ResolveAPIKey returns the API key override carried by ctx, or the next key from provider.
+func ResolveAPIKey(ctx context.Context, provider KeyProvider) (string, error) {
+	if apiKey, ok := APIKeyFromContext(ctx); ok {
+		return apiKey, nil
+	}
+	if provider == nil {
+		return "", fmt.Errorf(InvalidAPIKeyMessage)
+	}
+	return provider.Key()
+}
+
+// StaticKeyProvider always supplies the same API key.
+type StaticKeyProvider struct {
+	APIKey string
+}
+
+// NewStaticKeyProvider is a constructor function for StaticKeyProvider
+func NewStaticKeyProvider(apiKey string) *StaticKeyProvider {
+	return &StaticKeyProvider{APIKey: apiKey}
+}
+
+func (p *StaticKeyProvider) Key() (string, error) {
+	return p.APIKey, nil
+}
+
+func (p *StaticKeyProvider) Report(key string, statusCode int) {}
+
+const DefaultKeyBenchDuration = time.Minute
+
+// KeyPool supplies keys round-robin and benches a key for BenchDuration after it receives a 429 or 402 response.
+type KeyPool struct {
+	BenchDuration time.Duration
+
+	mu       sync.Mutex
+	keys     []string
+	next     int
+	benched  map[string]time.Time
+	path     string
+	modTime  time.Time
+	stopOnce sync.Once
+	stop     chan struct{}
+}
+
+// NewKeyPool is a constructor function for KeyPool. Every key must match the v1 or v2 API key pattern.
+func NewKeyPool(keys []string, benchDuration time.Duration) (*KeyPool, error) {
+	if benchDuration <= 0 {
+		benchDuration = DefaultKeyBenchDuration
+	}
+	pool := &KeyPool{BenchDuration: benchDuration, benched: map[string]time.Time{}}
+	if err := pool.SetKeys(keys); err != nil {
+		return nil, err
+	}
+	return pool, nil
+}
+
+// NewKeyPoolFromFile creates a KeyPool from a file holding one key per line. Blank lines and lines starting with `#` are ignored.
+// Call Reload or WatchFile to pick up changes to the file.
+func NewKeyPoolFromFile(path string, benchDuration time.Duration) (*KeyPool, error) {
+	keys, modTime, err := readKeyFile(path)
+	if err != nil {
+		return nil, err
+	}
+	pool, err := NewKeyPool(keys, benchDuration)
+	if err != nil {
+		return nil, err
+	}
+	pool.path = path
+	pool.modTime = modTime
+	return pool, nil
+}
+
+// SetKeys replaces the keys in the pool. Benches are kept for keys that remain in the pool.
+func (p *KeyPool) SetKeys(keys []string) error {
+	if len(keys) == 0 {
+		return fmt.Errorf("key pool requires at least one API key")
+	}
+	for _, key := range keys {
+		if !NewApiKeyValidator(key).IsValidApiKey() {
+			return fmt.Errorf("%s: %q", InvalidAPIKeyMessage, maskAPIKey(key))
+		}
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	benched := map[string]time.Time{}
+	for _, key := range keys {
+		if until, ok := p.benched[key]; ok {
+			benched[key] = until
+		}
+	}
+	p.keys = append([]string(nil), keys...)
+	p.benched = benched
+	p.next = 0
+	return nil
+}
+
+// Keys returns the keys currently in the pool.
+func (p *KeyPool) Keys() []string {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return append([]string(nil), p.keys...)
+}
+
+func (p *KeyPool) Key() (string, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	now := time.Now()
+	for i := 0; i < len(p.keys); i++ {
+		key := p.keys[(p.next+i)%len(p.keys)]
+		if until, ok := p.benched[key]; ok {
+			if now.Before(until) {
+				continue
+			}
+			delete(p.benched, key)
+		}
+		p.next = (p.next + i + 1) % len(p.keys)
+		return key, nil
+	}
+	return "", fmt.Errorf("all %d API keys in the pool are benched", len(p.keys))
+}
+
+// Report benches key on a 429 or 402 response. Keys that are not in the pool, such as one set with WithAPIKey, are ignored.
+func (p *KeyPool) Report(key string, statusCode int) {
+	if statusCode != http.StatusTooManyRequests && statusCode != http.StatusPaymentRequired {
+		return
+	}
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	for _, member := range p.keys {
+		if member == key {
+			p.benched[key] = time.Now().Add(p.BenchDuration)
+			return
+		}
+	}
+}
+
+// Reload reads the keys again from the file the pool was created from, if it changed since the last read.
+func (p *KeyPool) Reload() error {
+	if p.path == "" {
+		return fmt.Errorf("key pool was not created from a file")
+	}
+	info, err := os.Stat(p.path)
+	if err != nil {
+		return err
+	}
+	p.mu.Lock()
+	unchanged := info.ModTime().Equal(p.modTime)
+	p.mu.Unlock()
+	if unchanged {
+		return nil
+	}
+
+	keys, modTime, err := readKeyFile(p.path)
+	if err != nil {
+		return err
+	}
+	if err := p.SetKeys(keys); err != nil {
+		return err
+	}
+	p.mu.Lock()
+	p.modTime = modTime
+	p.mu.Unlock()
+	return nil
+}
+
+// WatchFile calls Reload every interval until Close is called. Reload errors are passed to onError when it is set,
+// and the pool keeps its previous keys.
+func (p *KeyPool) WatchFile(interval time.Duration, onError func(error)) {
+	p.mu.Lock()
+	if p.stop == nil {
+		p.stop = make(chan struct{})
+	}
+	stop := p.stop
+	p.mu.Unlock()
+
+	go func() {
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-stop:
+				return
+			case <-ticker.C:
+				if err := p.Reload(); err != nil && onError != nil {
+					onError(err)
+				}
+			}
+		}
+	}()
+}
+
+// Close stops any WatchFile goroutines.
+func (p *KeyPool) Close() {
+	p.mu.Lock()
+	if p.stop == nil {
+		p.stop = make(chan struct{})
+	}
+	p.mu.Unlock()
+	p.stopOnce.Do(func() { close(p.stop) })
+}
+
+func readKeyFile(path string) ([]string, time.Time, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, time.Time{}, err
+	}
+	defer file.Close()
+
+	info, err := file.Stat()
+	if err != nil {
+		return nil, time.Time{}, err
+	}
+
+	var keys []string
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		keys = append(keys, line)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, time.Time{}, err
+	}
+	return keys, info.ModTime(), nil
+}
+
+// maskAPIKey hides all but the prefix of a key so it can appear in error messages.
+func maskAPIKey(key string) string {
+	if len(key) <= 6 {
+		return strings.Repeat("*", len(key))
+	}
+	return key[:6] + strings.Repeat("*", len(key)-6)
+}
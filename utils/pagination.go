@@ -8,6 +8,11 @@ import (
 )
 
 func PaginateEndpoint(urlStr, apiKey string, urlParams url.Values, page int, debug bool, threadCount int, userAgent string) (*http.Response, error) {
+	return PaginateEndpointWithSettings(NewRequestSettings(apiKey), urlStr, urlParams, page, debug, threadCount, userAgent)
+}
+
+// PaginateEndpointWithSettings fetches a page like PaginateEndpoint, taking the API key and context from settings.
+func PaginateEndpointWithSettings(settings RequestSettings, urlStr string, urlParams url.Values, page int, debug bool, threadCount int, userAgent string) (*http.Response, error) {
 
 	parsedURL, err := url.Parse(urlStr)
 	if err != nil {
@@ -23,11 +28,16 @@ func PaginateEndpoint(urlStr, apiKey string, urlParams url.Values, page int, deb
 
 	parsedURL.RawQuery = urlParams.Encode()
 
+	apiKey, err := settings.APIKey()
+	if err != nil {
+		return nil, err
+	}
+
 	// Create an HTTP client
-	client := &http.Client{}
+	client := settings.HTTPClient
 
 	// Create a GET request
-	req, err := http.NewRequest("GET", parsedURL.String(), nil)
+	req, err := http.NewRequestWithContext(settings.Context, "GET", parsedURL.String(), nil)
 	if err != nil {
 		return nil, err
 	}
@@ -41,28 +51,38 @@ func PaginateEndpoint(urlStr, apiKey string, urlParams url.Values, page int, deb
 		startTime = time.Now() // Initialize startTime with the current time
 	}
 
-	backoff := NewExponentialBackoff(apiKey, debug, 0, userAgent)
+	backoff := NewExponentialBackoffWithSettings(settings, debug, 0, userAgent)
 
 	// Perform the request
+	requestStart := time.Now()
 	resp, err := client.Do(req)
 	if err != nil {
 		return nil, err
 	}
 
+	settings.Report(apiKey, resp.StatusCode)
 	DebugOutput(resp.Request.URL.String(), resp.StatusCode, startTime)
 
-	if resp.StatusCode == 429 {
+	if resp.StatusCode == 429 && backoff.Enabled() {
+		resp.Body.Close()
 		res, err := backoff.BackOff(resp.Request.URL.String())
 		if err != nil {
 			return nil, err
 		}
+		settings.trackUntilClose(res, requestStart, 1+backoff.RetryCount)
 		return res, nil
 	} else {
+		settings.trackUntilClose(resp, requestStart, 1)
 		return resp, nil
 	}
 }
 
 func PaginateEndpointUsingLinks(urlStr, apiKey string, urlParams url.Values, debug bool, threadCount int, userAgent string) (*http.Response, error) {
+	return PaginateEndpointUsingLinksWithSettings(NewRequestSettings(apiKey), urlStr, urlParams, debug, threadCount, userAgent)
+}
+
+// PaginateEndpointUsingLinksWithSettings fetches a page like PaginateEndpointUsingLinks, taking the API key and context from settings.
+func PaginateEndpointUsingLinksWithSettings(settings RequestSettings, urlStr string, urlParams url.Values, debug bool, threadCount int, userAgent string) (*http.Response, error) {
 	parsedURL, err := url.Parse(urlStr)
 	if err != nil {
 		return nil, err
@@ -70,11 +90,16 @@ func PaginateEndpointUsingLinks(urlStr, apiKey string, urlParams url.Values, deb
 
 	parsedURL.RawQuery = urlParams.Encode()
 
+	apiKey, err := settings.APIKey()
+	if err != nil {
+		return nil, err
+	}
+
 	// Create an HTTP client
-	client := &http.Client{}
+	client := settings.HTTPClient
 
 	// Create a GET request
-	req, err := http.NewRequest("GET", parsedURL.String(), nil)
+	req, err := http.NewRequestWithContext(settings.Context, "GET", parsedURL.String(), nil)
 	if err != nil {
 		return nil, err
 	}
@@ -88,23 +113,28 @@ func PaginateEndpointUsingLinks(urlStr, apiKey string, urlParams url.Values, deb
 		startTime = time.Now() // Initialize startTime with the current time
 	}
 
-	backoff := NewExponentialBackoff(apiKey, debug, 0, userAgent)
+	backoff := NewExponentialBackoffWithSettings(settings, debug, 0, userAgent)
 
 	// Perform the request
+	requestStart := time.Now()
 	resp, err := client.Do(req)
 	if err != nil {
 		return nil, err
 	}
 
+	settings.Report(apiKey, resp.StatusCode)
 	DebugOutput(resp.Request.URL.String(), resp.StatusCode, startTime)
 
-	if resp.StatusCode == 429 {
+	if resp.StatusCode == 429 && backoff.Enabled() {
+		resp.Body.Close()
 		res, err := backoff.BackOff(resp.Request.URL.String())
 		if err != nil {
 			return nil, err
 		}
+		settings.trackUntilClose(res, requestStart, 1+backoff.RetryCount)
 		return res, nil
 	} else {
+		settings.trackUntilClose(resp, requestStart, 1)
 		return resp, nil
 	}
 }
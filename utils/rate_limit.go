@@ -0,0 +1,69 @@
+package utils
+
+import (
+	"context"
+	"net/http"
+	"sync"
+	"time"
+)
+
+// RateLimiter spaces out calls to Wait so that at most RequestsPerSecond pass each second.
+type RateLimiter struct {
+	RequestsPerSecond float64
+
+	mu   sync.Mutex
+	next time.Time
+}
+
+// NewRateLimiter is a constructor function for RateLimiter
+func NewRateLimiter(requestsPerSecond float64) *RateLimiter {
+	return &RateLimiter{RequestsPerSecond: requestsPerSecond}
+}
+
+// Wait blocks until the next request may be sent or ctx is done.
+func (r *RateLimiter) Wait(ctx context.Context) error {
+	if r.RequestsPerSecond <= 0 {
+		return nil
+	}
+	interval := time.Duration(float64(time.Second) / r.RequestsPerSecond)
+
+	r.mu.Lock()
+	now := time.Now()
+	slot := r.next
+	if slot.Before(now) {
+		slot = now
+	}
+	r.next = slot.Add(interval)
+	r.mu.Unlock()
+
+	delay := time.Until(slot)
+	if delay <= 0 {
+		return nil
+	}
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
+
+// RateLimitedTransport is an http.RoundTripper that waits on Limiter before passing each request to Base.
+type RateLimitedTransport struct {
+	// The transport requests are sent with. Defaults to http.DefaultTransport.
+	Base    http.RoundTripper
+	Limiter *RateLimiter
+}
+
+func (t *RateLimitedTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if err := t.Limiter.Wait(req.Context()); err != nil {
+		return nil, err
+	}
+	base := t.Base
+	if base == nil {
+		base = http.DefaultTransport
+	}
+	return base.RoundTrip(req)
+}
@@ -0,0 +1,62 @@
+package utils
+
+import (
+	"context"
+	"net/http"
+)
+
+const DefaultBaseURL = "https://api.covalenthq.com"
+
+// RequestSettings holds the settings a service applies to every request it makes.
+type RequestSettings struct {
+	// Supplies the API key for each request.
+	KeyProvider KeyProvider
+	// The context requests are made with. A key set on it with WithAPIKey overrides KeyProvider.
+	Context context.Context
+	// The scheme and host requests are sent to. Defaults to DefaultBaseURL.
+	BaseURL string
+	// The HTTP client requests are sent with. Set its Timeout to bound each request.
+	HTTPClient *http.Client
+	// The number of retries after the first attempt of a rate limited request. Defaults to DefaultBackoffMaxRetries, a
+	// negative value disables retries and returns the rate limited response.
+	MaxRetries int
+	// Called with the metadata of every response once its body has been read, including each page of a paginated endpoint.
+	OnResponse func(*ResponseMeta)
+	// Checks every response against its model and reports unknown or mistyped fields. Off when nil.
+	StrictDecoding *StrictDecoding
+}
+
+// NewRequestSettings returns the first of settings, if any, with defaults filled in.
+// A missing KeyProvider defaults to a static provider for apiKey, a missing Context to context.Background()
+// and a missing HTTPClient to a client without a timeout.
+func NewRequestSettings(apiKey string, settings ...RequestSettings) RequestSettings {
+	var requestSettings RequestSettings
+	if len(settings) > 0 {
+		requestSettings = settings[0]
+	}
+	if requestSettings.KeyProvider == nil {
+		requestSettings.KeyProvider = NewStaticKeyProvider(apiKey)
+	}
+	if requestSettings.Context == nil {
+		requestSettings.Context = context.Background()
+	}
+	if requestSettings.BaseURL == "" {
+		requestSettings.BaseURL = DefaultBaseURL
+	}
+	if requestSettings.HTTPClient == nil {
+		requestSettings.HTTPClient = &http.Client{}
+	}
+	return requestSettings
+}
+
+// APIKey returns the key to use for the next request.
+func (r RequestSettings) APIKey() (string, error) {
+	return ResolveAPIKey(r.Context, r.KeyProvider)
+}
+
+// Report passes the status code of a request made with apiKey on to the KeyProvider.
+func (r RequestSettings) Report(apiKey string, statusCode int) {
+	if r.KeyProvider != nil {
+		r.KeyProvider.Report(apiKey, statusCode)
+	}
+}
@@ -5,4 +5,6 @@ type Response[T any] struct {
 	Error        bool    `json:"error"`
 	ErrorCode    *int    `json:"error_code"`
 	ErrorMessage *string `json:"error_message"`
+	// The HTTP status, headers, timing and size of the response. Nil when no response was received.
+	Meta *ResponseMeta `json:"-"`
 }
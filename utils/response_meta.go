@@ -0,0 +1,121 @@
+package utils
+
+import (
+	"io"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+)
+
+// ResponseMeta describes the HTTP exchange behind a response.
+type ResponseMeta struct {
+	// The HTTP status code of the final attempt.
+	StatusCode int
+	// The response headers of the final attempt.
+	Header http.Header
+	// The URL of the final attempt, after redirects.
+	URL string
+	// The number of requests made, including rate limit retries.
+	Attempts int
+	// The time from sending the first request until the response body was read.
+	Latency time.Duration
+	// The number of response body bytes read.
+	Bytes int64
+
+	start      time.Time
+	body       *countingReadCloser
+	onResponse func(*ResponseMeta)
+	finishOnce sync.Once
+}
+
+// RequestID returns the request ID header of the response, to quote in support tickets.
+func (m *ResponseMeta) RequestID() string {
+	for _, name := range []string{"X-Request-Id", "X-Amzn-Requestid", "Cf-Ray"} {
+		if value := m.Header.Get(name); value != "" {
+			return value
+		}
+	}
+	return ""
+}
+
+// RateLimitRemaining returns the number of requests left in the current rate limit window, when the API reports it.
+func (m *ResponseMeta) RateLimitRemaining() (int, bool) {
+	for _, name := range []string{"X-Ratelimit-Remaining", "Ratelimit-Remaining"} {
+		if value := m.Header.Get(name); value != "" {
+			remaining, err := strconv.Atoi(value)
+			if err == nil {
+				return remaining, true
+			}
+		}
+	}
+	return 0, false
+}
+
+// NewResponseMeta starts tracking resp, a response to a request sent at start.
+// The body of resp is wrapped to count the bytes read from it.
+func (r RequestSettings) NewResponseMeta(resp *http.Response, start time.Time) *ResponseMeta {
+	meta := &ResponseMeta{start: start, onResponse: r.OnResponse, Attempts: 1}
+	meta.track(resp)
+	return meta
+}
+
+// Retried switches tracking to resp, the response of a retry, after attempts requests in total.
+func (m *ResponseMeta) Retried(resp *http.Response, attempts int) {
+	m.Attempts = attempts
+	m.track(resp)
+}
+
+// Finish records the latency and byte count, calls the OnResponse hook once and returns m.
+func (m *ResponseMeta) Finish() *ResponseMeta {
+	m.finishOnce.Do(func() {
+		m.Latency = time.Since(m.start)
+		if m.body != nil {
+			m.Bytes = m.body.count
+		}
+		if m.onResponse != nil {
+			m.onResponse(m)
+		}
+	})
+	return m
+}
+
+func (m *ResponseMeta) track(resp *http.Response) {
+	m.StatusCode = resp.StatusCode
+	m.Header = resp.Header
+	if resp.Request != nil {
+		m.URL = resp.Request.URL.String()
+	}
+	m.body = &countingReadCloser{ReadCloser: resp.Body}
+	resp.Body = m.body
+}
+
+// trackUntilClose tracks resp like NewResponseMeta and finishes when its body is closed.
+func (r RequestSettings) trackUntilClose(resp *http.Response, start time.Time, attempts int) {
+	if r.OnResponse == nil {
+		return
+	}
+	meta := r.NewResponseMeta(resp, start)
+	meta.Attempts = attempts
+	meta.body.onClose = func() { meta.Finish() }
+}
+
+type countingReadCloser struct {
+	io.ReadCloser
+	count   int64
+	onClose func()
+}
+
+func (c *countingReadCloser) Read(p []byte) (int, error) {
+	n, err := c.ReadCloser.Read(p)
+	c.count += int64(n)
+	return n, err
+}
+
+func (c *countingReadCloser) Close() error {
+	err := c.ReadCloser.Close()
+	if c.onClose != nil {
+		c.onClose()
+	}
+	return err
+}
@@ -0,0 +1,296 @@
+package utils
+
+import (
+	"bytes"
+	"encoding"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"reflect"
+	"sort"
+	"strings"
+	"sync"
+)
+
+const (
+	// The response has a field the model does not declare.
+	SchemaDriftUnknownField = "unknown_field"
+	// The response has a field whose JSON type does not match the model.
+	SchemaDriftMistypedField = "mistyped_field"
+)
+
+// SchemaDrift is a difference between an API response and the SDK model it is decoded into.
+type SchemaDrift struct {
+	// The service method that received the response, eg: `GetTokenBalancesForWalletAddress`.
+	Endpoint string `json:"endpoint"`
+	// The JSON path of the field, eg: `data.items[].supports_erc`.
+	Path string `json:"path"`
+	// One of `unknown_field` or `mistyped_field`.
+	Kind string `json:"kind"`
+	// The JSON type found in the response.
+	JSONType string `json:"json_type"`
+	// The Go type of the model field. Empty for unknown fields.
+	GoType string `json:"go_type,omitempty"`
+}
+
+func (d SchemaDrift) String() string {
+	if d.Kind == SchemaDriftUnknownField {
+		return fmt.Sprintf("%s: unknown field %s (%s)", d.Endpoint, d.Path, d.JSONType)
+	}
+	return fmt.Sprintf("%s: field %s is %s in the response but %s in the model", d.Endpoint, d.Path, d.JSONType, d.GoType)
+}
+
+// SchemaDriftError lists the schema drift found in one response.
+type SchemaDriftError struct {
+	Endpoint string
+	Drifts   []SchemaDrift
+}
+
+func (e *SchemaDriftError) Error() string {
+	details := make([]string, 0, len(e.Drifts))
+	for _, drift := range e.Drifts {
+		details = append(details, drift.String())
+	}
+	return fmt.Sprintf("schema drift in %s response: %s", e.Endpoint, strings.Join(details, "; "))
+}
+
+// StrictDecoding turns on schema drift detection for every response.
+type StrictDecoding struct {
+	// Called with the drift found in a response. Responses without drift are not reported.
+	OnDrift func(*SchemaDriftError)
+	// Return the SchemaDriftError as the call's error instead of only reporting it.
+	FailOnDrift bool
+}
+
+// Decode reads JSON from body into v. With StrictDecoding set, the response is checked against the type of v;
+// mistyped fields are then reported as drift instead of failing the call, unless FailOnDrift is set.
+func (r RequestSettings) Decode(body io.Reader, v interface{}, endpoint string) error {
+	if r.StrictDecoding == nil {
+		return json.NewDecoder(body).Decode(v)
+	}
+
+	raw, err := io.ReadAll(body)
+	if err != nil {
+		return err
+	}
+	decodeErr := json.NewDecoder(bytes.NewReader(raw)).Decode(v)
+	var typeErr *json.UnmarshalTypeError
+	if decodeErr != nil && !errors.As(decodeErr, &typeErr) {
+		return decodeErr
+	}
+
+	var document interface{}
+	if err := json.Unmarshal(raw, &document); err != nil {
+		return err
+	}
+	drifts := FindSchemaDrift(endpoint, document, reflect.TypeOf(v))
+	if len(drifts) == 0 {
+		return decodeErr
+	}
+	return r.reportSchemaDrift(endpoint, drifts)
+}
+
+// reportSchemaDrift passes drifts on to the OnDrift callback and returns them as an error when FailOnDrift is set.
+func (r RequestSettings) reportSchemaDrift(endpoint string, drifts []SchemaDrift) error {
+	if len(drifts) == 0 {
+		return nil
+	}
+	driftErr := &SchemaDriftError{Endpoint: endpoint, Drifts: drifts}
+	if r.StrictDecoding.OnDrift != nil {
+		r.StrictDecoding.OnDrift(driftErr)
+	}
+	if r.StrictDecoding.FailOnDrift {
+		return driftErr
+	}
+	return nil
+}
+
+// FindSchemaDrift compares a decoded JSON document with the Go type it is meant to be decoded into.
+func FindSchemaDrift(endpoint string, document interface{}, t reflect.Type) []SchemaDrift {
+	seen := map[string]SchemaDrift{}
+	findSchemaDrift(endpoint, "", document, t, seen)
+	return sortedSchemaDrift(seen)
+}
+
+func sortedSchemaDrift(seen map[string]SchemaDrift) []SchemaDrift {
+	drifts := make([]SchemaDrift, 0, len(seen))
+	for _, drift := range seen {
+		drifts = append(drifts, drift)
+	}
+	sort.Slice(drifts, func(i, j int) bool { return drifts[i].Path < drifts[j].Path })
+	return drifts
+}
+
+var (
+	jsonUnmarshalerType = reflect.TypeOf((*json.Unmarshaler)(nil)).Elem()
+	textUnmarshalerType = reflect.TypeOf((*encoding.TextUnmarshaler)(nil)).Elem()
+)
+
+func findSchemaDrift(endpoint string, path string, value interface{}, t reflect.Type, seen map[string]SchemaDrift) {
+	for t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+	if value == nil || t.Kind() == reflect.Interface {
+		return
+	}
+	// Types with their own decoding, such as BigInt and time.Time, accept whatever they accept.
+	if reflect.PointerTo(t).Implements(jsonUnmarshalerType) || reflect.PointerTo(t).Implements(textUnmarshalerType) {
+		return
+	}
+
+	mistyped := func() {
+		seen[path] = SchemaDrift{Endpoint: endpoint, Path: path, Kind: SchemaDriftMistypedField, JSONType: jsonTypeName(value), GoType: t.String()}
+	}
+
+	switch t.Kind() {
+	case reflect.Struct:
+		object, ok := value.(map[string]interface{})
+		if !ok {
+			mistyped()
+			return
+		}
+		fields := jsonFields(t)
+		for key, child := range object {
+			childPath := joinJSONPath(path, key)
+			field, ok := fields[strings.ToLower(key)]
+			if !ok {
+				seen[childPath] = SchemaDrift{Endpoint: endpoint, Path: childPath, Kind: SchemaDriftUnknownField, JSONType: jsonTypeName(child)}
+				continue
+			}
+			findSchemaDrift(endpoint, childPath, child, field.Type, seen)
+		}
+	case reflect.Map:
+		object, ok := value.(map[string]interface{})
+		if !ok {
+			mistyped()
+			return
+		}
+		for key, child := range object {
+			findSchemaDrift(endpoint, joinJSONPath(path, key), child, t.Elem(), seen)
+		}
+	case reflect.Slice, reflect.Array:
+		items, ok := value.([]interface{})
+		if !ok {
+			mistyped()
+			return
+		}
+		for _, item := range items {
+			findSchemaDrift(endpoint, path+"[]", item, t.Elem(), seen)
+		}
+	case reflect.String:
+		if _, ok := value.(string); !ok {
+			mistyped()
+		}
+	case reflect.Bool:
+		if _, ok := value.(bool); !ok {
+			mistyped()
+		}
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		number, ok := value.(float64)
+		if !ok || number != float64(int64(number)) {
+			mistyped()
+		}
+	case reflect.Float32, reflect.Float64:
+		if _, ok := value.(float64); !ok {
+			mistyped()
+		}
+	}
+}
+
+// jsonFields maps the lower-cased JSON names of the fields of t, including promoted fields, to the fields.
+func jsonFields(t reflect.Type) map[string]reflect.StructField {
+	fields := map[string]reflect.StructField{}
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		tag := field.Tag.Get("json")
+		if tag == "-" {
+			continue
+		}
+		name := strings.Split(tag, ",")[0]
+		if field.Anonymous && name == "" {
+			embedded := field.Type
+			if embedded.Kind() == reflect.Pointer {
+				embedded = embedded.Elem()
+			}
+			if embedded.Kind() == reflect.Struct {
+				for key, promoted := range jsonFields(embedded) {
+					if _, ok := fields[key]; !ok {
+						fields[key] = promoted
+					}
+				}
+				continue
+			}
+		}
+		if !field.IsExported() {
+			continue
+		}
+		if name == "" {
+			name = field.Name
+		}
+		fields[strings.ToLower(name)] = field
+	}
+	return fields
+}
+
+func joinJSONPath(path string, key string) string {
+	if path == "" {
+		return key
+	}
+	return path + "." + key
+}
+
+func jsonTypeName(value interface{}) string {
+	switch value.(type) {
+	case nil:
+		return "null"
+	case string:
+		return "string"
+	case float64:
+		return "number"
+	case bool:
+		return "boolean"
+	case []interface{}:
+		return "array"
+	case map[string]interface{}:
+		return "object"
+	}
+	return fmt.Sprintf("%T", value)
+}
+
+// SchemaDriftRecorder collects the drift reported for each endpoint. Its Record method can be used as StrictDecoding.OnDrift.
+type SchemaDriftRecorder struct {
+	mu     sync.Mutex
+	drifts map[string]map[string]SchemaDrift
+}
+
+// NewSchemaDriftRecorder is a constructor function for SchemaDriftRecorder
+func NewSchemaDriftRecorder() *SchemaDriftRecorder {
+	return &SchemaDriftRecorder{drifts: map[string]map[string]SchemaDrift{}}
+}
+
+func (r *SchemaDriftRecorder) Record(err *SchemaDriftError) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.drifts[err.Endpoint] == nil {
+		r.drifts[err.Endpoint] = map[string]SchemaDrift{}
+	}
+	for _, drift := range err.Drifts {
+		r.drifts[err.Endpoint][drift.Kind+" "+drift.Path] = drift
+	}
+}
+
+// Drifts returns the distinct drift recorded for each endpoint, sorted by path.
+func (r *SchemaDriftRecorder) Drifts() map[string][]SchemaDrift {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	report := make(map[string][]SchemaDrift, len(r.drifts))
+	for endpoint, drifts := range r.drifts {
+		for _, drift := range drifts {
+			report[endpoint] = append(report[endpoint], drift)
+		}
+		sort.Slice(report[endpoint], func(i, j int) bool { return report[endpoint][i].Path < report[endpoint][j].Path })
+	}
+	return report
+}
@@ -0,0 +1,165 @@
+package utils
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"reflect"
+	"strings"
+)
+
+// DecodeItems reads a paginated response from body into v, passing each element of `data.items` to emit instead of collecting
+// them in v. Items are emitted as soon as they are parsed. The other fields of the response, such as the pagination and the
+// `error` fields the API writes after `data`, are set on v once the body is read, so callers check v for an error after the
+// items. Items emitted before a malformed part of the body is reached are not taken back. Keys are matched
+// case-insensitively, as encoding/json does.
+func DecodeItems[T any, I any](settings RequestSettings, body io.Reader, v *Response[T], endpoint string, emit func(item I)) error {
+	decoder := json.NewDecoder(body)
+	strict := settings.StrictDecoding != nil
+	seen := map[string]SchemaDrift{}
+
+	// decodeValue unmarshals raw into target, keeping type mismatches as drift in strict mode.
+	decodeValue := func(path string, raw []byte, target interface{}) error {
+		err := json.Unmarshal(raw, target)
+		if !strict {
+			return err
+		}
+		var unmarshalTypeErr *json.UnmarshalTypeError
+		if err != nil && !errors.As(err, &unmarshalTypeErr) {
+			return err
+		}
+		var document interface{}
+		if err := json.Unmarshal(raw, &document); err != nil {
+			return err
+		}
+		findSchemaDrift(endpoint, path, document, reflect.TypeOf(target), seen)
+		return nil
+	}
+
+	if err := expectDelim(decoder, '{'); err != nil {
+		return err
+	}
+	envelope := map[string]json.RawMessage{}
+	data := map[string]json.RawMessage{}
+	hasData := false
+	for decoder.More() {
+		key, err := objectKey(decoder)
+		if err != nil {
+			return err
+		}
+		if !strings.EqualFold(key, "data") {
+			var raw json.RawMessage
+			if err := decoder.Decode(&raw); err != nil {
+				return err
+			}
+			envelope[key] = raw
+			continue
+		}
+
+		token, err := decoder.Token()
+		if err != nil {
+			return err
+		}
+		if token == nil {
+			continue
+		}
+		if token != json.Delim('{') {
+			return fmt.Errorf("unexpected %v at the start of data", token)
+		}
+		hasData = true
+		for decoder.More() {
+			key, err := objectKey(decoder)
+			if err != nil {
+				return err
+			}
+			if !strings.EqualFold(key, "items") {
+				var raw json.RawMessage
+				if err := decoder.Decode(&raw); err != nil {
+					return err
+				}
+				data[key] = raw
+				continue
+			}
+
+			token, err := decoder.Token()
+			if err != nil {
+				return err
+			}
+			if token == nil {
+				continue
+			}
+			if token != json.Delim('[') {
+				return fmt.Errorf("unexpected %v at the start of data.items", token)
+			}
+			for decoder.More() {
+				var raw json.RawMessage
+				if err := decoder.Decode(&raw); err != nil {
+					return err
+				}
+				var item I
+				if err := decodeValue("data.items[]", raw, &item); err != nil {
+					return err
+				}
+				emit(item)
+			}
+			if err := expectDelim(decoder, ']'); err != nil {
+				return err
+			}
+		}
+		if err := expectDelim(decoder, '}'); err != nil {
+			return err
+		}
+	}
+	if err := expectDelim(decoder, '}'); err != nil {
+		return err
+	}
+
+	var response Response[T]
+	envelopeJSON, err := json.Marshal(envelope)
+	if err != nil {
+		return err
+	}
+	if err := decodeValue("", envelopeJSON, &response); err != nil {
+		return err
+	}
+	if hasData {
+		dataJSON, err := json.Marshal(data)
+		if err != nil {
+			return err
+		}
+		response.Data = new(T)
+		if err := decodeValue("data", dataJSON, response.Data); err != nil {
+			return err
+		}
+	}
+	*v = response
+
+	if !strict {
+		return nil
+	}
+	return settings.reportSchemaDrift(endpoint, sortedSchemaDrift(seen))
+}
+
+func expectDelim(decoder *json.Decoder, delim json.Delim) error {
+	token, err := decoder.Token()
+	if err != nil {
+		return err
+	}
+	if token != delim {
+		return fmt.Errorf("expected %v, got %v", delim, token)
+	}
+	return nil
+}
+
+func objectKey(decoder *json.Decoder) (string, error) {
+	token, err := decoder.Token()
+	if err != nil {
+		return "", err
+	}
+	key, ok := token.(string)
+	if !ok {
+		return "", fmt.Errorf("expected an object key, got %v", token)
+	}
+	return key, nil
+}
@@ -0,0 +1,258 @@
+package utils
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+	"math"
+	"math/big"
+	"strconv"
+	"strings"
+)
+
+// TokenAmount is an exact token quantity: a raw integer amount in the token's smallest unit together with the token's decimals,
+// eg: a raw amount of `1500000` with `6` decimals is `1.5`.
+type TokenAmount struct {
+	raw      *big.Int
+	decimals int
+}
+
+// NewTokenAmount is a constructor function for TokenAmount. The raw amount is copied.
+// Negative decimals, which only come from malformed data, are taken as 0.
+func NewTokenAmount(raw *big.Int, decimals int) TokenAmount {
+	if decimals < 0 {
+		decimals = 0
+	}
+	amount := TokenAmount{raw: new(big.Int), decimals: decimals}
+	if raw != nil {
+		amount.raw.Set(raw)
+	}
+	return amount
+}
+
+// TokenAmountOf builds a TokenAmount from a model's raw amount and contract decimals fields. Returns nil when either is missing.
+func TokenAmountOf(raw *BigInt, decimals *int) *TokenAmount {
+	if raw == nil || raw.Int == nil || decimals == nil || *decimals < 0 {
+		return nil
+	}
+	amount := NewTokenAmount(raw.Int, *decimals)
+	return &amount
+}
+
+// ParseTokenAmount reads a decimal string such as `1.5` as an amount with the given decimals.
+// It fails when the string has more fractional digits than decimals allows.
+func ParseTokenAmount(s string, decimals int) (TokenAmount, error) {
+	if decimals < 0 {
+		return TokenAmount{}, fmt.Errorf("token decimals cannot be negative: %d", decimals)
+	}
+	value, ok := new(big.Rat).SetString(strings.TrimSpace(s))
+	if !ok {
+		return TokenAmount{}, fmt.Errorf("invalid token amount: %q", s)
+	}
+	raw := new(big.Rat).Mul(value, new(big.Rat).SetInt(pow10(decimals)))
+	if !raw.IsInt() {
+		return TokenAmount{}, fmt.Errorf("token amount %q has more than %d decimals", s, decimals)
+	}
+	return TokenAmount{raw: new(big.Int).Set(raw.Num()), decimals: decimals}, nil
+}
+
+// Raw returns a copy of the amount in the token's smallest unit.
+func (a TokenAmount) Raw() *big.Int {
+	return new(big.Int).Set(a.rawInt())
+}
+
+// Decimals returns the number of decimals of the token.
+func (a TokenAmount) Decimals() int {
+	return a.decimals
+}
+
+// Rat returns the exact amount in whole tokens.
+func (a TokenAmount) Rat() *big.Rat {
+	return new(big.Rat).SetFrac(a.rawInt(), pow10(a.decimals))
+}
+
+// Sign returns -1, 0 or +1 depending on the sign of the amount.
+func (a TokenAmount) Sign() int {
+	return a.rawInt().Sign()
+}
+
+// IsZero reports whether the amount is zero.
+func (a TokenAmount) IsZero() bool {
+	return a.Sign() == 0
+}
+
+// Add returns a + b. Amounts with different decimals are added at the larger of the two decimals, so no precision is lost.
+func (a TokenAmount) Add(b TokenAmount) TokenAmount {
+	x, y, decimals := alignTokenAmounts(a, b)
+	return TokenAmount{raw: x.Add(x, y), decimals: decimals}
+}
+
+// Sub returns a - b, aligning decimals like Add.
+func (a TokenAmount) Sub(b TokenAmount) TokenAmount {
+	x, y, decimals := alignTokenAmounts(a, b)
+	return TokenAmount{raw: x.Sub(x, y), decimals: decimals}
+}
+
+// Neg returns -a.
+func (a TokenAmount) Neg() TokenAmount {
+	return TokenAmount{raw: new(big.Int).Neg(a.rawInt()), decimals: a.decimals}
+}
+
+// Cmp compares the values of a and b regardless of their decimals and returns -1, 0 or +1.
+func (a TokenAmount) Cmp(b TokenAmount) int {
+	x, y, _ := alignTokenAmounts(a, b)
+	return x.Cmp(y)
+}
+
+// Scale returns the amount with the given decimals. Digits that do not fit in fewer decimals are truncated toward zero;
+// use Exact to check beforehand. Negative decimals are taken as 0.
+func (a TokenAmount) Scale(decimals int) TokenAmount {
+	if decimals < 0 {
+		decimals = 0
+	}
+	raw := new(big.Int).Set(a.rawInt())
+	if decimals >= a.decimals {
+		raw.Mul(raw, pow10(decimals-a.decimals))
+	} else {
+		raw.Quo(raw, pow10(a.decimals-decimals))
+	}
+	return TokenAmount{raw: raw, decimals: decimals}
+}
+
+// Exact reports whether the amount can be represented with the given decimals without truncation.
+func (a TokenAmount) Exact(decimals int) bool {
+	if decimals < 0 {
+		decimals = 0
+	}
+	if decimals >= a.decimals {
+		return true
+	}
+	return new(big.Int).Rem(a.rawInt(), pow10(a.decimals-decimals)).Sign() == 0
+}
+
+// Mul returns the exact value of the amount at a price per whole token, eg: the quote value of a balance.
+func (a TokenAmount) Mul(price *big.Rat) *big.Rat {
+	return new(big.Rat).Mul(a.Rat(), price)
+}
+
+// MulFloat is like Mul for a price reported as a float64, such as QuoteRate. The price is taken as the shortest decimal
+// that rounds to it, eg: `0.1` rather than its binary approximation.
+func (a TokenAmount) MulFloat(price float64) *big.Rat {
+	return a.Mul(PriceFromFloat(price))
+}
+
+// PriceFromFloat returns the shortest decimal that rounds to price, as an exact rational.
+func PriceFromFloat(price float64) *big.Rat {
+	rat, _ := new(big.Rat).SetString(strconv.FormatFloat(price, 'g', -1, 64))
+	if rat == nil {
+		return new(big.Rat)
+	}
+	return rat
+}
+
+// String returns the exact amount in whole tokens without trailing zeros, eg: `1.5`.
+func (a TokenAmount) String() string {
+	text := a.Text(a.decimals)
+	if strings.Contains(text, ".") {
+		text = strings.TrimRight(strings.TrimRight(text, "0"), ".")
+	}
+	return text
+}
+
+// Text returns the amount in whole tokens with the given number of decimals, rounded half away from zero.
+func (a TokenAmount) Text(precision int) string {
+	return a.Rat().FloatString(precision)
+}
+
+type tokenAmountJSON struct {
+	Raw      BigInt `json:"raw"`
+	Decimals int    `json:"decimals"`
+}
+
+// MarshalJSON implements the json.Marshaler interface as `{"raw":"1500000","decimals":6}`, which keeps the amount exact.
+func (a TokenAmount) MarshalJSON() ([]byte, error) {
+	return json.Marshal(tokenAmountJSON{Raw: BigInt{a.rawInt()}, Decimals: a.decimals})
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface.
+func (a *TokenAmount) UnmarshalJSON(p []byte) error {
+	var decoded tokenAmountJSON
+	if err := json.Unmarshal(p, &decoded); err != nil {
+		return err
+	}
+	if decoded.Decimals < 0 {
+		return fmt.Errorf("token decimals cannot be negative: %d", decoded.Decimals)
+	}
+	*a = NewTokenAmount(decoded.Raw.Int, decoded.Decimals)
+	return nil
+}
+
+// Scan implements the sql.Scanner interface. It reads a decimal such as `1.500000` from a NUMERIC or text column,
+// taking the number of fractional digits as the decimals; a NULL sets the zero amount. A float64, as some drivers return
+// for NUMERIC and REAL columns, is read as the shortest decimal that rounds to it, and rejected when it has more digits
+// than a float64 holds exactly.
+func (a *TokenAmount) Scan(src interface{}) error {
+	var s string
+	switch v := src.(type) {
+	case nil:
+		*a = TokenAmount{}
+		return nil
+	case []byte:
+		s = string(v)
+	case string:
+		s = v
+	case int64:
+		*a = NewTokenAmount(big.NewInt(v), 0)
+		return nil
+	case float64:
+		s = strconv.FormatFloat(v, 'f', -1, 64)
+		// Only 15 significant digits, and integers within ±2^53, are exact in a float64.
+		if math.IsNaN(v) || math.IsInf(v, 0) || math.Abs(v) > 1<<53 || significantDigits(s) > 15 {
+			return fmt.Errorf("cannot scan %v into TokenAmount: not an exact amount", v)
+		}
+	default:
+		return fmt.Errorf("cannot scan %T into TokenAmount", src)
+	}
+	s = strings.TrimSpace(s)
+	decimals := 0
+	if dot := strings.IndexByte(s, '.'); dot >= 0 {
+		decimals = len(s) - dot - 1
+	}
+	amount, err := ParseTokenAmount(s, decimals)
+	if err != nil {
+		return err
+	}
+	*a = amount
+	return nil
+}
+
+// Value implements the driver.Valuer interface. The amount is stored as a decimal string with all of its decimals,
+// eg: `1.500000` for 6 decimals, so that Scan restores the decimals.
+func (a TokenAmount) Value() (driver.Value, error) {
+	return a.Text(a.decimals), nil
+}
+
+func (a TokenAmount) rawInt() *big.Int {
+	if a.raw == nil {
+		return new(big.Int)
+	}
+	return a.raw
+}
+
+func alignTokenAmounts(a, b TokenAmount) (*big.Int, *big.Int, int) {
+	decimals := a.decimals
+	if b.decimals > decimals {
+		decimals = b.decimals
+	}
+	return a.Scale(decimals).raw, b.Scale(decimals).raw, decimals
+}
+
+// significantDigits returns the number of significant digits of a decimal string such as `-0.0150`.
+func significantDigits(s string) int {
+	digits := strings.Trim(strings.NewReplacer("-", "", ".", "").Replace(s), "0")
+	return len(digits)
+}
+
+func pow10(n int) *big.Int {
+	return new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(n)), nil)
+}